@@ -0,0 +1,64 @@
+package jwks
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+)
+
+// Path is the well-known JWKS route.
+const Path = "/.well-known/jwks.json"
+
+// cacheControl lets clients cache keys for a short time, rotation publishes the next key before it signs
+// tokens and keeps the previous key published longer.
+var cacheControl = fmt.Sprintf("public, max-age=%d", int(token.JWKSCacheTTL.Seconds()))
+
+// Handler serves public keys used to verify access tokens.
+type Handler struct {
+	logger            *slog.Logger
+	signingKeyService service.SigningKeyService
+}
+
+// NewHandler creates a new JWKS handler.
+func NewHandler(logger *slog.Logger, signingKeyService service.SigningKeyService) *Handler {
+	return &Handler{
+		logger:            logger.With("handler", "jwks"),
+		signingKeyService: signingKeyService,
+	}
+}
+
+// ServeHTTP writes the JSON Web Key Set of all keys still valid for verification.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	keys, err := h.signingKeyService.GetVerificationKeys(r.Context())
+	if err != nil {
+		h.logger.Error("failed to get verification keys", slog.String("error", err.Error()))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	set := token.JWKS{Keys: make([]*token.JWK, 0, len(keys))}
+	for _, key := range keys {
+		jwk, err := token.NewJWK(key)
+		if err != nil {
+			h.logger.Error("failed to convert key to jwk",
+				slog.String("kid", key.ID),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+
+		set.Keys = append(set.Keys, jwk)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", cacheControl)
+
+	err = json.NewEncoder(w).Encode(set)
+	if err != nil {
+		h.logger.Error("failed to write jwks", slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,94 @@
+package oauth
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// Authorize handles an OpenID Connect authorization request and redirects the user back
+// to the client with an authorization code. Users without a valid session are sent to the login page first.
+func (h *Handler) Authorize(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, errorInvalidRequest, "malformed request")
+		return
+	}
+
+	request := &model.AuthorizationRequest{
+		ClientID:            r.Form.Get("client_id"),
+		RedirectURI:         r.Form.Get("redirect_uri"),
+		ResponseType:        r.Form.Get("response_type"),
+		Scopes:              strings.Fields(r.Form.Get("scope")),
+		Nonce:               r.Form.Get("nonce"),
+		CodeChallenge:       r.Form.Get("code_challenge"),
+		CodeChallengeMethod: r.Form.Get("code_challenge_method"),
+	}
+	state := r.Form.Get("state")
+
+	code, err := h.oauthService.Authorize(r.Context(), accessTokenFromRequest(r), request)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrInvalidClient), errors.Is(err, errs.ErrInvalidRedirectURI):
+			// the redirect URI cannot be trusted, so the error is shown to the user instead
+			h.writeError(w, http.StatusBadRequest, errorInvalidRequest, "unknown client_id or redirect_uri")
+		case errors.Is(err, errs.ErrInvalidScope):
+			h.redirect(w, r, request.RedirectURI, url.Values{"error": {errorInvalidScope}, "state": {state}})
+		case errors.Is(err, errs.ErrInvalidAuthorizationRequest):
+			h.redirect(w, r, request.RedirectURI, url.Values{"error": {errorInvalidRequest}, "state": {state}})
+		case errors.Is(err, errs.ErrInvalidAccessToken), errors.Is(err, errs.ErrMFARequired):
+			h.redirect(w, r, h.config.GetLoginURL(), url.Values{
+				"return_to": {h.config.GetIssuer() + AuthorizePath + "?" + r.Form.Encode()},
+			})
+		default:
+			h.logger.Error("failed to authorize client", slog.String("error", err.Error()))
+			h.redirect(w, r, request.RedirectURI, url.Values{"error": {errorServerError}, "state": {state}})
+		}
+
+		return
+	}
+
+	h.redirect(w, r, request.RedirectURI, url.Values{"code": {code}, "state": {state}})
+}
+
+// redirect sends the user agent to target with params added to its query. Empty params are omitted.
+func (h *Handler) redirect(w http.ResponseWriter, r *http.Request, target string, params url.Values) {
+	u, err := url.Parse(target)
+	if err != nil {
+		h.logger.Error("failed to parse redirect target", slog.String("error", err.Error()))
+		h.writeError(w, http.StatusInternalServerError, errorServerError, "")
+		return
+	}
+
+	query := u.Query()
+	for key, values := range params {
+		if len(values) == 0 || values[0] == "" {
+			continue
+		}
+
+		query.Set(key, values[0])
+	}
+	u.RawQuery = query.Encode()
+
+	http.Redirect(w, r, u.String(), http.StatusFound)
+}
+
+// accessTokenFromRequest returns the access token of the user from the authorization header
+// or, for browser redirects, from the access token cookie.
+func accessTokenFromRequest(r *http.Request) string {
+	if accessToken, ok := bearerToken(r); ok {
+		return accessToken
+	}
+
+	cookie, err := r.Cookie(accessTokenCookie)
+	if err != nil {
+		return ""
+	}
+
+	return cookie.Value
+}
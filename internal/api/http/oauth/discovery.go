@@ -0,0 +1,53 @@
+package oauth
+
+import (
+	"net/http"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/api/http/jwks"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+)
+
+// discoveryCacheControl lets clients cache the provider metadata, it changes only with deployments.
+const discoveryCacheControl = "public, max-age=3600"
+
+type discoveryResponse struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+// Discovery writes the OpenID Connect provider metadata.
+func (h *Handler) Discovery(w http.ResponseWriter, _ *http.Request) {
+	issuer := h.config.GetIssuer()
+
+	w.Header().Set("Cache-Control", discoveryCacheControl)
+
+	h.writeJSON(w, http.StatusOK, discoveryResponse{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             issuer + AuthorizePath,
+		TokenEndpoint:                     issuer + TokenPath,
+		UserInfoEndpoint:                  issuer + UserInfoPath,
+		JWKSURI:                           issuer + jwks.Path,
+		ScopesSupported:                   []string{model.ScopeOpenID, model.ScopeProfile, model.ScopeEmail},
+		ResponseTypesSupported:            []string{responseTypeCode},
+		GrantTypesSupported:               []string{grantTypeAuthorizationCode, grantTypeClientCredentials},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{model.SigningAlgorithmRS256, model.SigningAlgorithmEdDSA},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post"},
+		CodeChallengeMethodsSupported:     []string{token.CodeChallengeMethodS256},
+		ClaimsSupported: []string{
+			"sub", "iss", "aud", "exp", "iat", "nonce", "name", "updated_at", "email", "email_verified",
+		},
+	})
+}
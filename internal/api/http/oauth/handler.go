@@ -0,0 +1,95 @@
+package oauth
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+)
+
+// OAuth 2.0 and OpenID Connect routes.
+const (
+	DiscoveryPath = "/.well-known/openid-configuration"
+	AuthorizePath = "/oauth/authorize"
+	TokenPath     = "/oauth/token"
+	UserInfoPath  = "/userinfo"
+)
+
+// Error codes, see RFC 6749 sections 4.1.2.1 and 5.2 and RFC 6750 section 3.1.
+const (
+	errorInvalidRequest       = "invalid_request"
+	errorInvalidClient        = "invalid_client"
+	errorInvalidGrant         = "invalid_grant"
+	errorInvalidScope         = "invalid_scope"
+	errorUnsupportedGrantType = "unsupported_grant_type"
+	errorServerError          = "server_error"
+	errorInvalidToken         = "invalid_token"
+	errorInsufficientScope    = "insufficient_scope"
+)
+
+const responseTypeCode = "code"
+
+const (
+	bearerPrefix = "Bearer "
+	// accessTokenCookie carries the access token of the user to the authorization endpoint,
+	// the login page sets it as browsers cannot send the authorization header on redirects.
+	accessTokenCookie = "access_token"
+)
+
+// Handler serves OAuth 2.0 and OpenID Connect endpoints which cannot be expressed with the gRPC gateway.
+type Handler struct {
+	logger       *slog.Logger
+	oauthService service.OAuthService
+	config       config.OAuthConfig
+}
+
+// NewHandler creates a new OAuth handler.
+func NewHandler(logger *slog.Logger, oauthService service.OAuthService, cfg config.OAuthConfig) *Handler {
+	return &Handler{
+		logger:       logger.With("handler", "oauth"),
+		oauthService: oauthService,
+		config:       cfg,
+	}
+}
+
+type errorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+func (h *Handler) writeError(w http.ResponseWriter, code int, errorCode, description string) {
+	if errorCode == errorInvalidClient {
+		w.Header().Set("WWW-Authenticate", `Basic realm="oauth"`)
+	}
+
+	h.writeJSON(w, code, errorResponse{Error: errorCode, ErrorDescription: description})
+}
+
+func (h *Handler) writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	if w.Header().Get("Cache-Control") == "" {
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("Pragma", "no-cache")
+	}
+	w.WriteHeader(code)
+
+	err := json.NewEncoder(w).Encode(body)
+	if err != nil {
+		h.logger.Error("failed to write oauth response", slog.String("error", err.Error()))
+	}
+}
+
+// bearerToken returns the access token from the authorization header.
+func bearerToken(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return "", false
+	}
+
+	tokenStr := strings.TrimSpace(strings.TrimPrefix(header, bearerPrefix))
+
+	return tokenStr, len(tokenStr) != 0
+}
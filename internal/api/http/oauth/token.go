@@ -0,0 +1,99 @@
+package oauth
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// Grant types supported by the token endpoint.
+const (
+	grantTypeClientCredentials = "client_credentials"
+	grantTypeAuthorizationCode = "authorization_code"
+)
+
+const tokenTypeBearer = "Bearer"
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Scope       string `json:"scope,omitempty"`
+	IDToken     string `json:"id_token,omitempty"`
+}
+
+// Token issues access tokens for a form encoded token request.
+// Client credentials are accepted with HTTP Basic authentication or in the form body.
+func (h *Handler) Token(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, errorInvalidRequest, "malformed form body")
+		return
+	}
+
+	grantType := r.PostForm.Get("grant_type")
+	switch grantType {
+	case grantTypeClientCredentials, grantTypeAuthorizationCode:
+	case "":
+		h.writeError(w, http.StatusBadRequest, errorInvalidRequest, "grant_type is required")
+		return
+	default:
+		h.writeError(w, http.StatusBadRequest, errorUnsupportedGrantType, "")
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+
+	if clientID == "" || clientSecret == "" {
+		h.writeError(w, http.StatusUnauthorized, errorInvalidClient, "client credentials are required")
+		return
+	}
+
+	var clientToken *model.ClientToken
+
+	switch grantType {
+	case grantTypeClientCredentials:
+		clientToken, err = h.oauthService.IssueClientToken(r.Context(), clientID, clientSecret,
+			strings.Fields(r.PostForm.Get("scope")))
+	case grantTypeAuthorizationCode:
+		code, codeVerifier := r.PostForm.Get("code"), r.PostForm.Get("code_verifier")
+		if code == "" || codeVerifier == "" {
+			h.writeError(w, http.StatusBadRequest, errorInvalidRequest, "code and code_verifier are required")
+			return
+		}
+
+		clientToken, err = h.oauthService.ExchangeAuthorizationCode(r.Context(), clientID, clientSecret,
+			code, r.PostForm.Get("redirect_uri"), codeVerifier)
+	}
+
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrInvalidClient):
+			h.writeError(w, http.StatusUnauthorized, errorInvalidClient, "")
+		case errors.Is(err, errs.ErrInvalidGrant):
+			h.writeError(w, http.StatusBadRequest, errorInvalidGrant, "")
+		case errors.Is(err, errs.ErrInvalidScope):
+			h.writeError(w, http.StatusBadRequest, errorInvalidScope, "")
+		default:
+			h.logger.Error("failed to issue token", slog.String("error", err.Error()))
+			h.writeError(w, http.StatusInternalServerError, errorServerError, "")
+		}
+
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, tokenResponse{
+		AccessToken: clientToken.AccessToken,
+		TokenType:   tokenTypeBearer,
+		ExpiresIn:   int64(clientToken.ExpiresIn.Seconds()),
+		Scope:       strings.Join(clientToken.Scopes, " "),
+		IDToken:     clientToken.IDToken,
+	})
+}
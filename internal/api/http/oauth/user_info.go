@@ -0,0 +1,41 @@
+package oauth
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+)
+
+// UserInfo returns the claims about the user released to the client by the scopes of the access token.
+func (h *Handler) UserInfo(w http.ResponseWriter, r *http.Request) {
+	accessToken, ok := bearerToken(r)
+	if !ok {
+		h.writeBearerError(w, http.StatusUnauthorized, errorInvalidToken)
+		return
+	}
+
+	userInfo, err := h.oauthService.UserInfo(r.Context(), accessToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			h.writeBearerError(w, http.StatusUnauthorized, errorInvalidToken)
+		case errors.Is(err, errs.ErrAccessDenied):
+			h.writeBearerError(w, http.StatusForbidden, errorInsufficientScope)
+		default:
+			h.logger.Error("failed to get user info", slog.String("error", err.Error()))
+			h.writeError(w, http.StatusInternalServerError, errorServerError, "")
+		}
+
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, token.UserInfoClaims(userInfo.User, userInfo.Scopes))
+}
+
+func (h *Handler) writeBearerError(w http.ResponseWriter, code int, errorCode string) {
+	w.Header().Set("WWW-Authenticate", `Bearer error="`+errorCode+`"`)
+	h.writeJSON(w, code, errorResponse{Error: errorCode})
+}
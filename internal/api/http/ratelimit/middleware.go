@@ -0,0 +1,51 @@
+package ratelimit
+
+import (
+	"log/slog"
+	"net/http"
+	"net/textproto"
+	"strconv"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/clientip"
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/interceptor"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// NewMiddleware returns a gateway middleware limiting the rate of requests to each HTTP route
+// by client address. Routes proxied to gRPC methods are limited by the gRPC interceptor,
+// so only routes configured by method and path are limited here.
+// Requests are let through if the limiter is unavailable.
+func NewMiddleware(logger *slog.Logger, rateLimitService service.RateLimitService) runtime.Middleware {
+	return func(next runtime.HandlerFunc) runtime.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+			result, err := rateLimitService.Take(r.Context(), r.Method+" "+r.URL.Path, "ip:"+clientip.FromRequest(r))
+			if err != nil {
+				logger.Error("failed to take rate limit token", slog.String("error", err.Error()))
+				next(w, r, pathParams)
+
+				return
+			}
+
+			if !result.Allowed {
+				w.Header().Set("Retry-After", strconv.FormatInt(result.RetryAfterSeconds(), 10))
+				http.Error(w, errs.ErrRateLimited.Error(), http.StatusTooManyRequests)
+
+				return
+			}
+
+			next(w, r, pathParams)
+		}
+	}
+}
+
+// OutgoingHeaderMatcher passes the Retry-After header of rate limited gRPC responses to HTTP
+// responses as is, other headers keep the default gateway treatment.
+func OutgoingHeaderMatcher(key string) (string, bool) {
+	if key == interceptor.RetryAfterHeader {
+		return textproto.CanonicalMIMEHeaderKey(key), true
+	}
+
+	return runtime.MetadataHeaderPrefix + key, true
+}
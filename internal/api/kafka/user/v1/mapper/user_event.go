@@ -32,6 +32,61 @@ func ToUserEventFromKafka(event *modelKafka.UserEvent) (*model.UserEvent, error)
 		}
 	case model.UserEventTypeDeleteUser:
 		value = &model.DeleteUserEventValue{}
+	case model.UserEventTypeRefreshTokenReused:
+		value, err = ToRefreshTokenReusedEventValueFromKafka(event.Data)
+		if err != nil {
+			return nil, err
+		}
+	case model.UserEventTypePasswordChanged:
+		value = &model.PasswordChangedEventValue{}
+	case model.UserEventTypeLocked:
+		value, err = ToLockedEventValueFromKafka(event.Data)
+		if err != nil {
+			return nil, err
+		}
+	case model.UserEventTypeUnlocked:
+		value = &model.UnlockedEventValue{}
+	case model.UserEventTypeMFAEnabled:
+		value = &model.MFAEnabledEventValue{}
+	case model.UserEventTypeMFADisabled:
+		value = &model.MFADisabledEventValue{}
+	case model.UserEventTypeRoleCreated:
+		value, err = ToRoleCreatedEventValueFromKafka(event.Data)
+		if err != nil {
+			return nil, err
+		}
+	case model.UserEventTypeRoleUpdated:
+		value, err = ToRoleUpdatedEventValueFromKafka(event.Data)
+		if err != nil {
+			return nil, err
+		}
+	case model.UserEventTypeRoleDeleted:
+		value, err = ToRoleDeletedEventValueFromKafka(event.Data)
+		if err != nil {
+			return nil, err
+		}
+	case model.UserEventTypeRoleAssigned:
+		value, err = ToRoleAssignedEventValueFromKafka(event.Data)
+		if err != nil {
+			return nil, err
+		}
+	case model.UserEventTypeRoleUnassigned:
+		value, err = ToRoleUnassignedEventValueFromKafka(event.Data)
+		if err != nil {
+			return nil, err
+		}
+	case model.UserEventTypeImpersonationStarted:
+		value, err = ToImpersonationStartedEventValueFromKafka(event.Data)
+		if err != nil {
+			return nil, err
+		}
+	case model.UserEventTypeImpersonatedCall:
+		value, err = ToImpersonatedCallEventValueFromKafka(event.Data)
+		if err != nil {
+			return nil, err
+		}
+	case model.UserEventTypeOrgMemberAdded:
+		value = &model.OrgMemberAddedEventValue{}
 	default:
 		return nil, errors.New("invalid user event data")
 	}
@@ -39,6 +94,7 @@ func ToUserEventFromKafka(event *modelKafka.UserEvent) (*model.UserEvent, error)
 	return &model.UserEvent{
 		ID:        eventID,
 		UserID:    event.UserID,
+		OrgID:     event.OrgID,
 		Type:      event.Type,
 		EntityID:  event.EntityID,
 		Value:     value,
@@ -56,9 +112,10 @@ func ToCreateUserEventValueFromKafka(data json.RawMessage) (*model.CreateUserEve
 	return &model.CreateUserEventValue{
 		User: &model.User{
 			ID:        kafkaModel.User.ID,
+			OrgID:     kafkaModel.User.OrgID,
 			Name:      kafkaModel.User.Name,
 			Email:     kafkaModel.User.Email,
-			Role:      ToRoleFromKafka(kafkaModel.User.Role),
+			Roles:     ToRolesFromKafka(kafkaModel.User),
 			CreatedAt: kafkaModel.User.CreatedAt,
 			UpdatedAt: kafkaModel.User.UpdatedAt,
 		},
@@ -75,23 +132,140 @@ func ToUpdateUserEventValueFromKafka(data json.RawMessage) (*model.UpdateUserEve
 	return &model.UpdateUserEventValue{
 		User: &model.User{
 			ID:        kafkaModel.User.ID,
+			OrgID:     kafkaModel.User.OrgID,
 			Name:      kafkaModel.User.Name,
 			Email:     kafkaModel.User.Email,
-			Role:      ToRoleFromKafka(kafkaModel.User.Role),
+			Roles:     ToRolesFromKafka(kafkaModel.User),
 			CreatedAt: kafkaModel.User.CreatedAt,
 			UpdatedAt: kafkaModel.User.UpdatedAt,
 		},
 	}, nil
 }
 
+// ToRefreshTokenReusedEventValueFromKafka creates refresh token reused event value from kafka.
+func ToRefreshTokenReusedEventValueFromKafka(data json.RawMessage) (*model.RefreshTokenReusedEventValue, error) {
+	var kafkaModel modelRepoKafka.RefreshTokenReusedEventData
+	if err := json.Unmarshal(data, &kafkaModel); err != nil {
+		return nil, err
+	}
+
+	familyID, err := uuid.Parse(kafkaModel.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.RefreshTokenReusedEventValue{FamilyID: familyID}, nil
+}
+
+// ToLockedEventValueFromKafka creates account locked event value from kafka.
+func ToLockedEventValueFromKafka(data json.RawMessage) (*model.LockedEventValue, error) {
+	var kafkaModel modelRepoKafka.LockedEventData
+	if err := json.Unmarshal(data, &kafkaModel); err != nil {
+		return nil, err
+	}
+
+	return &model.LockedEventValue{
+		LockedUntil: kafkaModel.LockedUntil,
+		Lockouts:    kafkaModel.Lockouts,
+		IP:          kafkaModel.IP,
+	}, nil
+}
+
+// ToRoleCreatedEventValueFromKafka creates role created event value from kafka.
+func ToRoleCreatedEventValueFromKafka(data json.RawMessage) (*model.RoleCreatedEventValue, error) {
+	var kafkaModel modelRepoKafka.RoleCreatedEventData
+	if err := json.Unmarshal(data, &kafkaModel); err != nil {
+		return nil, err
+	}
+
+	return &model.RoleCreatedEventValue{Role: ToRoleFromKafka(kafkaModel.Role)}, nil
+}
+
+// ToRoleUpdatedEventValueFromKafka creates role updated event value from kafka.
+func ToRoleUpdatedEventValueFromKafka(data json.RawMessage) (*model.RoleUpdatedEventValue, error) {
+	var kafkaModel modelRepoKafka.RoleUpdatedEventData
+	if err := json.Unmarshal(data, &kafkaModel); err != nil {
+		return nil, err
+	}
+
+	return &model.RoleUpdatedEventValue{Role: ToRoleFromKafka(kafkaModel.Role)}, nil
+}
+
+// ToRoleDeletedEventValueFromKafka creates role deleted event value from kafka.
+func ToRoleDeletedEventValueFromKafka(data json.RawMessage) (*model.RoleDeletedEventValue, error) {
+	var kafkaModel modelRepoKafka.RoleDeletedEventData
+	if err := json.Unmarshal(data, &kafkaModel); err != nil {
+		return nil, err
+	}
+
+	return &model.RoleDeletedEventValue{Name: kafkaModel.Name}, nil
+}
+
+// ToRoleAssignedEventValueFromKafka creates role assigned event value from kafka.
+func ToRoleAssignedEventValueFromKafka(data json.RawMessage) (*model.RoleAssignedEventValue, error) {
+	var kafkaModel modelRepoKafka.RoleAssignedEventData
+	if err := json.Unmarshal(data, &kafkaModel); err != nil {
+		return nil, err
+	}
+
+	return &model.RoleAssignedEventValue{RoleID: kafkaModel.RoleID, Name: kafkaModel.Name}, nil
+}
+
+// ToRoleUnassignedEventValueFromKafka creates role unassigned event value from kafka.
+func ToRoleUnassignedEventValueFromKafka(data json.RawMessage) (*model.RoleUnassignedEventValue, error) {
+	var kafkaModel modelRepoKafka.RoleUnassignedEventData
+	if err := json.Unmarshal(data, &kafkaModel); err != nil {
+		return nil, err
+	}
+
+	return &model.RoleUnassignedEventValue{RoleID: kafkaModel.RoleID, Name: kafkaModel.Name}, nil
+}
+
+// ToImpersonationStartedEventValueFromKafka creates impersonation started event value from kafka.
+func ToImpersonationStartedEventValueFromKafka(data json.RawMessage) (*model.ImpersonationStartedEventValue, error) {
+	var kafkaModel modelRepoKafka.ImpersonationStartedEventData
+	if err := json.Unmarshal(data, &kafkaModel); err != nil {
+		return nil, err
+	}
+
+	return &model.ImpersonationStartedEventValue{
+		TokenID:   kafkaModel.TokenID,
+		Reason:    kafkaModel.Reason,
+		ExpiresAt: kafkaModel.ExpiresAt,
+	}, nil
+}
+
+// ToImpersonatedCallEventValueFromKafka creates impersonated call event value from kafka.
+func ToImpersonatedCallEventValueFromKafka(data json.RawMessage) (*model.ImpersonatedCallEventValue, error) {
+	var kafkaModel modelRepoKafka.ImpersonatedCallEventData
+	if err := json.Unmarshal(data, &kafkaModel); err != nil {
+		return nil, err
+	}
+
+	return &model.ImpersonatedCallEventValue{TokenID: kafkaModel.TokenID, Endpoint: kafkaModel.Endpoint}, nil
+}
+
 // ToRoleFromKafka creates role from kafka.
-func ToRoleFromKafka(role string) model.Role {
-	switch role {
-	case model.RoleAdmin.String():
-		return model.RoleAdmin
-	case model.RoleUser.String():
-		return model.RoleUser
-	default:
-		return model.RoleUnknown
+func ToRoleFromKafka(role *modelRepoKafka.Role) *model.Role {
+	if role == nil {
+		return nil
 	}
+
+	return &model.Role{
+		ID:          role.ID,
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+		BuiltIn:     role.BuiltIn,
+		CreatedAt:   role.CreatedAt,
+	}
+}
+
+// ToRolesFromKafka creates roles from kafka, events produced before roles were introduced carry a single role.
+func ToRolesFromKafka(user *modelRepoKafka.User) []string {
+	if len(user.Roles) != 0 || user.Role == "" {
+		return user.Roles
+	}
+
+	return []string{user.Role}
 }
@@ -11,6 +11,7 @@ import (
 type UserEvent struct {
 	ID        string              `json:"id"`
 	UserID    int64               `json:"user_id"`
+	OrgID     int64               `json:"org_id,omitempty"`
 	Type      model.UserEventType `json:"type"`
 	Data      json.RawMessage     `json:"data"`
 	EntityID  int64               `json:"entity_id"`
@@ -0,0 +1,47 @@
+package accessv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/access_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Check whether the caller's access token may call the endpoint.
+func (a *Implementation) Check(ctx context.Context, request *desc.CheckRequest) (*emptypb.Empty, error) {
+	logger := a.logger.
+		With("method", "Check").
+		With("endpoint_address", request.EndpointAddress)
+
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	err := a.accessService.Check(ctx, accessToken, request.EndpointAddress)
+	if err != nil {
+		logger.Error("failed to check access", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrAccessDenied):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrAccessDenied.Error())
+		case errors.Is(err, errs.ErrMFARequired):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrMFARequired.Error())
+		case errors.Is(err, errs.ErrEmailNotVerified):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrEmailNotVerified.Error())
+		}
+
+		return nil, fmt.Errorf("failed to check access: %w", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,24 @@
+package accessv1
+
+import (
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	"github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/access_v1"
+)
+
+// CheckMethod is the full name of the Check method. The scopes of the caller are checked against
+// the endpoint it is asked about rather than the method itself.
+const CheckMethod = "/access_v1.Access/Check"
+
+// Implementation of the access service.
+type Implementation struct {
+	logger        *slog.Logger
+	accessService service.AccessService
+	access_v1.UnimplementedAccessServer
+}
+
+// NewImplementation creates a new access service implementation.
+func NewImplementation(logger *slog.Logger, accessService service.AccessService) *Implementation {
+	return &Implementation{logger: logger, accessService: accessService}
+}
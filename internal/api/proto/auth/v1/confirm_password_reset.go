@@ -0,0 +1,43 @@
+package authv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/mapper"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/auth_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ConfirmPasswordReset sets a new password using the password reset token.
+func (a *Implementation) ConfirmPasswordReset(
+	ctx context.Context,
+	request *desc.ConfirmPasswordResetRequest,
+) (*emptypb.Empty, error) {
+	logger := a.logger.With("method", "ConfirmPasswordReset")
+
+	if request.NewPassword != request.NewPasswordConfirm {
+		return nil, status.Error(codes.InvalidArgument, "password and confirm password do not match")
+	}
+
+	err := a.passwordResetService.Confirm(ctx, request.Token, request.NewPassword)
+	if err != nil {
+		logger.Error("failed to confirm password reset", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidPasswordResetToken):
+			return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidPasswordResetToken.Error())
+		case errors.Is(err, errs.ErrWeakPassword):
+			return nil, mapper.ToInvalidArgumentStatusFromError(err, errs.ErrWeakPassword.Error())
+		}
+
+		return nil, fmt.Errorf("failed to confirm password reset: %w", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,58 @@
+package authv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/mapper"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/auth_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateAPIKey creates an API key of the authenticated user.
+func (a *Implementation) CreateAPIKey(
+	ctx context.Context,
+	request *desc.CreateAPIKeyRequest,
+) (*desc.CreateAPIKeyResponse, error) {
+	logger := a.logger.
+		With("method", "CreateAPIKey").
+		With("name", request.Name)
+
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	created, err := a.apiKeyService.Create(
+		ctx,
+		accessToken,
+		request.Name,
+		request.Scopes,
+		mapper.ToExpiresAtFromCreateAPIKeyRequest(request),
+	)
+	if err != nil {
+		logger.Error("failed to create api key", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrAccessDenied):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrAccessDenied.Error())
+		case errors.Is(err, errs.ErrMFARequired):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrMFARequired.Error())
+		case errors.Is(err, errs.ErrImpersonatedSession):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrImpersonatedSession.Error())
+		case errors.Is(err, errs.ErrInvalidAPIKey):
+			return nil, mapper.ToInvalidArgumentStatusFromError(err, errs.ErrInvalidAPIKey.Error())
+		}
+
+		return nil, fmt.Errorf("failed to create api key: %w", err)
+	}
+
+	return mapper.ToCreateAPIKeyResponseFromService(created), nil
+}
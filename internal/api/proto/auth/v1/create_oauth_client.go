@@ -0,0 +1,50 @@
+package authv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/mapper"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/auth_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateOAuthClient registers an OAuth client for the client credentials grant.
+func (a *Implementation) CreateOAuthClient(
+	ctx context.Context,
+	request *desc.CreateOAuthClientRequest,
+) (*desc.CreateOAuthClientResponse, error) {
+	logger := a.logger.
+		With("method", "CreateOAuthClient").
+		With("name", request.Name)
+
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	created, err := a.oauthService.CreateClient(ctx, accessToken, request.Name, request.Scopes, request.RedirectUris)
+	if err != nil {
+		logger.Error("failed to create oauth client", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrAccessDenied):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrAccessDenied.Error())
+		case errors.Is(err, errs.ErrMFARequired):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrMFARequired.Error())
+		case errors.Is(err, errs.ErrInvalidOAuthClient):
+			return nil, mapper.ToInvalidArgumentStatusFromError(err, errs.ErrInvalidOAuthClient.Error())
+		}
+
+		return nil, fmt.Errorf("failed to create oauth client: %w", err)
+	}
+
+	return mapper.ToCreateOAuthClientResponseFromService(created), nil
+}
@@ -0,0 +1,52 @@
+package authv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/mapper"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/auth_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Impersonate issues a short-lived token to act as the user.
+func (a *Implementation) Impersonate(
+	ctx context.Context,
+	request *desc.ImpersonateRequest,
+) (*desc.ImpersonateResponse, error) {
+	logger := a.logger.
+		With("method", "Impersonate").
+		With("user_id", request.UserId)
+
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	impersonation, err := a.authService.Impersonate(ctx, accessToken, request.UserId, request.Reason)
+	if err != nil {
+		logger.Error("failed to impersonate user", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrAccessDenied):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrAccessDenied.Error())
+		case errors.Is(err, errs.ErrMFARequired):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrMFARequired.Error())
+		case errors.Is(err, errs.ErrImpersonatedSession):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrImpersonatedSession.Error())
+		case errors.Is(err, errs.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, errs.ErrUserNotFound.Error())
+		}
+
+		return nil, fmt.Errorf("failed to impersonate user: %w", err)
+	}
+
+	return mapper.ToImpersonateResponseFromService(impersonation), nil
+}
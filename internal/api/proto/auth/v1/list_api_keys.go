@@ -0,0 +1,39 @@
+package authv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/mapper"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/auth_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ListAPIKeys returns API keys of the authenticated user.
+func (a *Implementation) ListAPIKeys(ctx context.Context, _ *emptypb.Empty) (*desc.ListAPIKeysResponse, error) {
+	logger := a.logger.With("method", "ListAPIKeys")
+
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	keys, err := a.apiKeyService.List(ctx, accessToken)
+	if err != nil {
+		logger.Error("failed to list api keys", slog.String("error", err.Error()))
+
+		if errors.Is(err, errs.ErrInvalidAccessToken) {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		}
+
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+
+	return mapper.ToListAPIKeysResponseFromService(keys), nil
+}
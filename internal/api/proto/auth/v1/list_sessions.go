@@ -0,0 +1,48 @@
+package authv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/mapper"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/auth_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListSessions returns active sessions of the user.
+func (a *Implementation) ListSessions(
+	ctx context.Context,
+	request *desc.ListSessionsRequest,
+) (*desc.ListSessionsResponse, error) {
+	logger := a.logger.
+		With("method", "ListSessions").
+		With("user_id", request.UserId)
+
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	sessions, err := a.authService.ListSessions(ctx, accessToken, request.UserId)
+	if err != nil {
+		logger.Error("failed to list sessions", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrAccessDenied):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrAccessDenied.Error())
+		case errors.Is(err, errs.ErrMFARequired):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrMFARequired.Error())
+		}
+
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	return mapper.ToListSessionsResponseFromService(sessions), nil
+}
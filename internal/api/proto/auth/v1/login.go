@@ -0,0 +1,45 @@
+package authv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/clientip"
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/mapper"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/auth_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Login user by email and password.
+func (a *Implementation) Login(ctx context.Context, request *desc.LoginRequest) (*desc.LoginResponse, error) {
+	ip := clientip.FromIncomingContext(ctx)
+	logger := a.logger.
+		With("method", "Login").
+		With("email", request.Email).
+		With("org_id", request.OrgId).
+		With("ip", ip)
+
+	result, err := a.authService.Login(ctx, mapper.ToOrgIDFromDesc(request.OrgId), request.Email, request.Password, ip)
+	if err != nil {
+		logger.Error("failed to login", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidCredentials):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidCredentials.Error())
+		case errors.Is(err, errs.ErrEmailNotVerified):
+			return nil, status.Error(codes.FailedPrecondition, errs.ErrEmailNotVerified.Error())
+		case errors.Is(err, errs.ErrAccountLocked):
+			return nil, status.Error(codes.ResourceExhausted, errs.ErrAccountLocked.Error())
+		case errors.Is(err, errs.ErrTooManyLoginAttempts):
+			return nil, status.Error(codes.ResourceExhausted, errs.ErrTooManyLoginAttempts.Error())
+		}
+
+		return nil, fmt.Errorf("failed to login: %w", err)
+	}
+
+	return mapper.ToLoginResponseFromService(result), nil
+}
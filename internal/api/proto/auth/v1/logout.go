@@ -0,0 +1,41 @@
+package authv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/auth_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Logout revokes caller's access token and its refresh token family.
+func (a *Implementation) Logout(ctx context.Context, request *desc.LogoutRequest) (*emptypb.Empty, error) {
+	logger := a.logger.With("method", "Logout")
+
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	err := a.authService.Logout(ctx, accessToken, request.RefreshToken)
+	if err != nil {
+		logger.Error("failed to logout", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrInvalidRefreshToken):
+			return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidRefreshToken.Error())
+		}
+
+		return nil, fmt.Errorf("failed to logout: %w", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,35 @@
+package authv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/mapper"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/auth_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RefreshToken exchanges refresh token for a new pair of tokens.
+func (a *Implementation) RefreshToken(
+	ctx context.Context,
+	request *desc.RefreshTokenRequest,
+) (*desc.RefreshTokenResponse, error) {
+	logger := a.logger.With("method", "RefreshToken")
+
+	tokens, err := a.authService.RefreshToken(ctx, request.RefreshToken)
+	if err != nil {
+		logger.Error("failed to refresh token", slog.String("error", err.Error()))
+
+		if errors.Is(err, errs.ErrInvalidRefreshToken) {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidRefreshToken.Error())
+		}
+
+		return nil, fmt.Errorf("failed to refresh token: %w", err)
+	}
+
+	return mapper.ToRefreshTokenResponseFromService(tokens), nil
+}
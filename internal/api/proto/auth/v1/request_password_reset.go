@@ -0,0 +1,27 @@
+package authv1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/mapper"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/auth_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// RequestPasswordReset sends password reset link to the user email.
+func (a *Implementation) RequestPasswordReset(
+	ctx context.Context,
+	request *desc.RequestPasswordResetRequest,
+) (*emptypb.Empty, error) {
+	logger := a.logger.With("method", "RequestPasswordReset")
+
+	err := a.passwordResetService.Request(ctx, mapper.ToOrgIDFromDesc(request.OrgId), request.Email)
+	if err != nil {
+		logger.Error("failed to request password reset", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to request password reset: %w", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,49 @@
+package authv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/auth_v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// RevokeAPIKey revokes an API key of the authenticated user.
+func (a *Implementation) RevokeAPIKey(ctx context.Context, request *desc.RevokeAPIKeyRequest) (*emptypb.Empty, error) {
+	logger := a.logger.
+		With("method", "RevokeAPIKey").
+		With("id", request.Id)
+
+	id, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, errs.ErrAPIKeyNotFound.Error())
+	}
+
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	err = a.apiKeyService.Revoke(ctx, accessToken, id)
+	if err != nil {
+		logger.Error("failed to revoke api key", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrAPIKeyNotFound):
+			return nil, status.Error(codes.NotFound, errs.ErrAPIKeyNotFound.Error())
+		}
+
+		return nil, fmt.Errorf("failed to revoke api key: %w", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,50 @@
+package authv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/auth_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// RevokeOAuthClient revokes an OAuth client.
+func (a *Implementation) RevokeOAuthClient(
+	ctx context.Context,
+	request *desc.RevokeOAuthClientRequest,
+) (*emptypb.Empty, error) {
+	logger := a.logger.
+		With("method", "RevokeOAuthClient").
+		With("client_id", request.ClientId)
+
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	err := a.oauthService.RevokeClient(ctx, accessToken, request.ClientId)
+	if err != nil {
+		logger.Error("failed to revoke oauth client", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrAccessDenied):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrAccessDenied.Error())
+		case errors.Is(err, errs.ErrMFARequired):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrMFARequired.Error())
+		case errors.Is(err, errs.ErrOAuthClientNotFound):
+			return nil, status.Error(codes.NotFound, errs.ErrOAuthClientNotFound.Error())
+		}
+
+		return nil, fmt.Errorf("failed to revoke oauth client: %w", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
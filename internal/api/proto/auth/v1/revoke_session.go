@@ -0,0 +1,54 @@
+package authv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/auth_v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// RevokeSession signs the session out.
+func (a *Implementation) RevokeSession(
+	ctx context.Context,
+	request *desc.RevokeSessionRequest,
+) (*emptypb.Empty, error) {
+	logger := a.logger.
+		With("method", "RevokeSession").
+		With("session_id", request.SessionId)
+
+	id, err := uuid.Parse(request.SessionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, errs.ErrSessionNotFound.Error())
+	}
+
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	err = a.authService.RevokeSession(ctx, accessToken, id)
+	if err != nil {
+		logger.Error("failed to revoke session", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrSessionNotFound):
+			return nil, status.Error(codes.NotFound, errs.ErrSessionNotFound.Error())
+		case errors.Is(err, errs.ErrMFARequired):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrMFARequired.Error())
+		}
+
+		return nil, fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,48 @@
+package authv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/auth_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// RevokeUserTokens revokes all tokens of the user.
+func (a *Implementation) RevokeUserTokens(
+	ctx context.Context,
+	request *desc.RevokeUserTokensRequest,
+) (*emptypb.Empty, error) {
+	logger := a.logger.
+		With("method", "RevokeUserTokens").
+		With("user_id", request.UserId)
+
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	err := a.authService.RevokeUserTokens(ctx, accessToken, request.UserId)
+	if err != nil {
+		logger.Error("failed to revoke user tokens", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrAccessDenied):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrAccessDenied.Error())
+		case errors.Is(err, errs.ErrMFARequired):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrMFARequired.Error())
+		}
+
+		return nil, fmt.Errorf("failed to revoke user tokens: %w", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,35 @@
+package authv1
+
+import (
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	"github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/auth_v1"
+)
+
+// Implementation of the auth service.
+type Implementation struct {
+	logger               *slog.Logger
+	authService          service.AuthService
+	passwordResetService service.PasswordResetService
+	apiKeyService        service.APIKeyService
+	oauthService         service.OAuthService
+	auth_v1.UnimplementedAuthServer
+}
+
+// NewImplementation creates a new auth service implementation.
+func NewImplementation(
+	logger *slog.Logger,
+	authService service.AuthService,
+	passwordResetService service.PasswordResetService,
+	apiKeyService service.APIKeyService,
+	oauthService service.OAuthService,
+) *Implementation {
+	return &Implementation{
+		logger:               logger,
+		authService:          authService,
+		passwordResetService: passwordResetService,
+		apiKeyService:        apiKeyService,
+		oauthService:         oauthService,
+	}
+}
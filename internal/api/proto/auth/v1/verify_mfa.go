@@ -0,0 +1,38 @@
+package authv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/mapper"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/auth_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// VerifyMFA completes login with the second factor.
+func (a *Implementation) VerifyMFA(
+	ctx context.Context,
+	request *desc.VerifyMFARequest,
+) (*desc.VerifyMFAResponse, error) {
+	logger := a.logger.With("method", "VerifyMFA")
+
+	tokens, err := a.authService.VerifyMFA(ctx, request.MfaToken, request.Code)
+	if err != nil {
+		logger.Error("failed to verify mfa", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidMFAChallenge):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidMFAChallenge.Error())
+		case errors.Is(err, errs.ErrInvalidMFACode):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidMFACode.Error())
+		}
+
+		return nil, fmt.Errorf("failed to verify mfa: %w", err)
+	}
+
+	return mapper.ToVerifyMFAResponseFromService(tokens), nil
+}
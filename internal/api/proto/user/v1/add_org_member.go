@@ -0,0 +1,44 @@
+package userv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AddOrgMember adds the user of the caller's organization to another organization.
+func (u *Implementation) AddOrgMember(
+	ctx context.Context,
+	request *desc.AddOrgMemberRequest,
+) (*desc.AddOrgMemberResponse, error) {
+	logger := u.logger.
+		With("method", "AddOrgMember").
+		With("user_id", request.UserId).
+		With("org_id", request.OrgId)
+
+	err := u.userService.AddToOrg(ctx, request.UserId, request.OrgId)
+	if err != nil {
+		logger.Error("failed to add organization member", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrAccessDenied):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrAccessDenied.Error())
+		case errors.Is(err, errs.ErrMFARequired):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrMFARequired.Error())
+		case errors.Is(err, errs.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, errs.ErrUserNotFound.Error())
+		}
+
+		return nil, fmt.Errorf("failed to add organization member: %w", err)
+	}
+
+	return &desc.AddOrgMemberResponse{}, nil
+}
@@ -0,0 +1,52 @@
+package userv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AssignRole assigns the role to the user.
+func (u *Implementation) AssignRole(
+	ctx context.Context,
+	request *desc.AssignRoleRequest,
+) (*desc.AssignRoleResponse, error) {
+	logger := u.logger.
+		With("method", "AssignRole").
+		With("user_id", request.UserId).
+		With("role_id", request.RoleId)
+
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	err := u.roleService.Assign(ctx, accessToken, request.UserId, request.RoleId)
+	if err != nil {
+		logger.Error("failed to assign role", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrAccessDenied):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrAccessDenied.Error())
+		case errors.Is(err, errs.ErrMFARequired):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrMFARequired.Error())
+		case errors.Is(err, errs.ErrRoleNotFound):
+			return nil, status.Error(codes.NotFound, errs.ErrRoleNotFound.Error())
+		case errors.Is(err, errs.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, errs.ErrUserNotFound.Error())
+		}
+
+		return nil, fmt.Errorf("failed to assign role: %w", err)
+	}
+
+	return &desc.AssignRoleResponse{}, nil
+}
@@ -0,0 +1,52 @@
+package userv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/mapper"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ChangePassword changes password of the authenticated user.
+func (u *Implementation) ChangePassword(
+	ctx context.Context,
+	request *desc.ChangePasswordRequest,
+) (*desc.ChangePasswordResponse, error) {
+	logger := u.logger.With("method", "ChangePassword")
+
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	if request.NewPassword != request.NewPasswordConfirm {
+		return nil, status.Error(codes.InvalidArgument, "password and confirm password do not match")
+	}
+
+	err := u.userService.ChangePassword(ctx, accessToken, request.OldPassword, request.NewPassword)
+	if err != nil {
+		logger.Error("failed to change password", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrImpersonatedSession):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrImpersonatedSession.Error())
+		case errors.Is(err, errs.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidCredentials.Error())
+		case errors.Is(err, errs.ErrWeakPassword):
+			return nil, mapper.ToInvalidArgumentStatusFromError(err, errs.ErrWeakPassword.Error())
+		}
+
+		return nil, fmt.Errorf("failed to change password: %w", err)
+	}
+
+	return &desc.ChangePasswordResponse{}, nil
+}
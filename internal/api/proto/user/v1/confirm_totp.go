@@ -0,0 +1,51 @@
+package userv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ConfirmTOTP enables two-factor authentication of the authenticated user.
+func (u *Implementation) ConfirmTOTP(
+	ctx context.Context,
+	request *desc.ConfirmTOTPRequest,
+) (*desc.ConfirmTOTPResponse, error) {
+	logger := u.logger.With("method", "ConfirmTOTP")
+
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	recoveryCodes, err := u.mfaService.ConfirmTOTP(ctx, accessToken, request.Code)
+	if err != nil {
+		logger.Error("failed to confirm totp", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrImpersonatedSession):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrImpersonatedSession.Error())
+		case errors.Is(err, errs.ErrInvalidMFACode):
+			return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidMFACode.Error())
+		case errors.Is(err, errs.ErrMFAAlreadyEnabled):
+			return nil, status.Error(codes.FailedPrecondition, errs.ErrMFAAlreadyEnabled.Error())
+		case errors.Is(err, errs.ErrMFANotEnrolled):
+			return nil, status.Error(codes.FailedPrecondition, errs.ErrMFANotEnrolled.Error())
+		case errors.Is(err, errs.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, errs.ErrUserNotFound.Error())
+		}
+
+		return nil, fmt.Errorf("failed to confirm totp: %w", err)
+	}
+
+	return &desc.ConfirmTOTPResponse{RecoveryCodes: recoveryCodes}, nil
+}
@@ -0,0 +1,52 @@
+package userv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/mapper"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateRole creates a custom role.
+func (u *Implementation) CreateRole(
+	ctx context.Context,
+	request *desc.CreateRoleRequest,
+) (*desc.CreateRoleResponse, error) {
+	logger := u.logger.
+		With("method", "CreateRole").
+		With("name", request.Name)
+
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	id, err := u.roleService.Create(ctx, accessToken, mapper.ToRoleFromCreateRoleRequest(request))
+	if err != nil {
+		logger.Error("failed to create role", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrAccessDenied):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrAccessDenied.Error())
+		case errors.Is(err, errs.ErrMFARequired):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrMFARequired.Error())
+		case errors.Is(err, errs.ErrInvalidRole):
+			return nil, mapper.ToInvalidArgumentStatusFromError(err, errs.ErrInvalidRole.Error())
+		case errors.Is(err, errs.ErrRoleAlreadyExists):
+			return nil, status.Error(codes.AlreadyExists, errs.ErrRoleAlreadyExists.Error())
+		}
+
+		return nil, fmt.Errorf("failed to create role: %w", err)
+	}
+
+	return &desc.CreateRoleResponse{Id: id}, nil
+}
@@ -2,14 +2,18 @@ package userv1
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
 	"github.com/Paul1k96/microservices_course_auth/internal/mapper"
 	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-// Create new user.
+// Create new user, anonymous callers always create them in the default organization.
 func (u *Implementation) Create(ctx context.Context, request *desc.CreateRequest) (*desc.CreateResponse, error) {
 	logger := u.logger.
 		With("method", "Create").
@@ -26,6 +30,16 @@ func (u *Implementation) Create(ctx context.Context, request *desc.CreateRequest
 	userID, err := u.userService.Create(ctx, mapper.ToUserFromCreateRequest(request))
 	if err != nil {
 		logger.Error("failed to create user", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrWeakPassword):
+			return nil, mapper.ToInvalidArgumentStatusFromError(err, errs.ErrWeakPassword.Error())
+		case errors.Is(err, errs.ErrAccessDenied):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrAccessDenied.Error())
+		case errors.Is(err, errs.ErrMFARequired):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrMFARequired.Error())
+		}
+
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
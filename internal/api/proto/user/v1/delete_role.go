@@ -0,0 +1,51 @@
+package userv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeleteRole deletes the custom role.
+func (u *Implementation) DeleteRole(
+	ctx context.Context,
+	request *desc.DeleteRoleRequest,
+) (*desc.DeleteRoleResponse, error) {
+	logger := u.logger.
+		With("method", "DeleteRole").
+		With("role_id", request.Id)
+
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	err := u.roleService.Delete(ctx, accessToken, request.Id)
+	if err != nil {
+		logger.Error("failed to delete role", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrAccessDenied):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrAccessDenied.Error())
+		case errors.Is(err, errs.ErrMFARequired):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrMFARequired.Error())
+		case errors.Is(err, errs.ErrRoleNotFound):
+			return nil, status.Error(codes.NotFound, errs.ErrRoleNotFound.Error())
+		case errors.Is(err, errs.ErrBuiltInRole):
+			return nil, status.Error(codes.FailedPrecondition, errs.ErrBuiltInRole.Error())
+		}
+
+		return nil, fmt.Errorf("failed to delete role: %w", err)
+	}
+
+	return &desc.DeleteRoleResponse{}, nil
+}
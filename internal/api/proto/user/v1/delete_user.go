@@ -2,14 +2,18 @@ package userv1
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
 	"github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
 	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-// Delete user by id.
+// Delete user by id within the organization of the caller.
 func (u *Implementation) Delete(ctx context.Context, request *desc.DeleteRequest) (*desc.DeleteResponse, error) {
 	logger := u.logger.
 		With("method", "Delete").
@@ -18,6 +22,16 @@ func (u *Implementation) Delete(ctx context.Context, request *desc.DeleteRequest
 	err := u.userService.Delete(ctx, request.Id)
 	if err != nil {
 		logger.Error("failed to delete user", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, errs.ErrUserNotFound.Error())
+		case errors.Is(err, errs.ErrAccessDenied):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrAccessDenied.Error())
+		}
+
 		return nil, fmt.Errorf("failed to delete user: %w", err)
 	}
 
@@ -0,0 +1,51 @@
+package userv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DisableTOTP disables two-factor authentication of the authenticated user.
+func (u *Implementation) DisableTOTP(
+	ctx context.Context,
+	request *desc.DisableTOTPRequest,
+) (*desc.DisableTOTPResponse, error) {
+	logger := u.logger.With("method", "DisableTOTP")
+
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	err := u.mfaService.DisableTOTP(ctx, accessToken, request.Code)
+	if err != nil {
+		logger.Error("failed to disable totp", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrImpersonatedSession):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrImpersonatedSession.Error())
+		case errors.Is(err, errs.ErrInvalidMFACode):
+			return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidMFACode.Error())
+		case errors.Is(err, errs.ErrMFARequired):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrMFARequired.Error())
+		case errors.Is(err, errs.ErrMFANotEnrolled):
+			return nil, status.Error(codes.FailedPrecondition, errs.ErrMFANotEnrolled.Error())
+		case errors.Is(err, errs.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, errs.ErrUserNotFound.Error())
+		}
+
+		return nil, fmt.Errorf("failed to disable totp: %w", err)
+	}
+
+	return &desc.DisableTOTPResponse{}, nil
+}
@@ -0,0 +1,45 @@
+package userv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/mapper"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EnrollTOTP generates a TOTP secret for the authenticated user.
+func (u *Implementation) EnrollTOTP(ctx context.Context, _ *desc.EnrollTOTPRequest) (*desc.EnrollTOTPResponse, error) {
+	logger := u.logger.With("method", "EnrollTOTP")
+
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	enrollment, err := u.mfaService.EnrollTOTP(ctx, accessToken)
+	if err != nil {
+		logger.Error("failed to enroll totp", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrImpersonatedSession):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrImpersonatedSession.Error())
+		case errors.Is(err, errs.ErrMFAAlreadyEnabled):
+			return nil, status.Error(codes.FailedPrecondition, errs.ErrMFAAlreadyEnabled.Error())
+		case errors.Is(err, errs.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, errs.ErrUserNotFound.Error())
+		}
+
+		return nil, fmt.Errorf("failed to enroll totp: %w", err)
+	}
+
+	return mapper.ToEnrollTOTPResponseFromService(enrollment), nil
+}
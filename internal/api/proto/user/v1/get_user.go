@@ -2,22 +2,40 @@ package userv1
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
 	"github.com/Paul1k96/microservices_course_auth/internal/mapper"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
 	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-// Get user by id.
+// Get user by id within the organization of the caller.
 func (u *Implementation) Get(ctx context.Context, request *desc.GetRequest) (*desc.GetResponse, error) {
 	logger := u.logger.
 		With("method", "Get").
 		With("user_id", request.Id)
 
-	user, err := u.userService.GetByID(ctx, request.Id)
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	user, err := u.userService.Get(ctx, accessToken, request.Id)
 	if err != nil {
 		logger.Error("failed to get user", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, errs.ErrUserNotFound.Error())
+		}
+
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
@@ -2,21 +2,36 @@ package userv1
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
 	"github.com/Paul1k96/microservices_course_auth/internal/mapper"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
 	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-// List returns list of users.
+// List returns list of users within the organization of the caller.
 func (u *Implementation) List(ctx context.Context, request *desc.GetListRequest) (*desc.GetListResponse, error) {
 	logger := u.logger.
 		With("method", "GetList").
 		With("ids", request.GetIds())
 
-	users, err := u.userService.GetListByIDs(ctx, request.GetIds())
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	users, err := u.userService.GetListByIDs(ctx, accessToken, request.GetIds())
 	if err != nil {
 		logger.Error("failed to get users", "error", err)
+
+		if errors.Is(err, errs.ErrInvalidAccessToken) {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		}
+
 		return nil, fmt.Errorf("failed to get users: %w", err)
 	}
 
@@ -0,0 +1,26 @@
+package userv1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/mapper"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
+)
+
+// ResendVerification sends a new verification link to the user email unless it is already verified.
+func (u *Implementation) ResendVerification(
+	ctx context.Context,
+	request *desc.ResendVerificationRequest,
+) (*desc.ResendVerificationResponse, error) {
+	logger := u.logger.With("method", "ResendVerification")
+
+	err := u.emailVerificationService.Resend(ctx, mapper.ToOrgIDFromDesc(request.OrgId), request.Email)
+	if err != nil {
+		logger.Error("failed to resend email verification", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to resend email verification: %w", err)
+	}
+
+	return &desc.ResendVerificationResponse{}, nil
+}
@@ -0,0 +1,56 @@
+package userv1
+
+import (
+	"github.com/Paul1k96/microservices_course_auth/internal/interceptor"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
+)
+
+// Rules returns authorization rules of the user service methods. Users may read and update
+// themselves, while listing, searching, deleting, adding to organizations and changing roles
+// of users is left to admins. Methods missing here authorize the caller themselves.
+func Rules() interceptor.Rules {
+	return interceptor.Rules{
+		"/user_v1.User/Create": {
+			Public: func(req interface{}) bool {
+				role := req.(*desc.CreateRequest).Role
+				return role == desc.Role_UNKNOWN || role == desc.Role_USER
+			},
+			Permission: model.PermissionManageUsers,
+		},
+		"/user_v1.User/Get": {
+			Self: func(req interface{}) (int64, bool) {
+				return req.(*desc.GetRequest).Id, true
+			},
+			Permission: model.PermissionManageUsers,
+		},
+		"/user_v1.User/List": {
+			Permission: model.PermissionManageUsers,
+		},
+		"/user_v1.User/Search": {
+			Permission: model.PermissionManageUsers,
+		},
+		"/user_v1.User/Update": {
+			Self: func(req interface{}) (int64, bool) {
+				request := req.(*desc.UpdateRequest)
+				return request.Id, request.Role == desc.Role_UNKNOWN
+			},
+			Permission: model.PermissionManageUsers,
+		},
+		"/user_v1.User/Delete": {
+			Permission: model.PermissionManageUsers,
+		},
+		"/user_v1.User/AssignRole": {
+			Permission: model.PermissionManageRoles,
+		},
+		"/user_v1.User/UnassignRole": {
+			Permission: model.PermissionManageRoles,
+		},
+		"/user_v1.User/AddOrgMember": {
+			Permission: model.PermissionManageUsers,
+		},
+		"/user_v1.User/SetOrgMemberRole": {
+			Permission: model.PermissionManageRoles,
+		},
+	}
+}
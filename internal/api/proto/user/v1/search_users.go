@@ -0,0 +1,46 @@
+package userv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/mapper"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Search returns a page of users within the organization of the caller matching the filters.
+func (u *Implementation) Search(ctx context.Context, request *desc.SearchRequest) (*desc.SearchResponse, error) {
+	logger := u.logger.With("method", "Search")
+
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	page, err := u.userService.Search(
+		ctx,
+		accessToken,
+		mapper.ToUserFilterFromSearchRequest(request),
+		request.GetPageToken(),
+	)
+	if err != nil {
+		logger.Error("failed to search users", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrInvalidPageToken):
+			return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidPageToken.Error())
+		}
+
+		return nil, fmt.Errorf("failed to search users: %w", err)
+	}
+
+	return mapper.ToSearchResponseFromUserService(page), nil
+}
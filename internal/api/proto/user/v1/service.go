@@ -9,12 +9,30 @@ import (
 
 // Implementation of the user service.
 type Implementation struct {
-	logger      *slog.Logger
-	userService service.UserService
+	logger                   *slog.Logger
+	userService              service.UserService
+	emailVerificationService service.EmailVerificationService
+	lockoutService           service.LockoutService
+	mfaService               service.MFAService
+	roleService              service.RoleService
 	user_v1.UnimplementedUserServer
 }
 
 // NewImplementation creates a new user service implementation.
-func NewImplementation(logger *slog.Logger, userService service.UserService) *Implementation {
-	return &Implementation{logger: logger, userService: userService}
+func NewImplementation(
+	logger *slog.Logger,
+	userService service.UserService,
+	emailVerificationService service.EmailVerificationService,
+	lockoutService service.LockoutService,
+	mfaService service.MFAService,
+	roleService service.RoleService,
+) *Implementation {
+	return &Implementation{
+		logger:                   logger,
+		userService:              userService,
+		emailVerificationService: emailVerificationService,
+		lockoutService:           lockoutService,
+		mfaService:               mfaService,
+		roleService:              roleService,
+	}
 }
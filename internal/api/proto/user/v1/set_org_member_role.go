@@ -0,0 +1,52 @@
+package userv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetOrgMemberRole sets the role the user holds in the caller's organization.
+func (u *Implementation) SetOrgMemberRole(
+	ctx context.Context,
+	request *desc.SetOrgMemberRoleRequest,
+) (*desc.SetOrgMemberRoleResponse, error) {
+	logger := u.logger.
+		With("method", "SetOrgMemberRole").
+		With("user_id", request.UserId).
+		With("role_id", request.RoleId)
+
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	err := u.roleService.SetOrgRole(ctx, accessToken, request.UserId, request.RoleId)
+	if err != nil {
+		logger.Error("failed to set organization member role", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrAccessDenied):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrAccessDenied.Error())
+		case errors.Is(err, errs.ErrMFARequired):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrMFARequired.Error())
+		case errors.Is(err, errs.ErrRoleNotFound):
+			return nil, status.Error(codes.NotFound, errs.ErrRoleNotFound.Error())
+		case errors.Is(err, errs.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, errs.ErrUserNotFound.Error())
+		}
+
+		return nil, fmt.Errorf("failed to set organization member role: %w", err)
+	}
+
+	return &desc.SetOrgMemberRoleResponse{}, nil
+}
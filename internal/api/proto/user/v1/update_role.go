@@ -0,0 +1,54 @@
+package userv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/mapper"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UpdateRole replaces description and permissions of the custom role.
+func (u *Implementation) UpdateRole(
+	ctx context.Context,
+	request *desc.UpdateRoleRequest,
+) (*desc.UpdateRoleResponse, error) {
+	logger := u.logger.
+		With("method", "UpdateRole").
+		With("role_id", request.Id)
+
+	accessToken, ok := token.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+	}
+
+	err := u.roleService.Update(ctx, accessToken, mapper.ToRoleFromUpdateRoleRequest(request))
+	if err != nil {
+		logger.Error("failed to update role", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrAccessDenied):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrAccessDenied.Error())
+		case errors.Is(err, errs.ErrMFARequired):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrMFARequired.Error())
+		case errors.Is(err, errs.ErrInvalidRole):
+			return nil, mapper.ToInvalidArgumentStatusFromError(err, errs.ErrInvalidRole.Error())
+		case errors.Is(err, errs.ErrRoleNotFound):
+			return nil, status.Error(codes.NotFound, errs.ErrRoleNotFound.Error())
+		case errors.Is(err, errs.ErrBuiltInRole):
+			return nil, status.Error(codes.FailedPrecondition, errs.ErrBuiltInRole.Error())
+		}
+
+		return nil, fmt.Errorf("failed to update role: %w", err)
+	}
+
+	return &desc.UpdateRoleResponse{}, nil
+}
@@ -2,15 +2,19 @@ package userv1
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
 	"github.com/Paul1k96/microservices_course_auth/internal/mapper"
 	"github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
 	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-// Update user fields.
+// Update user fields within the organization of the caller.
 func (u *Implementation) Update(ctx context.Context, request *desc.UpdateRequest) (*desc.UpdateResponse, error) {
 	logger := u.logger.
 		With("method", "Update").
@@ -19,6 +23,18 @@ func (u *Implementation) Update(ctx context.Context, request *desc.UpdateRequest
 	err := u.userService.Update(ctx, mapper.ToUserFromUpdateRequest(request))
 	if err != nil {
 		logger.Error("failed to update user", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		case errors.Is(err, errs.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, errs.ErrUserNotFound.Error())
+		case errors.Is(err, errs.ErrImpersonatedSession):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrImpersonatedSession.Error())
+		case errors.Is(err, errs.ErrAccessDenied):
+			return nil, status.Error(codes.PermissionDenied, errs.ErrAccessDenied.Error())
+		}
+
 		return nil, fmt.Errorf("failed to update user: %w", err)
 	}
 
@@ -0,0 +1,34 @@
+package userv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// VerifyEmail verifies user email using the verification token.
+func (u *Implementation) VerifyEmail(
+	ctx context.Context,
+	request *desc.VerifyEmailRequest,
+) (*desc.VerifyEmailResponse, error) {
+	logger := u.logger.With("method", "VerifyEmail")
+
+	err := u.emailVerificationService.Verify(ctx, request.Token)
+	if err != nil {
+		logger.Error("failed to verify email", slog.String("error", err.Error()))
+
+		if errors.Is(err, errs.ErrInvalidEmailVerificationToken) {
+			return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidEmailVerificationToken.Error())
+		}
+
+		return nil, fmt.Errorf("failed to verify email: %w", err)
+	}
+
+	return &desc.VerifyEmailResponse{}, nil
+}
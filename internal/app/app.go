@@ -11,9 +11,20 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
 	"syscall"
-
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/api/http/jwks"
+	"github.com/Paul1k96/microservices_course_auth/internal/api/http/oauth"
+	"github.com/Paul1k96/microservices_course_auth/internal/api/http/ratelimit"
+	accessv1 "github.com/Paul1k96/microservices_course_auth/internal/api/proto/access/v1"
+	userv1 "github.com/Paul1k96/microservices_course_auth/internal/api/proto/user/v1"
+	"github.com/Paul1k96/microservices_course_auth/internal/certs"
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
 	"github.com/Paul1k96/microservices_course_auth/internal/interceptor"
+	"github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/access_v1"
+	"github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/auth_v1"
 	"github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
 	_ "github.com/Paul1k96/microservices_course_auth/statik" // required for statik
 	"github.com/Paul1k96/microservices_course_platform_common/pkg/closer"
@@ -22,7 +33,6 @@ import (
 	"github.com/rs/cors"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -57,6 +67,14 @@ func (a *App) Run(ctx context.Context) error {
 		closer.Wait()
 	}()
 
+	workerPool, err := a.serviceProvider.WorkerPool()
+	if err != nil {
+		return fmt.Errorf("failed to get worker pool: %w", err)
+	}
+
+	// Servers are stopped by then, so queued tasks are done before their connections are closed.
+	defer workerPool.Stop()
+
 	ctx, cancel := context.WithCancel(ctx)
 
 	errGroup, ctx := errgroup.WithContext(ctx)
@@ -89,6 +107,16 @@ func (a *App) Run(ctx context.Context) error {
 		return nil
 	})
 
+	errGroup.Go(func() error {
+		err := a.runSigningKeyRotation(ctx)
+		if err != nil {
+			a.logger.Error("failed to run signing key rotation", slog.String("error", err.Error()))
+			return fmt.Errorf("failed to run signing key rotation: %w", err)
+		}
+
+		return nil
+	})
+
 	errGroup.Go(func() error {
 		err := a.runGRPCServer(ctx)
 		if err != nil {
@@ -129,6 +157,8 @@ func (a *App) Run(ctx context.Context) error {
 func (a *App) initDeps(ctx context.Context) error {
 	inits := []func(context.Context) error{
 		a.initServiceProvider,
+		a.initPermissions,
+		a.initSigningKeys,
 		a.initGRPCServer,
 		a.initHTTPServer,
 		a.initSwaggerServer,
@@ -149,10 +179,81 @@ func (a *App) initServiceProvider(_ context.Context) error {
 	return nil
 }
 
+// initPermissions creates the permissions the access policy requires and grants the configured role permissions.
+func (a *App) initPermissions(ctx context.Context) error {
+	accessConfig, err := a.serviceProvider.AccessConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get access config: %w", err)
+	}
+
+	roleService, err := a.serviceProvider.RoleService(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get role service: %w", err)
+	}
+
+	var permissions []string
+	for _, required := range accessConfig.GetPolicy() {
+		permissions = append(permissions, required...)
+	}
+
+	slices.Sort(permissions)
+
+	err = roleService.Seed(ctx, slices.Compact(permissions), accessConfig.GetRolePermissions())
+	if err != nil {
+		return fmt.Errorf("failed to seed permissions: %w", err)
+	}
+
+	return nil
+}
+
+func (a *App) initSigningKeys(ctx context.Context) error {
+	signingKeyService, err := a.serviceProvider.SigningKeyService(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get signing key service: %w", err)
+	}
+
+	err = signingKeyService.Rotate(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to rotate signing keys: %w", err)
+	}
+
+	return nil
+}
+
 func (a *App) initGRPCServer(ctx context.Context) error {
+	sessionService, err := a.serviceProvider.SessionService(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get session service: %w", err)
+	}
+
+	roleService, err := a.serviceProvider.RoleService(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get role service: %w", err)
+	}
+
+	rateLimitService, err := a.serviceProvider.RateLimitService()
+	if err != nil {
+		return fmt.Errorf("failed to get rate limit service: %w", err)
+	}
+
+	grpcConfig, err := a.serviceProvider.GRPCConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get grpc config: %w", err)
+	}
+
+	creds, err := grpcServerCredentials(grpcConfig)
+	if err != nil {
+		return fmt.Errorf("failed to get grpc server credentials: %w", err)
+	}
+
 	a.grpcServer = grpc.NewServer(
-		grpc.Creds(insecure.NewCredentials()),
-		grpc.UnaryInterceptor(interceptor.ValidateInterceptor),
+		grpc.Creds(creds),
+		grpc.ChainUnaryInterceptor(
+			interceptor.NewAuthInterceptor(sessionService, accessv1.CheckMethod),
+			interceptor.NewRateLimitInterceptor(a.logger, rateLimitService),
+			interceptor.NewAuthorizeInterceptor(roleService, userv1.Rules()),
+			interceptor.ValidateInterceptor,
+		),
 	)
 
 	userV1Impl, err := a.serviceProvider.UserV1Impl(ctx)
@@ -160,24 +261,103 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 		return fmt.Errorf("failed to get user v1 implementation: %w", err)
 	}
 
+	authV1Impl, err := a.serviceProvider.AuthV1Impl(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get auth v1 implementation: %w", err)
+	}
+
+	accessV1Impl, err := a.serviceProvider.AccessV1Impl(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get access v1 implementation: %w", err)
+	}
+
 	reflection.Register(a.grpcServer)
 	user_v1.RegisterUserServer(a.grpcServer, userV1Impl)
+	auth_v1.RegisterAuthServer(a.grpcServer, authV1Impl)
+	access_v1.RegisterAccessServer(a.grpcServer, accessV1Impl)
 
 	return nil
 }
 
 func (a *App) initHTTPServer(ctx context.Context) error {
-	mux := runtime.NewServeMux()
+	rateLimitService, err := a.serviceProvider.RateLimitService()
+	if err != nil {
+		return fmt.Errorf("failed to get rate limit service: %w", err)
+	}
+
+	mux := runtime.NewServeMux(
+		runtime.WithMiddlewares(ratelimit.NewMiddleware(a.logger, rateLimitService)),
+		runtime.WithOutgoingHeaderMatcher(ratelimit.OutgoingHeaderMatcher),
+	)
+
+	grpcConfig, err := a.serviceProvider.GRPCConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get grpc config: %w", err)
+	}
+
+	creds, err := grpcGatewayCredentials(grpcConfig)
+	if err != nil {
+		return fmt.Errorf("failed to get grpc gateway credentials: %w", err)
+	}
 
 	grpcOpts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
 	}
 
-	err := user_v1.RegisterUserHandlerFromEndpoint(ctx, mux, a.serviceProvider.GRPCConfig().GetAddress(), grpcOpts)
+	err = user_v1.RegisterUserHandlerFromEndpoint(ctx, mux, grpcConfig.GetAddress(), grpcOpts)
 	if err != nil {
 		return fmt.Errorf("failed to register user handler from endpoint: %w", err)
 	}
 
+	err = auth_v1.RegisterAuthHandlerFromEndpoint(ctx, mux, grpcConfig.GetAddress(), grpcOpts)
+	if err != nil {
+		return fmt.Errorf("failed to register auth handler from endpoint: %w", err)
+	}
+
+	err = access_v1.RegisterAccessHandlerFromEndpoint(ctx, mux, grpcConfig.GetAddress(), grpcOpts)
+	if err != nil {
+		return fmt.Errorf("failed to register access handler from endpoint: %w", err)
+	}
+
+	jwksHandler, err := a.serviceProvider.JWKSHandler(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get jwks handler: %w", err)
+	}
+
+	err = mux.HandlePath(http.MethodGet, jwks.Path, func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		jwksHandler.ServeHTTP(w, r)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register jwks handler: %w", err)
+	}
+
+	oauthHandler, err := a.serviceProvider.OAuthHandler(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get oauth handler: %w", err)
+	}
+
+	oauthRoutes := []struct {
+		method string
+		path   string
+		handle http.HandlerFunc
+	}{
+		{http.MethodGet, oauth.DiscoveryPath, oauthHandler.Discovery},
+		{http.MethodGet, oauth.AuthorizePath, oauthHandler.Authorize},
+		{http.MethodPost, oauth.AuthorizePath, oauthHandler.Authorize},
+		{http.MethodPost, oauth.TokenPath, oauthHandler.Token},
+		{http.MethodGet, oauth.UserInfoPath, oauthHandler.UserInfo},
+		{http.MethodPost, oauth.UserInfoPath, oauthHandler.UserInfo},
+	}
+
+	for _, route := range oauthRoutes {
+		err = mux.HandlePath(route.method, route.path, func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			route.handle(w, r)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to register oauth handler %s %s: %w", route.method, route.path, err)
+		}
+	}
+
 	httpConfig, err := a.serviceProvider.HTTPConfig()
 	if err != nil {
 		return fmt.Errorf("failed to get http config: %w", err)
@@ -196,6 +376,13 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 		Handler:           corsMiddleware.Handler(mux),
 	}
 
+	if httpConfig.GetTLS().IsEnabled() {
+		a.httpServer.TLSConfig, err = certs.NewServerTLSConfig(httpConfig.GetTLS())
+		if err != nil {
+			return fmt.Errorf("failed to create http server tls config: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -222,17 +409,49 @@ func (a *App) initSwaggerServer(_ context.Context) error {
 	return nil
 }
 
+func (a *App) runSigningKeyRotation(ctx context.Context) error {
+	signingKeyService, err := a.serviceProvider.SigningKeyService(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get signing key service: %w", err)
+	}
+
+	cfg, err := a.serviceProvider.SigningKeyConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get signing key config: %w", err)
+	}
+
+	ticker := time.NewTicker(cfg.GetRotationCheckInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			a.logger.Info("signing key rotation is stopping")
+			return nil
+		case <-ticker.C:
+			err = signingKeyService.Rotate(ctx)
+			if err != nil {
+				a.logger.Error("failed to rotate signing keys", slog.String("error", err.Error()))
+			}
+		}
+	}
+}
+
 func (a *App) runGRPCServer(ctx context.Context) error {
-	a.logger.Info("GRPC server is running on", slog.Any("addr", a.serviceProvider.GRPCConfig().GetAddress()))
+	cfg, err := a.serviceProvider.GRPCConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get grpc config: %w", err)
+	}
+
+	a.logger.Info("GRPC server is running on", slog.Any("addr", cfg.GetAddress()))
+
+	lis, err := net.Listen("tcp", cfg.GetAddress())
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
 
 	errChan := make(chan error, 1)
 	go func() {
-		lis, err := net.Listen("tcp", a.serviceProvider.GRPCConfig().GetAddress())
-		if err != nil {
-			errChan <- fmt.Errorf("failed to listen: %w", err)
-			return
-		}
-
 		if err = a.grpcServer.Serve(lis); err != nil {
 			errChan <- fmt.Errorf("failed to serve: %w", err)
 			return
@@ -242,7 +461,7 @@ func (a *App) runGRPCServer(ctx context.Context) error {
 	select {
 	case <-ctx.Done():
 		a.logger.Info("GRPC server is stopping")
-	case err := <-errChan:
+	case err = <-errChan:
 		return fmt.Errorf("failed to run grpc server: %w", err)
 	}
 
@@ -261,7 +480,7 @@ func (a *App) runHTTPServer(ctx context.Context) error {
 
 	errChan := make(chan error, 1)
 	go func() {
-		if err = a.httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		if err = a.listenAndServeHTTP(config); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			errChan <- fmt.Errorf("failed to listen and serve: %w", err)
 			return
 		}
@@ -285,6 +504,15 @@ func (a *App) runHTTPServer(ctx context.Context) error {
 	return nil
 }
 
+// listenAndServeHTTP serves the gateway over TLS once it is configured, certificates come from TLSConfig.
+func (a *App) listenAndServeHTTP(cfg config.HTTPConfig) error {
+	if cfg.GetTLS().IsEnabled() {
+		return a.httpServer.ListenAndServeTLS("", "")
+	}
+
+	return a.httpServer.ListenAndServe()
+}
+
 func (a *App) runSwaggerServer(ctx context.Context) error {
 	config, err := a.serviceProvider.SwaggerConfig()
 	if err != nil {
@@ -6,23 +6,57 @@ import (
 	"log/slog"
 
 	"github.com/IBM/sarama"
+	accessv1 "github.com/Paul1k96/microservices_course_auth/internal/api/proto/access/v1"
+	authv1 "github.com/Paul1k96/microservices_course_auth/internal/api/proto/auth/v1"
 	userv1 "github.com/Paul1k96/microservices_course_auth/internal/api/proto/user/v1"
 	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/cache"
 	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/db/pg"
 	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/db/transaction"
 	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/kafka"
 
+	"github.com/Paul1k96/microservices_course_auth/internal/api/http/jwks"
+	oauthHTTP "github.com/Paul1k96/microservices_course_auth/internal/api/http/oauth"
 	kafkaApi "github.com/Paul1k96/microservices_course_auth/internal/api/kafka"
 	userKafkaV1 "github.com/Paul1k96/microservices_course_auth/internal/api/kafka/user/v1"
 	"github.com/Paul1k96/microservices_course_auth/internal/config"
 	"github.com/Paul1k96/microservices_course_auth/internal/config/env"
+	"github.com/Paul1k96/microservices_course_auth/internal/notifier"
+	"github.com/Paul1k96/microservices_course_auth/internal/password"
 	"github.com/Paul1k96/microservices_course_auth/internal/repository"
+	accessPolicyRedis "github.com/Paul1k96/microservices_course_auth/internal/repository/access_policy/redis"
+	denylistRedis "github.com/Paul1k96/microservices_course_auth/internal/repository/access_token_denylist/redis"
+	apikeypg "github.com/Paul1k96/microservices_course_auth/internal/repository/api_key/pg"
+	authorizationCodeRedis "github.com/Paul1k96/microservices_course_auth/internal/repository/authorization_code/redis"
+	emailtokenpg "github.com/Paul1k96/microservices_course_auth/internal/repository/email_verification_token/pg"
+	loginAttemptRedis "github.com/Paul1k96/microservices_course_auth/internal/repository/login_attempt/redis"
+	mfaChallengeRedis "github.com/Paul1k96/microservices_course_auth/internal/repository/mfa_challenge/redis"
+	oauthclientpg "github.com/Paul1k96/microservices_course_auth/internal/repository/oauth_client/pg"
+	passwordresettokenpg "github.com/Paul1k96/microservices_course_auth/internal/repository/password_reset_token/pg"
+	rateLimitRedis "github.com/Paul1k96/microservices_course_auth/internal/repository/rate_limit/redis"
+	recoverycodepg "github.com/Paul1k96/microservices_course_auth/internal/repository/recovery_code/pg"
+	refreshtokenpg "github.com/Paul1k96/microservices_course_auth/internal/repository/refresh_token/pg"
+	rolepg "github.com/Paul1k96/microservices_course_auth/internal/repository/role/pg"
+	sessionpg "github.com/Paul1k96/microservices_course_auth/internal/repository/session/pg"
+	signingkeypg "github.com/Paul1k96/microservices_course_auth/internal/repository/signing_key/pg"
 	userpg "github.com/Paul1k96/microservices_course_auth/internal/repository/user/pg"
 	userRedis "github.com/Paul1k96/microservices_course_auth/internal/repository/user/redis"
 	usereventsproducer "github.com/Paul1k96/microservices_course_auth/internal/repository/user_event/kafka"
 	usereventspg "github.com/Paul1k96/microservices_course_auth/internal/repository/user_event/pg"
 	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	accessSvc "github.com/Paul1k96/microservices_course_auth/internal/service/access"
+	apiKeySvc "github.com/Paul1k96/microservices_course_auth/internal/service/apikey"
+	authSvc "github.com/Paul1k96/microservices_course_auth/internal/service/auth"
+	emailVerificationSvc "github.com/Paul1k96/microservices_course_auth/internal/service/emailverification"
+	lockoutSvc "github.com/Paul1k96/microservices_course_auth/internal/service/lockout"
+	mfaSvc "github.com/Paul1k96/microservices_course_auth/internal/service/mfa"
+	oauthSvc "github.com/Paul1k96/microservices_course_auth/internal/service/oauth"
+	passwordResetSvc "github.com/Paul1k96/microservices_course_auth/internal/service/passwordreset"
+	rateLimitSvc "github.com/Paul1k96/microservices_course_auth/internal/service/ratelimit"
+	roleSvc "github.com/Paul1k96/microservices_course_auth/internal/service/role"
+	sessionSvc "github.com/Paul1k96/microservices_course_auth/internal/service/session"
+	signingKeySvc "github.com/Paul1k96/microservices_course_auth/internal/service/signingkey"
 	userSvc "github.com/Paul1k96/microservices_course_auth/internal/service/user"
+	"github.com/Paul1k96/microservices_course_auth/internal/worker"
 	commonRedis "github.com/Paul1k96/microservices_course_platform_common/pkg/client/cache/redis"
 	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/db"
 	kafkaConsumer "github.com/Paul1k96/microservices_course_platform_common/pkg/client/kafka/consumer"
@@ -38,6 +72,19 @@ type serviceProvider struct {
 	swaggerConfig                 config.HTTPConfig
 	kafkaCreateUserConsumerConfig config.KafkaConsumerConfig
 	kafkaUserEventsProducerConfig config.KafkaProducerConfig
+	passwordConfig                config.PasswordConfig
+	passwordPolicyConfig          config.PasswordPolicyConfig
+	tokenConfig                   config.TokenConfig
+	accessConfig                  config.AccessConfig
+	signingKeyConfig              config.SigningKeyConfig
+	notifierConfig                config.NotifierConfig
+	workerConfig                  config.WorkerConfig
+	passwordResetConfig           config.PasswordResetConfig
+	emailVerificationConfig       config.EmailVerificationConfig
+	lockoutConfig                 config.LockoutConfig
+	rateLimitConfig               config.RateLimitConfig
+	mfaConfig                     config.MFAConfig
+	oauthConfig                   config.OAuthConfig
 	logger                        *slog.Logger
 
 	consumerGroupHandler *kafkaConsumer.GroupHandler
@@ -53,10 +100,49 @@ type serviceProvider struct {
 	usersRepository      repository.UsersRepository
 	userEventsRepository repository.UserEventsRepository
 	usersCache           repository.UsersCache
-
-	usersService service.UserService
-
-	userV1Impl *userv1.Implementation
+	refreshTokensRepo    repository.RefreshTokensRepository
+	sessionsRepository   repository.SessionsRepository
+	rolesRepository      repository.RolesRepository
+	accessPolicyCache    repository.AccessPolicyCache
+	signingKeysRepo      repository.SigningKeysRepository
+	accessTokenDenylist  repository.AccessTokenDenylist
+	passwordResetTokens  repository.PasswordResetTokensRepository
+	emailVerifyTokens    repository.EmailVerificationTokensRepository
+	loginAttempts        repository.LoginAttemptsRepository
+	rateLimits           repository.RateLimitRepository
+	recoveryCodes        repository.RecoveryCodesRepository
+	mfaChallenges        repository.MFAChallengesRepository
+	apiKeys              repository.APIKeysRepository
+	oauthClients         repository.OAuthClientsRepository
+	authorizationCodes   repository.AuthorizationCodesRepository
+
+	passwordHasher password.Hasher
+	passwordPolicy *userSvc.PasswordPolicy
+	notifier       notifier.Notifier
+	workerPool     *worker.Pool
+
+	usersService  service.UserService
+	authService   service.AuthService
+	accessService service.AccessService
+
+	passwordResetService     service.PasswordResetService
+	emailVerificationService service.EmailVerificationService
+	lockoutService           service.LockoutService
+	rateLimitService         service.RateLimitService
+	mfaService               service.MFAService
+	apiKeyService            service.APIKeyService
+	oauthService             service.OAuthService
+
+	signingKeyService service.SigningKeyService
+	sessionService    service.SessionService
+	roleService       service.RoleService
+
+	userV1Impl   *userv1.Implementation
+	authV1Impl   *authv1.Implementation
+	accessV1Impl *accessv1.Implementation
+
+	jwksHandler  *jwks.Handler
+	oauthHandler *oauthHTTP.Handler
 }
 
 func newServiceProvider(logger *slog.Logger) *serviceProvider {
@@ -73,12 +159,17 @@ func (s *serviceProvider) PGConfig() config.PGConfig {
 }
 
 // GRPCConfig returns an instance of config.GRPCConfig.
-func (s *serviceProvider) GRPCConfig() config.GRPCConfig {
+func (s *serviceProvider) GRPCConfig() (config.GRPCConfig, error) {
 	if s.grpcConfig == nil {
-		s.grpcConfig = env.NewGRPCConfig()
+		cfg, err := env.NewGRPCConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get grpc config: %w", err)
+		}
+
+		s.grpcConfig = cfg
 	}
 
-	return s.grpcConfig
+	return s.grpcConfig, nil
 }
 
 // HTTPConfig returns an instance of config.HTTPConfig.
@@ -151,6 +242,188 @@ func (s *serviceProvider) KafkaUserEventsProducerConfig() (config.KafkaProducerC
 	return s.kafkaUserEventsProducerConfig, nil
 }
 
+// PasswordConfig returns an instance of config.PasswordConfig.
+func (s *serviceProvider) PasswordConfig() (config.PasswordConfig, error) {
+	if s.passwordConfig == nil {
+		cfg, err := env.NewPasswordConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get password config: %w", err)
+		}
+
+		s.passwordConfig = cfg
+	}
+
+	return s.passwordConfig, nil
+}
+
+// PasswordPolicyConfig returns an instance of config.PasswordPolicyConfig.
+func (s *serviceProvider) PasswordPolicyConfig() (config.PasswordPolicyConfig, error) {
+	if s.passwordPolicyConfig == nil {
+		cfg, err := env.NewPasswordPolicyConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get password policy config: %w", err)
+		}
+
+		s.passwordPolicyConfig = cfg
+	}
+
+	return s.passwordPolicyConfig, nil
+}
+
+// TokenConfig returns an instance of config.TokenConfig.
+func (s *serviceProvider) TokenConfig() (config.TokenConfig, error) {
+	if s.tokenConfig == nil {
+		cfg, err := env.NewTokenConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get token config: %w", err)
+		}
+
+		s.tokenConfig = cfg
+	}
+
+	return s.tokenConfig, nil
+}
+
+// AccessConfig returns an instance of config.AccessConfig.
+func (s *serviceProvider) AccessConfig() (config.AccessConfig, error) {
+	if s.accessConfig == nil {
+		cfg, err := env.NewAccessConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get access config: %w", err)
+		}
+
+		s.accessConfig = cfg
+	}
+
+	return s.accessConfig, nil
+}
+
+// SigningKeyConfig returns an instance of config.SigningKeyConfig.
+func (s *serviceProvider) SigningKeyConfig() (config.SigningKeyConfig, error) {
+	if s.signingKeyConfig == nil {
+		cfg, err := env.NewSigningKeyConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get signing key config: %w", err)
+		}
+
+		s.signingKeyConfig = cfg
+	}
+
+	return s.signingKeyConfig, nil
+}
+
+// NotifierConfig returns an instance of config.NotifierConfig.
+func (s *serviceProvider) NotifierConfig() (config.NotifierConfig, error) {
+	if s.notifierConfig == nil {
+		cfg, err := env.NewNotifierConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get notifier config: %w", err)
+		}
+
+		s.notifierConfig = cfg
+	}
+
+	return s.notifierConfig, nil
+}
+
+// WorkerConfig returns an instance of config.WorkerConfig.
+func (s *serviceProvider) WorkerConfig() (config.WorkerConfig, error) {
+	if s.workerConfig == nil {
+		cfg, err := env.NewWorkerConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get worker config: %w", err)
+		}
+
+		s.workerConfig = cfg
+	}
+
+	return s.workerConfig, nil
+}
+
+// PasswordResetConfig returns an instance of config.PasswordResetConfig.
+func (s *serviceProvider) PasswordResetConfig() (config.PasswordResetConfig, error) {
+	if s.passwordResetConfig == nil {
+		cfg, err := env.NewPasswordResetConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get password reset config: %w", err)
+		}
+
+		s.passwordResetConfig = cfg
+	}
+
+	return s.passwordResetConfig, nil
+}
+
+// EmailVerificationConfig returns an instance of config.EmailVerificationConfig.
+func (s *serviceProvider) EmailVerificationConfig() (config.EmailVerificationConfig, error) {
+	if s.emailVerificationConfig == nil {
+		cfg, err := env.NewEmailVerificationConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get email verification config: %w", err)
+		}
+
+		s.emailVerificationConfig = cfg
+	}
+
+	return s.emailVerificationConfig, nil
+}
+
+// LockoutConfig returns an instance of config.LockoutConfig.
+func (s *serviceProvider) LockoutConfig() (config.LockoutConfig, error) {
+	if s.lockoutConfig == nil {
+		cfg, err := env.NewLockoutConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get lockout config: %w", err)
+		}
+
+		s.lockoutConfig = cfg
+	}
+
+	return s.lockoutConfig, nil
+}
+
+// RateLimitConfig returns an instance of config.RateLimitConfig.
+func (s *serviceProvider) RateLimitConfig() (config.RateLimitConfig, error) {
+	if s.rateLimitConfig == nil {
+		cfg, err := env.NewRateLimitConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get rate limit config: %w", err)
+		}
+
+		s.rateLimitConfig = cfg
+	}
+
+	return s.rateLimitConfig, nil
+}
+
+// MFAConfig returns an instance of config.MFAConfig.
+func (s *serviceProvider) MFAConfig() (config.MFAConfig, error) {
+	if s.mfaConfig == nil {
+		cfg, err := env.NewMFAConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get mfa config: %w", err)
+		}
+
+		s.mfaConfig = cfg
+	}
+
+	return s.mfaConfig, nil
+}
+
+// OAuthConfig returns an instance of config.OAuthConfig.
+func (s *serviceProvider) OAuthConfig() (config.OAuthConfig, error) {
+	if s.oauthConfig == nil {
+		cfg, err := env.NewOAuthConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get oauth config: %w", err)
+		}
+
+		s.oauthConfig = cfg
+	}
+
+	return s.oauthConfig, nil
+}
+
 // RedisPool returns an instance of redigo.Pool.
 func (s *serviceProvider) RedisPool() (*redigo.Pool, error) {
 	if s.redisPool == nil {
@@ -296,6 +569,141 @@ func (s *serviceProvider) UsersRepository(ctx context.Context) (repository.Users
 	return s.usersRepository, nil
 }
 
+// RefreshTokensRepository returns an instance of repository.RefreshTokensRepository.
+func (s *serviceProvider) RefreshTokensRepository(ctx context.Context) (repository.RefreshTokensRepository, error) {
+	if s.refreshTokensRepo == nil {
+		dbClient, err := s.DBClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get db client: %w", err)
+		}
+
+		s.refreshTokensRepo = refreshtokenpg.NewRepository(dbClient.DB())
+	}
+
+	return s.refreshTokensRepo, nil
+}
+
+// SessionsRepository returns an instance of repository.SessionsRepository.
+func (s *serviceProvider) SessionsRepository(ctx context.Context) (repository.SessionsRepository, error) {
+	if s.sessionsRepository == nil {
+		dbClient, err := s.DBClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get db client: %w", err)
+		}
+
+		s.sessionsRepository = sessionpg.NewRepository(dbClient.DB())
+	}
+
+	return s.sessionsRepository, nil
+}
+
+// RolesRepository returns an instance of repository.RolesRepository.
+func (s *serviceProvider) RolesRepository(ctx context.Context) (repository.RolesRepository, error) {
+	if s.rolesRepository == nil {
+		dbClient, err := s.DBClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get db client: %w", err)
+		}
+
+		s.rolesRepository = rolepg.NewRepository(dbClient.DB())
+	}
+
+	return s.rolesRepository, nil
+}
+
+// PasswordResetTokensRepository returns an instance of repository.PasswordResetTokensRepository.
+func (s *serviceProvider) PasswordResetTokensRepository(
+	ctx context.Context,
+) (repository.PasswordResetTokensRepository, error) {
+	if s.passwordResetTokens == nil {
+		dbClient, err := s.DBClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get db client: %w", err)
+		}
+
+		s.passwordResetTokens = passwordresettokenpg.NewRepository(dbClient.DB())
+	}
+
+	return s.passwordResetTokens, nil
+}
+
+// EmailVerificationTokensRepository returns an instance of repository.EmailVerificationTokensRepository.
+func (s *serviceProvider) EmailVerificationTokensRepository(
+	ctx context.Context,
+) (repository.EmailVerificationTokensRepository, error) {
+	if s.emailVerifyTokens == nil {
+		dbClient, err := s.DBClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get db client: %w", err)
+		}
+
+		s.emailVerifyTokens = emailtokenpg.NewRepository(dbClient.DB())
+	}
+
+	return s.emailVerifyTokens, nil
+}
+
+// RecoveryCodesRepository returns an instance of repository.RecoveryCodesRepository.
+func (s *serviceProvider) RecoveryCodesRepository(ctx context.Context) (repository.RecoveryCodesRepository, error) {
+	if s.recoveryCodes == nil {
+		dbClient, err := s.DBClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get db client: %w", err)
+		}
+
+		s.recoveryCodes = recoverycodepg.NewRepository(dbClient.DB())
+	}
+
+	return s.recoveryCodes, nil
+}
+
+// APIKeysRepository returns an instance of repository.APIKeysRepository.
+func (s *serviceProvider) APIKeysRepository(ctx context.Context) (repository.APIKeysRepository, error) {
+	if s.apiKeys == nil {
+		dbClient, err := s.DBClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get db client: %w", err)
+		}
+
+		s.apiKeys = apikeypg.NewRepository(dbClient.DB())
+	}
+
+	return s.apiKeys, nil
+}
+
+// OAuthClientsRepository returns an instance of repository.OAuthClientsRepository.
+func (s *serviceProvider) OAuthClientsRepository(ctx context.Context) (repository.OAuthClientsRepository, error) {
+	if s.oauthClients == nil {
+		dbClient, err := s.DBClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get db client: %w", err)
+		}
+
+		s.oauthClients = oauthclientpg.NewRepository(dbClient.DB())
+	}
+
+	return s.oauthClients, nil
+}
+
+// SigningKeysRepository returns an instance of repository.SigningKeysRepository.
+func (s *serviceProvider) SigningKeysRepository(ctx context.Context) (repository.SigningKeysRepository, error) {
+	if s.signingKeysRepo == nil {
+		dbClient, err := s.DBClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get db client: %w", err)
+		}
+
+		cfg, err := s.SigningKeyConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get signing key config: %w", err)
+		}
+
+		s.signingKeysRepo = signingkeypg.NewRepository(dbClient.DB(), cfg.GetSecretKey())
+	}
+
+	return s.signingKeysRepo, nil
+}
+
 // UserEventsRepository returns an instance of repository.UserEventsRepository.
 func (s *serviceProvider) UserEventsRepository(ctx context.Context) (repository.UserEventsRepository, error) {
 	if s.userEventsRepository == nil {
@@ -344,53 +752,940 @@ func (s *serviceProvider) UsersCache(ctx context.Context) (repository.UsersCache
 			return nil, fmt.Errorf("failed to get cache client: %w", err)
 		}
 
-		s.usersCache = userRedis.NewRepository(cacheClient, cfg.GetUserTTL())
+		pool, err := s.RedisPool()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get redis pool: %w", err)
+		}
+
+		s.usersCache = userRedis.NewRepository(cacheClient, pool, cfg.GetUserTTL())
 	}
 
 	return s.usersCache, nil
 }
 
-// UsersService returns an instance of service.UserService.
-func (s *serviceProvider) UsersService(ctx context.Context) (service.UserService, error) {
-	if s.usersService == nil {
-		txManager, err := s.TxManager(ctx)
+// AccessPolicyCache returns an instance of repository.AccessPolicyCache.
+func (s *serviceProvider) AccessPolicyCache(ctx context.Context) (repository.AccessPolicyCache, error) {
+	if s.accessPolicyCache == nil {
+		cfg, err := s.AccessConfig()
 		if err != nil {
-			return nil, fmt.Errorf("failed to get tx manager: %w", err)
+			return nil, fmt.Errorf("failed to get access config: %w", err)
 		}
 
-		userRepository, err := s.UsersRepository(ctx)
+		cacheClient, err := s.CacheClient(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get users repository: %w", err)
+			return nil, fmt.Errorf("failed to get cache client: %w", err)
 		}
 
-		userEventsProducer, err := s.UserEventsProducer()
+		s.accessPolicyCache = accessPolicyRedis.NewRepository(cacheClient, cfg.GetPolicyCacheTTL())
+	}
+
+	return s.accessPolicyCache, nil
+}
+
+// AccessTokenDenylist returns an instance of repository.AccessTokenDenylist.
+func (s *serviceProvider) AccessTokenDenylist(ctx context.Context) (repository.AccessTokenDenylist, error) {
+	if s.accessTokenDenylist == nil {
+		cacheClient, err := s.CacheClient(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get user events producer: %w", err)
+			return nil, fmt.Errorf("failed to get cache client: %w", err)
 		}
 
-		userCache, err := s.UsersCache(ctx)
+		s.accessTokenDenylist = denylistRedis.NewRepository(cacheClient)
+	}
+
+	return s.accessTokenDenylist, nil
+}
+
+// LoginAttemptsRepository returns an instance of repository.LoginAttemptsRepository.
+func (s *serviceProvider) LoginAttemptsRepository(ctx context.Context) (repository.LoginAttemptsRepository, error) {
+	if s.loginAttempts == nil {
+		cacheClient, err := s.CacheClient(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get users cache: %w", err)
+			return nil, fmt.Errorf("failed to get cache client: %w", err)
+		}
+
+		pool, err := s.RedisPool()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get redis pool: %w", err)
 		}
 
-		s.usersService = userSvc.NewService(s.logger, txManager, userRepository, userEventsProducer, userCache)
+		s.loginAttempts = loginAttemptRedis.NewRepository(cacheClient, pool)
 	}
 
-	return s.usersService, nil
+	return s.loginAttempts, nil
 }
 
-// UserV1Impl returns an instance of user_v1.Implementation.
-func (s *serviceProvider) UserV1Impl(ctx context.Context) (*userv1.Implementation, error) {
-	if s.userV1Impl == nil {
-		usersService, err := s.UsersService(ctx)
+// RateLimitRepository returns an instance of repository.RateLimitRepository.
+func (s *serviceProvider) RateLimitRepository() (repository.RateLimitRepository, error) {
+	if s.rateLimits == nil {
+		pool, err := s.RedisPool()
 		if err != nil {
-			return nil, fmt.Errorf("failed to get users service: %w", err)
+			return nil, fmt.Errorf("failed to get redis pool: %w", err)
 		}
 
-		s.userV1Impl = userv1.NewImplementation(s.logger, usersService)
+		s.rateLimits = rateLimitRedis.NewRepository(pool)
 	}
 
-	return s.userV1Impl, nil
+	return s.rateLimits, nil
+}
+
+// MFAChallengesRepository returns an instance of repository.MFAChallengesRepository.
+func (s *serviceProvider) MFAChallengesRepository(ctx context.Context) (repository.MFAChallengesRepository, error) {
+	if s.mfaChallenges == nil {
+		cacheClient, err := s.CacheClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cache client: %w", err)
+		}
+
+		pool, err := s.RedisPool()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get redis pool: %w", err)
+		}
+
+		s.mfaChallenges = mfaChallengeRedis.NewRepository(cacheClient, pool)
+	}
+
+	return s.mfaChallenges, nil
+}
+
+// AuthorizationCodesRepository returns an instance of repository.AuthorizationCodesRepository.
+func (s *serviceProvider) AuthorizationCodesRepository(
+	ctx context.Context,
+) (repository.AuthorizationCodesRepository, error) {
+	if s.authorizationCodes == nil {
+		cacheClient, err := s.CacheClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cache client: %w", err)
+		}
+
+		pool, err := s.RedisPool()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get redis pool: %w", err)
+		}
+
+		s.authorizationCodes = authorizationCodeRedis.NewRepository(cacheClient, pool)
+	}
+
+	return s.authorizationCodes, nil
+}
+
+// PasswordHasher returns an instance of password.Hasher.
+func (s *serviceProvider) PasswordHasher() (password.Hasher, error) {
+	if s.passwordHasher == nil {
+		cfg, err := s.PasswordConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get password config: %w", err)
+		}
+
+		hasher, err := password.NewHasher(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create password hasher: %w", err)
+		}
+
+		s.passwordHasher = hasher
+	}
+
+	return s.passwordHasher, nil
+}
+
+// PasswordPolicy returns an instance of user.PasswordPolicy.
+func (s *serviceProvider) PasswordPolicy() (*userSvc.PasswordPolicy, error) {
+	if s.passwordPolicy == nil {
+		cfg, err := s.PasswordPolicyConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get password policy config: %w", err)
+		}
+
+		policy, err := userSvc.NewPasswordPolicy(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create password policy: %w", err)
+		}
+
+		s.passwordPolicy = policy
+	}
+
+	return s.passwordPolicy, nil
+}
+
+// Notifier returns an instance of notifier.Notifier.
+func (s *serviceProvider) Notifier() (notifier.Notifier, error) {
+	if s.notifier == nil {
+		cfg, err := s.NotifierConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get notifier config: %w", err)
+		}
+
+		n, err := notifier.NewNotifier(s.logger, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create notifier: %w", err)
+		}
+
+		s.notifier = n
+	}
+
+	return s.notifier, nil
+}
+
+// WorkerPool returns an instance of worker.Pool running background tasks.
+func (s *serviceProvider) WorkerPool() (*worker.Pool, error) {
+	if s.workerPool == nil {
+		cfg, err := s.WorkerConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get worker config: %w", err)
+		}
+
+		s.workerPool = worker.NewPool(s.logger, cfg.GetCount(), cfg.GetQueueSize())
+	}
+
+	return s.workerPool, nil
+}
+
+// UsersService returns an instance of service.UserService.
+func (s *serviceProvider) UsersService(ctx context.Context) (service.UserService, error) {
+	if s.usersService == nil {
+		txManager, err := s.TxManager(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tx manager: %w", err)
+		}
+
+		userRepository, err := s.UsersRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get users repository: %w", err)
+		}
+
+		userEventsProducer, err := s.UserEventsProducer()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user events producer: %w", err)
+		}
+
+		userCache, err := s.UsersCache(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get users cache: %w", err)
+		}
+
+		passwordHasher, err := s.PasswordHasher()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get password hasher: %w", err)
+		}
+
+		passwordPolicy, err := s.PasswordPolicy()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get password policy: %w", err)
+		}
+
+		sessionService, err := s.SessionService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get session service: %w", err)
+		}
+
+		emailVerificationService, err := s.EmailVerificationService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get email verification service: %w", err)
+		}
+
+		roleService, err := s.RoleService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get role service: %w", err)
+		}
+
+		s.usersService = userSvc.NewService(
+			s.logger,
+			txManager,
+			userRepository,
+			userEventsProducer,
+			userCache,
+			passwordHasher,
+			passwordPolicy,
+			sessionService,
+			emailVerificationService,
+			roleService,
+		)
+	}
+
+	return s.usersService, nil
+}
+
+// SigningKeyService returns an instance of service.SigningKeyService.
+func (s *serviceProvider) SigningKeyService(ctx context.Context) (service.SigningKeyService, error) {
+	if s.signingKeyService == nil {
+		signingKeysRepository, err := s.SigningKeysRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get signing keys repository: %w", err)
+		}
+
+		signingKeyConfig, err := s.SigningKeyConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get signing key config: %w", err)
+		}
+
+		tokenConfig, err := s.TokenConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get token config: %w", err)
+		}
+
+		oauthConfig, err := s.OAuthConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get oauth config: %w", err)
+		}
+
+		s.signingKeyService = signingKeySvc.NewService(
+			s.logger,
+			signingKeysRepository,
+			signingKeyConfig,
+			tokenConfig,
+			oauthConfig,
+		)
+	}
+
+	return s.signingKeyService, nil
+}
+
+// RoleService returns an instance of service.RoleService.
+func (s *serviceProvider) RoleService(ctx context.Context) (service.RoleService, error) {
+	if s.roleService == nil {
+		txManager, err := s.TxManager(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tx manager: %w", err)
+		}
+
+		rolesRepository, err := s.RolesRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get roles repository: %w", err)
+		}
+
+		userRepository, err := s.UsersRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get users repository: %w", err)
+		}
+
+		userEventsProducer, err := s.UserEventsProducer()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user events producer: %w", err)
+		}
+
+		userCache, err := s.UsersCache(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get users cache: %w", err)
+		}
+
+		sessionService, err := s.SessionService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get session service: %w", err)
+		}
+
+		s.roleService = roleSvc.NewService(
+			s.logger,
+			txManager,
+			rolesRepository,
+			userRepository,
+			userEventsProducer,
+			userCache,
+			sessionService,
+		)
+	}
+
+	return s.roleService, nil
+}
+
+// SessionService returns an instance of service.SessionService.
+func (s *serviceProvider) SessionService(ctx context.Context) (service.SessionService, error) {
+	if s.sessionService == nil {
+		refreshTokensRepository, err := s.RefreshTokensRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get refresh tokens repository: %w", err)
+		}
+
+		sessionsRepository, err := s.SessionsRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sessions repository: %w", err)
+		}
+
+		accessTokenDenylist, err := s.AccessTokenDenylist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get access token denylist: %w", err)
+		}
+
+		apiKeysRepository, err := s.APIKeysRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get api keys repository: %w", err)
+		}
+
+		userRepository, err := s.UsersRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get users repository: %w", err)
+		}
+
+		userEventsProducer, err := s.UserEventsProducer()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user events producer: %w", err)
+		}
+
+		signingKeyService, err := s.SigningKeyService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get signing key service: %w", err)
+		}
+
+		tokenConfig, err := s.TokenConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get token config: %w", err)
+		}
+
+		s.sessionService = sessionSvc.NewService(
+			s.logger,
+			refreshTokensRepository,
+			sessionsRepository,
+			accessTokenDenylist,
+			apiKeysRepository,
+			userRepository,
+			userEventsProducer,
+			signingKeyService,
+			tokenConfig,
+		)
+	}
+
+	return s.sessionService, nil
+}
+
+// APIKeyService returns an instance of service.APIKeyService.
+func (s *serviceProvider) APIKeyService(ctx context.Context) (service.APIKeyService, error) {
+	if s.apiKeyService == nil {
+		apiKeysRepository, err := s.APIKeysRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get api keys repository: %w", err)
+		}
+
+		sessionService, err := s.SessionService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get session service: %w", err)
+		}
+
+		s.apiKeyService = apiKeySvc.NewService(s.logger, apiKeysRepository, sessionService)
+	}
+
+	return s.apiKeyService, nil
+}
+
+// OAuthService returns an instance of service.OAuthService.
+func (s *serviceProvider) OAuthService(ctx context.Context) (service.OAuthService, error) {
+	if s.oauthService == nil {
+		oauthClientsRepository, err := s.OAuthClientsRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get oauth clients repository: %w", err)
+		}
+
+		authorizationCodesRepository, err := s.AuthorizationCodesRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get authorization codes repository: %w", err)
+		}
+
+		usersRepository, err := s.UsersRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get users repository: %w", err)
+		}
+
+		sessionService, err := s.SessionService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get session service: %w", err)
+		}
+
+		roleService, err := s.RoleService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get role service: %w", err)
+		}
+
+		signingKeyService, err := s.SigningKeyService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get signing key service: %w", err)
+		}
+
+		cfg, err := s.OAuthConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get oauth config: %w", err)
+		}
+
+		s.oauthService = oauthSvc.NewService(
+			s.logger,
+			oauthClientsRepository,
+			authorizationCodesRepository,
+			usersRepository,
+			sessionService,
+			roleService,
+			signingKeyService,
+			cfg,
+		)
+	}
+
+	return s.oauthService, nil
+}
+
+// AuthService returns an instance of service.AuthService.
+func (s *serviceProvider) AuthService(ctx context.Context) (service.AuthService, error) {
+	if s.authService == nil {
+		txManager, err := s.TxManager(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tx manager: %w", err)
+		}
+
+		refreshTokensRepository, err := s.RefreshTokensRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get refresh tokens repository: %w", err)
+		}
+
+		sessionsRepository, err := s.SessionsRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sessions repository: %w", err)
+		}
+
+		userEventsProducer, err := s.UserEventsProducer()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user events producer: %w", err)
+		}
+
+		usersService, err := s.UsersService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get users service: %w", err)
+		}
+
+		sessionService, err := s.SessionService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get session service: %w", err)
+		}
+
+		roleService, err := s.RoleService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get role service: %w", err)
+		}
+
+		lockoutService, err := s.LockoutService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get lockout service: %w", err)
+		}
+
+		mfaService, err := s.MFAService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get mfa service: %w", err)
+		}
+
+		tokenConfig, err := s.TokenConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get token config: %w", err)
+		}
+
+		emailVerificationConfig, err := s.EmailVerificationConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get email verification config: %w", err)
+		}
+
+		s.authService = authSvc.NewService(
+			s.logger,
+			txManager,
+			refreshTokensRepository,
+			sessionsRepository,
+			userEventsProducer,
+			usersService,
+			sessionService,
+			roleService,
+			lockoutService,
+			mfaService,
+			tokenConfig,
+			emailVerificationConfig,
+		)
+	}
+
+	return s.authService, nil
+}
+
+// LockoutService returns an instance of service.LockoutService.
+func (s *serviceProvider) LockoutService(ctx context.Context) (service.LockoutService, error) {
+	if s.lockoutService == nil {
+		loginAttemptsRepository, err := s.LoginAttemptsRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get login attempts repository: %w", err)
+		}
+
+		userRepository, err := s.UsersRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get users repository: %w", err)
+		}
+
+		userEventsProducer, err := s.UserEventsProducer()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user events producer: %w", err)
+		}
+
+		sessionService, err := s.SessionService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get session service: %w", err)
+		}
+
+		roleService, err := s.RoleService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get role service: %w", err)
+		}
+
+		cfg, err := s.LockoutConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get lockout config: %w", err)
+		}
+
+		s.lockoutService = lockoutSvc.NewService(
+			s.logger,
+			loginAttemptsRepository,
+			userRepository,
+			userEventsProducer,
+			sessionService,
+			roleService,
+			cfg,
+		)
+	}
+
+	return s.lockoutService, nil
+}
+
+// RateLimitService returns an instance of service.RateLimitService.
+func (s *serviceProvider) RateLimitService() (service.RateLimitService, error) {
+	if s.rateLimitService == nil {
+		rateLimitRepository, err := s.RateLimitRepository()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get rate limit repository: %w", err)
+		}
+
+		cfg, err := s.RateLimitConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get rate limit config: %w", err)
+		}
+
+		s.rateLimitService = rateLimitSvc.NewService(
+			s.logger,
+			rateLimitRepository,
+			cfg,
+		)
+	}
+
+	return s.rateLimitService, nil
+}
+
+// MFAService returns an instance of service.MFAService.
+func (s *serviceProvider) MFAService(ctx context.Context) (service.MFAService, error) {
+	if s.mfaService == nil {
+		txManager, err := s.TxManager(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tx manager: %w", err)
+		}
+
+		userRepository, err := s.UsersRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get users repository: %w", err)
+		}
+
+		recoveryCodesRepository, err := s.RecoveryCodesRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get recovery codes repository: %w", err)
+		}
+
+		mfaChallengesRepository, err := s.MFAChallengesRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get mfa challenges repository: %w", err)
+		}
+
+		userEventsProducer, err := s.UserEventsProducer()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user events producer: %w", err)
+		}
+
+		sessionService, err := s.SessionService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get session service: %w", err)
+		}
+
+		cfg, err := s.MFAConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get mfa config: %w", err)
+		}
+
+		s.mfaService = mfaSvc.NewService(
+			s.logger,
+			txManager,
+			userRepository,
+			recoveryCodesRepository,
+			mfaChallengesRepository,
+			userEventsProducer,
+			sessionService,
+			cfg,
+		)
+	}
+
+	return s.mfaService, nil
+}
+
+// EmailVerificationService returns an instance of service.EmailVerificationService.
+func (s *serviceProvider) EmailVerificationService(ctx context.Context) (service.EmailVerificationService, error) {
+	if s.emailVerificationService == nil {
+		txManager, err := s.TxManager(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tx manager: %w", err)
+		}
+
+		usersRepository, err := s.UsersRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get users repository: %w", err)
+		}
+
+		usersCache, err := s.UsersCache(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get users cache: %w", err)
+		}
+
+		verificationTokens, err := s.EmailVerificationTokensRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get email verification tokens repository: %w", err)
+		}
+
+		n, err := s.Notifier()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get notifier: %w", err)
+		}
+
+		workerPool, err := s.WorkerPool()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get worker pool: %w", err)
+		}
+
+		emailVerificationConfig, err := s.EmailVerificationConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get email verification config: %w", err)
+		}
+
+		s.emailVerificationService = emailVerificationSvc.NewService(
+			s.logger,
+			txManager,
+			usersRepository,
+			usersCache,
+			verificationTokens,
+			n,
+			workerPool,
+			emailVerificationConfig,
+		)
+	}
+
+	return s.emailVerificationService, nil
+}
+
+// PasswordResetService returns an instance of service.PasswordResetService.
+func (s *serviceProvider) PasswordResetService(ctx context.Context) (service.PasswordResetService, error) {
+	if s.passwordResetService == nil {
+		txManager, err := s.TxManager(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tx manager: %w", err)
+		}
+
+		usersRepository, err := s.UsersRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get users repository: %w", err)
+		}
+
+		passwordResetTokens, err := s.PasswordResetTokensRepository(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get password reset tokens repository: %w", err)
+		}
+
+		usersService, err := s.UsersService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get users service: %w", err)
+		}
+
+		n, err := s.Notifier()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get notifier: %w", err)
+		}
+
+		workerPool, err := s.WorkerPool()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get worker pool: %w", err)
+		}
+
+		passwordResetConfig, err := s.PasswordResetConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get password reset config: %w", err)
+		}
+
+		s.passwordResetService = passwordResetSvc.NewService(
+			s.logger,
+			txManager,
+			usersRepository,
+			passwordResetTokens,
+			usersService,
+			n,
+			workerPool,
+			passwordResetConfig,
+		)
+	}
+
+	return s.passwordResetService, nil
+}
+
+// AccessService returns an instance of service.AccessService.
+func (s *serviceProvider) AccessService(ctx context.Context) (service.AccessService, error) {
+	if s.accessService == nil {
+		accessPolicyCache, err := s.AccessPolicyCache(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get access policy cache: %w", err)
+		}
+
+		accessConfig, err := s.AccessConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get access config: %w", err)
+		}
+
+		sessionService, err := s.SessionService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get session service: %w", err)
+		}
+
+		roleService, err := s.RoleService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get role service: %w", err)
+		}
+
+		emailVerificationConfig, err := s.EmailVerificationConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get email verification config: %w", err)
+		}
+
+		s.accessService = accessSvc.NewService(
+			s.logger,
+			accessPolicyCache,
+			sessionService,
+			roleService,
+			accessConfig,
+			emailVerificationConfig,
+		)
+	}
+
+	return s.accessService, nil
+}
+
+// UserV1Impl returns an instance of user_v1.Implementation.
+func (s *serviceProvider) UserV1Impl(ctx context.Context) (*userv1.Implementation, error) {
+	if s.userV1Impl == nil {
+		usersService, err := s.UsersService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get users service: %w", err)
+		}
+
+		emailVerificationService, err := s.EmailVerificationService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get email verification service: %w", err)
+		}
+
+		lockoutService, err := s.LockoutService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get lockout service: %w", err)
+		}
+
+		mfaService, err := s.MFAService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get mfa service: %w", err)
+		}
+
+		roleService, err := s.RoleService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get role service: %w", err)
+		}
+
+		s.userV1Impl = userv1.NewImplementation(
+			s.logger,
+			usersService,
+			emailVerificationService,
+			lockoutService,
+			mfaService,
+			roleService,
+		)
+	}
+
+	return s.userV1Impl, nil
+}
+
+// AuthV1Impl returns an instance of auth_v1.Implementation.
+func (s *serviceProvider) AuthV1Impl(ctx context.Context) (*authv1.Implementation, error) {
+	if s.authV1Impl == nil {
+		authService, err := s.AuthService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get auth service: %w", err)
+		}
+
+		passwordResetService, err := s.PasswordResetService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get password reset service: %w", err)
+		}
+
+		apiKeyService, err := s.APIKeyService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get api key service: %w", err)
+		}
+
+		oauthService, err := s.OAuthService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get oauth service: %w", err)
+		}
+
+		s.authV1Impl = authv1.NewImplementation(
+			s.logger,
+			authService,
+			passwordResetService,
+			apiKeyService,
+			oauthService,
+		)
+	}
+
+	return s.authV1Impl, nil
+}
+
+// AccessV1Impl returns an instance of access_v1.Implementation.
+func (s *serviceProvider) AccessV1Impl(ctx context.Context) (*accessv1.Implementation, error) {
+	if s.accessV1Impl == nil {
+		accessService, err := s.AccessService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get access service: %w", err)
+		}
+
+		s.accessV1Impl = accessv1.NewImplementation(s.logger, accessService)
+	}
+
+	return s.accessV1Impl, nil
+}
+
+// JWKSHandler returns an instance of jwks.Handler.
+func (s *serviceProvider) JWKSHandler(ctx context.Context) (*jwks.Handler, error) {
+	if s.jwksHandler == nil {
+		signingKeyService, err := s.SigningKeyService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get signing key service: %w", err)
+		}
+
+		s.jwksHandler = jwks.NewHandler(s.logger, signingKeyService)
+	}
+
+	return s.jwksHandler, nil
+}
+
+// OAuthHandler returns an instance of oauth.Handler.
+func (s *serviceProvider) OAuthHandler(ctx context.Context) (*oauthHTTP.Handler, error) {
+	if s.oauthHandler == nil {
+		oauthService, err := s.OAuthService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get oauth service: %w", err)
+		}
+
+		cfg, err := s.OAuthConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get oauth config: %w", err)
+		}
+
+		s.oauthHandler = oauthHTTP.NewHandler(s.logger, oauthService, cfg)
+	}
+
+	return s.oauthHandler, nil
 }
 
 // UserCreateConsumer returns an instance of kafka.UserCreateConsumer.
@@ -0,0 +1,55 @@
+package app
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/certs"
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// grpcServerCredentials returns transport credentials of the gRPC server, plaintext unless TLS is configured.
+func grpcServerCredentials(cfg config.GRPCConfig) (credentials.TransportCredentials, error) {
+	if !cfg.GetTLS().IsEnabled() {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsConfig, err := certs.NewServerTLSConfig(cfg.GetTLS())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create grpc server tls config: %w", err)
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// grpcGatewayCredentials returns transport credentials the gateway dials the gRPC server with.
+// Under TLS the gateway verifies the server against the same CA and presents the server certificate
+// as its own, so the certificate has to allow client auth when mutual TLS is required.
+func grpcGatewayCredentials(cfg config.GRPCConfig) (credentials.TransportCredentials, error) {
+	if !cfg.GetTLS().IsEnabled() {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsConfig, err := certs.NewClientTLSConfig(cfg.GetTLS(), loopbackServerName(cfg.GetAddress()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create grpc gateway tls config: %w", err)
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// loopbackServerName returns the name the gRPC server certificate is verified for when dialing address.
+func loopbackServerName(address string) string {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil || len(host) == 0 {
+		return "localhost"
+	}
+
+	if ip := net.ParseIP(host); ip != nil && ip.IsUnspecified() {
+		return "localhost"
+	}
+
+	return host
+}
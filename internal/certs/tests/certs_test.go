@@ -0,0 +1,269 @@
+package tests
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/certs"
+	"github.com/stretchr/testify/require"
+)
+
+type tlsConfig struct {
+	certFile   string
+	keyFile    string
+	caFile     string
+	clientAuth bool
+}
+
+func (c *tlsConfig) IsEnabled() bool            { return len(c.certFile) != 0 }
+func (c *tlsConfig) GetCertFile() string        { return c.certFile }
+func (c *tlsConfig) GetKeyFile() string         { return c.keyFile }
+func (c *tlsConfig) GetCAFile() string          { return c.caFile }
+func (c *tlsConfig) IsClientAuthRequired() bool { return c.clientAuth }
+
+type authority struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newAuthority(t *testing.T, name string) *authority {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &authority{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue writes a localhost certificate usable for both server and client auth into dir.
+func (a *authority) issue(t *testing.T, dir, name string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, a.cert, &key.PublicKey, a.key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	return certFile, keyFile
+}
+
+func (a *authority) write(t *testing.T, dir, name string) string {
+	t.Helper()
+
+	file := filepath.Join(dir, name+".pem")
+	require.NoError(t, os.WriteFile(file, a.pem, 0o600))
+
+	return file
+}
+
+func handshake(t *testing.T, serverConfig, clientConfig *tls.Config) (*tls.ConnectionState, error, error) {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+
+	type result struct {
+		state tls.ConnectionState
+		err   error
+	}
+
+	serverResult := make(chan result, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			serverResult <- result{err: err}
+			return
+		}
+		defer conn.Close()
+
+		server := tls.Server(conn, serverConfig)
+		err = server.Handshake()
+		serverResult <- result{state: server.ConnectionState(), err: err}
+	}()
+
+	conn, err := net.Dial("tcp", lis.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := tls.Client(conn, clientConfig)
+	clientErr := client.Handshake()
+	if clientErr != nil {
+		conn.Close()
+	}
+
+	res := <-serverResult
+
+	return &res.state, res.err, clientErr
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+
+	ca := newAuthority(t, "ca")
+	caFile := ca.write(t, dir, "ca")
+	serverCert, serverKey := ca.issue(t, dir, "server")
+	clientCert, clientKey := ca.issue(t, dir, "client")
+
+	foreign := newAuthority(t, "foreign")
+	foreignCert, foreignKey := foreign.issue(t, dir, "foreign")
+
+	serverConfig, err := certs.NewServerTLSConfig(&tlsConfig{
+		certFile:   serverCert,
+		keyFile:    serverKey,
+		caFile:     caFile,
+		clientAuth: true,
+	})
+	require.NoError(t, err)
+
+	t.Run("client signed by the CA", func(t *testing.T) {
+		clientConfig, err := certs.NewClientTLSConfig(&tlsConfig{
+			certFile: clientCert,
+			keyFile:  clientKey,
+			caFile:   caFile,
+		}, "localhost")
+		require.NoError(t, err)
+
+		state, serverErr, clientErr := handshake(t, serverConfig, clientConfig)
+		require.NoError(t, serverErr)
+		require.NoError(t, clientErr)
+		require.Len(t, state.PeerCertificates, 1)
+		require.Equal(t, "client", state.PeerCertificates[0].Subject.CommonName)
+	})
+
+	t.Run("client signed by another CA", func(t *testing.T) {
+		clientConfig, err := certs.NewClientTLSConfig(&tlsConfig{
+			certFile: foreignCert,
+			keyFile:  foreignKey,
+			caFile:   caFile,
+		}, "localhost")
+		require.NoError(t, err)
+
+		_, serverErr, _ := handshake(t, serverConfig, clientConfig)
+		require.Error(t, serverErr)
+	})
+
+	t.Run("client without certificate", func(t *testing.T) {
+		pool := x509.NewCertPool()
+		pool.AddCert(ca.cert)
+
+		_, serverErr, _ := handshake(t, serverConfig, &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			ServerName: "localhost",
+			RootCAs:    pool,
+		})
+		require.Error(t, serverErr)
+	})
+
+	t.Run("server name mismatch", func(t *testing.T) {
+		clientConfig, err := certs.NewClientTLSConfig(&tlsConfig{
+			certFile: clientCert,
+			keyFile:  clientKey,
+			caFile:   caFile,
+		}, "auth.example.com")
+		require.NoError(t, err)
+
+		_, _, clientErr := handshake(t, serverConfig, clientConfig)
+		require.Error(t, clientErr)
+	})
+}
+
+func TestCertificateReload(t *testing.T) {
+	dir := t.TempDir()
+
+	ca := newAuthority(t, "ca")
+	caFile := ca.write(t, dir, "ca")
+	serverCert, serverKey := ca.issue(t, dir, "server")
+
+	serverConfig, err := certs.NewServerTLSConfig(&tlsConfig{
+		certFile: serverCert,
+		keyFile:  serverKey,
+	})
+	require.NoError(t, err)
+
+	clientConfig, err := certs.NewClientTLSConfig(&tlsConfig{
+		certFile: serverCert,
+		keyFile:  serverKey,
+		caFile:   caFile,
+	}, "localhost")
+	require.NoError(t, err)
+
+	_, serverErr, clientErr := handshake(t, serverConfig, clientConfig)
+	require.NoError(t, serverErr)
+	require.NoError(t, clientErr)
+
+	// The server moves to a certificate of a new CA, the client keeps trusting the old one until its CA file is rotated.
+	rotated := newAuthority(t, "rotated")
+	rotatedCert, rotatedKey := rotated.issue(t, dir, "rotated")
+	later := time.Now().Add(time.Minute)
+	for _, pair := range [][2]string{{rotatedCert, serverCert}, {rotatedKey, serverKey}} {
+		require.NoError(t, os.Rename(pair[0], pair[1]))
+		require.NoError(t, os.Chtimes(pair[1], later, later))
+	}
+
+	_, _, clientErr = handshake(t, serverConfig, clientConfig)
+	require.Error(t, clientErr)
+
+	rotated.write(t, dir, "ca")
+	require.NoError(t, os.Chtimes(caFile, later, later))
+
+	_, serverErr, clientErr = handshake(t, serverConfig, clientConfig)
+	require.NoError(t, serverErr)
+	require.NoError(t, clientErr)
+}
+
+func TestNewServerTLSConfigWithoutCertificate(t *testing.T) {
+	_, err := certs.NewServerTLSConfig(&tlsConfig{})
+	require.Error(t, err)
+}
@@ -0,0 +1,71 @@
+package clientip
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const forwardedForHeader = "x-forwarded-for"
+
+// FromIncomingContext returns address of the client which made incoming gRPC request.
+// The last X-Forwarded-For entry is trusted only when the request came from the
+// loopback interface, since that is how the in-process HTTP gateway reaches the
+// gRPC server and it appends the address it saw to the header.
+func FromIncomingContext(ctx context.Context) string {
+	var peerIP net.IP
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		peerIP = parseIP(p.Addr.String())
+	}
+
+	if peerIP == nil || peerIP.IsLoopback() {
+		if forwarded := lastForwardedFor(ctx); forwarded != nil {
+			return forwarded.String()
+		}
+	}
+
+	if peerIP == nil {
+		return ""
+	}
+
+	return peerIP.String()
+}
+
+// FromRequest returns address of the client which made the HTTP request. The HTTP server is
+// reached directly, so X-Forwarded-For is not trusted.
+func FromRequest(r *http.Request) string {
+	ip := parseIP(r.RemoteAddr)
+	if ip == nil {
+		return ""
+	}
+
+	return ip.String()
+}
+
+func lastForwardedFor(ctx context.Context) net.IP {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	values := md.Get(forwardedForHeader)
+	if len(values) == 0 {
+		return nil
+	}
+
+	entries := strings.Split(values[len(values)-1], ",")
+
+	return parseIP(strings.TrimSpace(entries[len(entries)-1]))
+}
+
+func parseIP(addr string) net.IP {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+
+	return net.ParseIP(addr)
+}
@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/clientip"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func incomingContext(peerAddr string, forwardedFor ...string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{
+		IP:   net.ParseIP(peerAddr),
+		Port: 51234,
+	}})
+
+	if len(forwardedFor) > 0 {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", forwardedFor[0]))
+	}
+
+	return ctx
+}
+
+func TestFromIncomingContext(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{
+			name: "direct client",
+			ctx:  incomingContext("203.0.113.7"),
+			want: "203.0.113.7",
+		},
+		{
+			name: "forwarded header from remote peer is ignored",
+			ctx:  incomingContext("203.0.113.7", "198.51.100.1"),
+			want: "203.0.113.7",
+		},
+		{
+			name: "gateway on loopback",
+			ctx:  incomingContext("127.0.0.1", "198.51.100.1, 203.0.113.9"),
+			want: "203.0.113.9",
+		},
+		{
+			name: "loopback without header",
+			ctx:  incomingContext("::1"),
+			want: "::1",
+		},
+		{
+			name: "no peer",
+			ctx:  context.Background(),
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, clientip.FromIncomingContext(tt.ctx))
+		})
+	}
+}
+
+func TestFromRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		remoteAddr string
+		header     http.Header
+		want       string
+	}{
+		{
+			name:       "remote address",
+			remoteAddr: "203.0.113.7:51234",
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "forwarded for is not trusted",
+			remoteAddr: "203.0.113.7:51234",
+			header:     http.Header{"X-Forwarded-For": []string{"198.51.100.1"}},
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "invalid remote address",
+			remoteAddr: "unknown",
+			want:       "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/oauth/token", nil)
+			r.RemoteAddr = tt.remoteAddr
+			if tt.header != nil {
+				r.Header = tt.header
+			}
+
+			require.Equal(t, tt.want, clientip.FromRequest(r))
+		})
+	}
+}
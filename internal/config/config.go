@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/IBM/sarama"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
 )
 
 // PGConfig represents configuration for PostgreSQL.
@@ -14,6 +15,7 @@ type PGConfig interface {
 // GRPCConfig represents configuration for gRPC.
 type GRPCConfig interface {
 	GetAddress() string
+	GetTLS() TLSConfig
 }
 
 // HTTPConfig represents configuration for HTTP.
@@ -21,6 +23,16 @@ type HTTPConfig interface {
 	GetAddress() string
 	GetReadHeaderTimeout() time.Duration
 	GetGracefulShutdownTimeout() time.Duration
+	GetTLS() TLSConfig
+}
+
+// TLSConfig represents TLS configuration of a server.
+type TLSConfig interface {
+	IsEnabled() bool
+	GetCertFile() string
+	GetKeyFile() string
+	GetCAFile() string
+	IsClientAuthRequired() bool
 }
 
 // RedisConfig represents configuration for Redis.
@@ -46,3 +58,108 @@ type KafkaProducerConfig interface {
 	Topic() string
 	Config() *sarama.Config
 }
+
+// PasswordConfig represents configuration for password hashing.
+type PasswordConfig interface {
+	GetHashAlgorithm() string
+	GetArgon2Time() uint32
+	GetArgon2Memory() uint32
+	GetArgon2Threads() uint8
+	GetBcryptCost() int
+}
+
+// TokenConfig represents configuration for access and refresh tokens.
+type TokenConfig interface {
+	GetRefreshTokenSecretKey() []byte
+	GetAccessTokenTTL() time.Duration
+	GetRefreshTokenTTL() time.Duration
+	GetImpersonationTokenTTL() time.Duration
+}
+
+// SigningKeyConfig represents configuration for access token signing keys.
+type SigningKeyConfig interface {
+	GetAlgorithm() string
+	GetRotationPeriod() time.Duration
+	GetRotationCheckInterval() time.Duration
+	GetSecretKey() []byte
+}
+
+// AccessConfig represents configuration for endpoint access checks.
+type AccessConfig interface {
+	GetPolicy() map[string][]string
+	GetPolicyCacheTTL() time.Duration
+	GetRolePermissions() map[string][]string
+}
+
+// NotifierConfig represents configuration for delivery of notifications to users.
+type NotifierConfig interface {
+	GetKind() string
+	GetFrom() string
+	GetFilePath() string
+	GetSMTPHost() string
+	GetSMTPAddress() string
+	GetSMTPUsername() string
+	GetSMTPPassword() string
+}
+
+// WorkerConfig represents configuration for the pool running background tasks.
+type WorkerConfig interface {
+	GetCount() int
+	GetQueueSize() int
+}
+
+// PasswordResetConfig represents configuration for self-service password reset.
+type PasswordResetConfig interface {
+	GetTokenTTL() time.Duration
+	GetURL() string
+}
+
+// EmailVerificationConfig represents configuration for email ownership verification.
+type EmailVerificationConfig interface {
+	GetTokenTTL() time.Duration
+	GetURL() string
+	IsRequiredForLogin() bool
+	IsRequiredForAccess() bool
+}
+
+// PasswordPolicyConfig represents configuration for rules new passwords must satisfy.
+type PasswordPolicyConfig interface {
+	GetMinLength() int
+	GetMaxLength() int
+	IsLowerRequired() bool
+	IsUpperRequired() bool
+	IsDigitRequired() bool
+	IsSymbolRequired() bool
+	GetBreachedListPath() string
+}
+
+// LockoutConfig represents configuration for throttling of failed login attempts.
+type LockoutConfig interface {
+	GetAccountThreshold() int
+	GetIPThreshold() int
+	GetCooldown() time.Duration
+	GetMaxCooldown() time.Duration
+	GetWindow() time.Duration
+}
+
+// RateLimitConfig represents configuration for rate limiting of requests.
+type RateLimitConfig interface {
+	GetLimits() map[string]model.RateLimit
+}
+
+// MFAConfig represents configuration for two-factor authentication.
+type MFAConfig interface {
+	GetIssuer() string
+	GetSecretKey() []byte
+	GetChallengeTTL() time.Duration
+	GetChallengeMaxAttempts() int
+}
+
+// OAuthConfig represents configuration for the OAuth 2.0 authorization server and OpenID Connect provider.
+type OAuthConfig interface {
+	GetClientTokenTTL() time.Duration
+	GetIssuer() string
+	GetLoginURL() string
+	GetAuthorizationCodeTTL() time.Duration
+	GetIDTokenTTL() time.Duration
+}
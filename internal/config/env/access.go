@@ -0,0 +1,100 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	accessPolicyEnvName          = "ACCESS_POLICY"
+	accessPolicyCacheTTLEnvName  = "ACCESS_POLICY_CACHE_TTL"
+	accessRolePermissionsEnvName = "ACCESS_ROLE_PERMISSIONS"
+)
+
+type accessConfig struct {
+	policy          map[string][]string
+	policyCacheTTL  time.Duration
+	rolePermissions map[string][]string
+}
+
+// NewAccessConfig returns a new config.AccessConfig.
+//
+// Policy is read in the form "/pkg.Service/Method:permission,permission;/pkg.Service/Other:permission",
+// holding any of the listed permissions is enough to call the endpoint.
+// Optional role permissions are read in the form "ROLE:permission,permission;OTHER:permission",
+// the listed permissions are granted to the existing roles on startup.
+func NewAccessConfig() (config.AccessConfig, error) {
+	rawPolicy := os.Getenv(accessPolicyEnvName)
+	if len(rawPolicy) == 0 {
+		return nil, errors.New("access policy not found")
+	}
+
+	policy, err := parsePermissionRules(rawPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse access policy: %w", err)
+	}
+
+	policyCacheTTL, err := time.ParseDuration(os.Getenv(accessPolicyCacheTTLEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse access policy cache ttl: %w", err)
+	}
+
+	rolePermissions, err := parsePermissionRules(os.Getenv(accessRolePermissionsEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse role permissions: %w", err)
+	}
+
+	return &accessConfig{
+		policy:          policy,
+		policyCacheTTL:  policyCacheTTL,
+		rolePermissions: rolePermissions,
+	}, nil
+}
+
+// parsePermissionRules parses rules of a key and comma separated permissions separated by semicolons.
+func parsePermissionRules(rawRules string) (map[string][]string, error) {
+	rules := make(map[string][]string)
+
+	for _, rule := range strings.Split(rawRules, ";") {
+		rule = strings.TrimSpace(rule)
+		if len(rule) == 0 {
+			continue
+		}
+
+		key, rawPermissions, ok := strings.Cut(rule, ":")
+		if !ok || len(strings.TrimSpace(key)) == 0 {
+			return nil, fmt.Errorf("invalid rule %q", rule)
+		}
+
+		var permissions []string
+		for _, permission := range strings.Split(rawPermissions, ",") {
+			if permission = strings.TrimSpace(permission); len(permission) != 0 {
+				permissions = append(permissions, permission)
+			}
+		}
+
+		rules[strings.TrimSpace(key)] = permissions
+	}
+
+	return rules, nil
+}
+
+// GetPolicy returns permissions allowing to call each endpoint address.
+func (c *accessConfig) GetPolicy() map[string][]string {
+	return c.policy
+}
+
+// GetPolicyCacheTTL returns how long the access policy is kept in cache.
+func (c *accessConfig) GetPolicyCacheTTL() time.Duration {
+	return c.policyCacheTTL
+}
+
+// GetRolePermissions returns permissions granted to each role on startup.
+func (c *accessConfig) GetRolePermissions() map[string][]string {
+	return c.rolePermissions
+}
@@ -0,0 +1,80 @@
+package env
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	emailVerificationTokenTTLEnvName          = "EMAIL_VERIFICATION_TOKEN_TTL" // nolint: gosec
+	emailVerificationURLEnvName               = "EMAIL_VERIFICATION_URL"
+	emailVerificationRequiredForLoginEnvName  = "EMAIL_VERIFICATION_REQUIRED_FOR_LOGIN"
+	emailVerificationRequiredForAccessEnvName = "EMAIL_VERIFICATION_REQUIRED_FOR_ACCESS"
+)
+
+type emailVerificationConfig struct {
+	tokenTTL          time.Duration
+	url               string
+	requiredForLogin  bool
+	requiredForAccess bool
+}
+
+// NewEmailVerificationConfig returns a new config.EmailVerificationConfig.
+func NewEmailVerificationConfig() (config.EmailVerificationConfig, error) {
+	tokenTTL, err := time.ParseDuration(os.Getenv(emailVerificationTokenTTLEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse email verification token ttl: %w", err)
+	}
+
+	rawURL := os.Getenv(emailVerificationURLEnvName)
+	if len(rawURL) == 0 {
+		return nil, errors.New("email verification url not found")
+	}
+
+	if _, err = url.Parse(rawURL); err != nil {
+		return nil, fmt.Errorf("failed to parse email verification url: %w", err)
+	}
+
+	requiredForLogin, err := strconv.ParseBool(os.Getenv(emailVerificationRequiredForLoginEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse email verification required for login: %w", err)
+	}
+
+	requiredForAccess, err := strconv.ParseBool(os.Getenv(emailVerificationRequiredForAccessEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse email verification required for access: %w", err)
+	}
+
+	return &emailVerificationConfig{
+		tokenTTL:          tokenTTL,
+		url:               rawURL,
+		requiredForLogin:  requiredForLogin,
+		requiredForAccess: requiredForAccess,
+	}, nil
+}
+
+// GetTokenTTL returns how long an email verification token stays valid.
+func (c *emailVerificationConfig) GetTokenTTL() time.Duration {
+	return c.tokenTTL
+}
+
+// GetURL returns the page address the verification token is appended to as the "token" query parameter.
+func (c *emailVerificationConfig) GetURL() string {
+	return c.url
+}
+
+// IsRequiredForLogin reports whether users with unverified email are refused to log in.
+func (c *emailVerificationConfig) IsRequiredForLogin() bool {
+	return c.requiredForLogin
+}
+
+// IsRequiredForAccess reports whether access checks deny tokens of users with unverified email.
+func (c *emailVerificationConfig) IsRequiredForAccess() bool {
+	return c.requiredForAccess
+}
@@ -1,6 +1,7 @@
 package env
 
 import (
+	"fmt"
 	"net"
 	"os"
 
@@ -12,22 +13,36 @@ const (
 	grpcPort = "GRPC_PORT"
 )
 
+const grpcEnvPrefix = "GRPC"
+
 type grpcConfig struct {
 	host string
 	port string
+	tls  config.TLSConfig
 }
 
 // NewGRPCConfig returns a new config.GRPCConfig.
-func NewGRPCConfig() config.GRPCConfig {
+func NewGRPCConfig() (config.GRPCConfig, error) {
 	var cfg grpcConfig
 
 	cfg.host = os.Getenv(grpcHost)
 	cfg.port = os.Getenv(grpcPort)
 
-	return &cfg
+	tlsCfg, err := newTLSConfig(grpcEnvPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get grpc tls config: %w", err)
+	}
+	cfg.tls = tlsCfg
+
+	return &cfg, nil
 }
 
 // GetAddress returns the address of the gRPC server.
 func (c *grpcConfig) GetAddress() string {
 	return net.JoinHostPort(c.host, c.port)
 }
+
+// GetTLS returns TLS configuration of the gRPC server.
+func (c *grpcConfig) GetTLS() config.TLSConfig {
+	return c.tls
+}
@@ -15,6 +15,7 @@ const (
 	httpPortEnvName              = "HTTP_PORT"
 	httpShutdownTimeoutEnvName   = "HTTP_SHUTDOWN_TIMEOUT"
 	httpReadHeaderTimeoutEnvName = "HTTP_READ_HEADER_TIMEOUT"
+	httpEnvPrefix                = "HTTP"
 )
 
 type httpConfig struct {
@@ -22,6 +23,7 @@ type httpConfig struct {
 	port              string
 	shutdownTimeout   time.Duration
 	readHeaderTimeout time.Duration
+	tls               config.TLSConfig
 }
 
 // NewHTTPConfig returns new HTTP config.
@@ -43,11 +45,17 @@ func NewHTTPConfig() (config.HTTPConfig, error) {
 		return nil, fmt.Errorf("failed to parse http read header timeout: %w", err)
 	}
 
+	tlsCfg, err := newTLSConfig(httpEnvPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get http tls config: %w", err)
+	}
+
 	return &httpConfig{
 		host:              host,
 		port:              port,
 		shutdownTimeout:   shutdownTimeout,
 		readHeaderTimeout: readHeaderTimeout,
+		tls:               tlsCfg,
 	}, nil
 }
 
@@ -65,3 +73,8 @@ func (cfg *httpConfig) GetReadHeaderTimeout() time.Duration {
 func (cfg *httpConfig) GetGracefulShutdownTimeout() time.Duration {
 	return cfg.shutdownTimeout
 }
+
+// GetTLS returns TLS configuration.
+func (cfg *httpConfig) GetTLS() config.TLSConfig {
+	return cfg.tls
+}
@@ -0,0 +1,96 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	lockoutAccountThresholdEnvName = "LOCKOUT_ACCOUNT_THRESHOLD"
+	lockoutIPThresholdEnvName      = "LOCKOUT_IP_THRESHOLD"
+	lockoutCooldownEnvName         = "LOCKOUT_COOLDOWN"
+	lockoutMaxCooldownEnvName      = "LOCKOUT_MAX_COOLDOWN"
+	lockoutWindowEnvName           = "LOCKOUT_WINDOW"
+)
+
+type lockoutConfig struct {
+	accountThreshold int
+	ipThreshold      int
+	cooldown         time.Duration
+	maxCooldown      time.Duration
+	window           time.Duration
+}
+
+// NewLockoutConfig returns a new config.LockoutConfig.
+func NewLockoutConfig() (config.LockoutConfig, error) {
+	accountThreshold, err := strconv.Atoi(os.Getenv(lockoutAccountThresholdEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse lockout account threshold: %w", err)
+	}
+
+	ipThreshold, err := strconv.Atoi(os.Getenv(lockoutIPThresholdEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse lockout ip threshold: %w", err)
+	}
+
+	if accountThreshold < 1 || ipThreshold < 1 {
+		return nil, errors.New("lockout thresholds must be positive")
+	}
+
+	cooldown, err := time.ParseDuration(os.Getenv(lockoutCooldownEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse lockout cooldown: %w", err)
+	}
+
+	maxCooldown, err := time.ParseDuration(os.Getenv(lockoutMaxCooldownEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse lockout max cooldown: %w", err)
+	}
+
+	if cooldown <= 0 || maxCooldown < cooldown {
+		return nil, errors.New("lockout cooldown bounds are invalid")
+	}
+
+	window, err := time.ParseDuration(os.Getenv(lockoutWindowEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse lockout window: %w", err)
+	}
+
+	return &lockoutConfig{
+		accountThreshold: accountThreshold,
+		ipThreshold:      ipThreshold,
+		cooldown:         cooldown,
+		maxCooldown:      maxCooldown,
+		window:           window,
+	}, nil
+}
+
+// GetAccountThreshold returns the number of failed attempts after which the account is locked.
+func (c *lockoutConfig) GetAccountThreshold() int {
+	return c.accountThreshold
+}
+
+// GetIPThreshold returns the number of failed attempts after which the client address is locked.
+func (c *lockoutConfig) GetIPThreshold() int {
+	return c.ipThreshold
+}
+
+// GetCooldown returns duration of the first lockout, every next lockout in a row lasts twice as long.
+func (c *lockoutConfig) GetCooldown() time.Duration {
+	return c.cooldown
+}
+
+// GetMaxCooldown returns the longest lockout duration.
+func (c *lockoutConfig) GetMaxCooldown() time.Duration {
+	return c.maxCooldown
+}
+
+// GetWindow returns how long failed attempts and past lockouts are remembered after the last failure.
+func (c *lockoutConfig) GetWindow() time.Duration {
+	return c.window
+}
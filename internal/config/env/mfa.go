@@ -0,0 +1,79 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	mfaIssuerEnvName               = "MFA_ISSUER"
+	mfaSecretKeyEnvName            = "MFA_SECRET_KEY" // nolint: gosec
+	mfaChallengeTTLEnvName         = "MFA_CHALLENGE_TTL"
+	mfaChallengeMaxAttemptsEnvName = "MFA_CHALLENGE_MAX_ATTEMPTS"
+)
+
+type mfaConfig struct {
+	issuer               string
+	secretKey            []byte
+	challengeTTL         time.Duration
+	challengeMaxAttempts int
+}
+
+// NewMFAConfig returns a new config.MFAConfig.
+func NewMFAConfig() (config.MFAConfig, error) {
+	issuer := os.Getenv(mfaIssuerEnvName)
+	if len(issuer) == 0 {
+		return nil, errors.New("mfa issuer not found")
+	}
+
+	secretKey := os.Getenv(mfaSecretKeyEnvName)
+	if len(secretKey) == 0 {
+		return nil, errors.New("mfa secret key not found")
+	}
+
+	challengeTTL, err := time.ParseDuration(os.Getenv(mfaChallengeTTLEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mfa challenge ttl: %w", err)
+	}
+
+	challengeMaxAttempts, err := strconv.Atoi(os.Getenv(mfaChallengeMaxAttemptsEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mfa challenge max attempts: %w", err)
+	}
+
+	if challengeMaxAttempts < 1 {
+		return nil, errors.New("mfa challenge max attempts must be positive")
+	}
+
+	return &mfaConfig{
+		issuer:               issuer,
+		secretKey:            []byte(secretKey),
+		challengeTTL:         challengeTTL,
+		challengeMaxAttempts: challengeMaxAttempts,
+	}, nil
+}
+
+// GetIssuer returns the issuer name shown by authenticator apps.
+func (c *mfaConfig) GetIssuer() string {
+	return c.issuer
+}
+
+// GetSecretKey returns the key TOTP secrets are encrypted with at rest.
+func (c *mfaConfig) GetSecretKey() []byte {
+	return c.secretKey
+}
+
+// GetChallengeTTL returns how long the second login step may be completed after the password step.
+func (c *mfaConfig) GetChallengeTTL() time.Duration {
+	return c.challengeTTL
+}
+
+// GetChallengeMaxAttempts returns the number of wrong codes after which the challenge is dropped.
+func (c *mfaConfig) GetChallengeMaxAttempts() int {
+	return c.challengeMaxAttempts
+}
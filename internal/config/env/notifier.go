@@ -0,0 +1,87 @@
+package env
+
+import (
+	"net"
+	"os"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	notifierKindEnvName     = "NOTIFIER_KIND"
+	notifierFromEnvName     = "NOTIFIER_FROM"
+	notifierFilePathEnvName = "NOTIFIER_FILE_PATH"
+	smtpHostEnvName         = "SMTP_HOST"
+	smtpPortEnvName         = "SMTP_PORT"
+	smtpUsernameEnvName     = "SMTP_USERNAME"
+	smtpPasswordEnvName     = "SMTP_PASSWORD" // nolint: gosec
+)
+
+type notifierConfig struct {
+	kind         string
+	from         string
+	filePath     string
+	smtpHost     string
+	smtpPort     string
+	smtpUsername string
+	smtpPassword string
+}
+
+// NewNotifierConfig returns a new config.NotifierConfig.
+func NewNotifierConfig() (config.NotifierConfig, error) {
+	kind := os.Getenv(notifierKindEnvName)
+	if len(kind) == 0 {
+		return nil, errors.New("notifier kind not found")
+	}
+
+	from := os.Getenv(notifierFromEnvName)
+	if len(from) == 0 {
+		return nil, errors.New("notifier sender address not found")
+	}
+
+	return &notifierConfig{
+		kind:         kind,
+		from:         from,
+		filePath:     os.Getenv(notifierFilePathEnvName),
+		smtpHost:     os.Getenv(smtpHostEnvName),
+		smtpPort:     os.Getenv(smtpPortEnvName),
+		smtpUsername: os.Getenv(smtpUsernameEnvName),
+		smtpPassword: os.Getenv(smtpPasswordEnvName),
+	}, nil
+}
+
+// GetKind returns the notifier implementation name.
+func (c *notifierConfig) GetKind() string {
+	return c.kind
+}
+
+// GetFrom returns the sender address of notifications.
+func (c *notifierConfig) GetFrom() string {
+	return c.from
+}
+
+// GetFilePath returns the file notifications are appended to by the file notifier.
+func (c *notifierConfig) GetFilePath() string {
+	return c.filePath
+}
+
+// GetSMTPHost returns the SMTP server host.
+func (c *notifierConfig) GetSMTPHost() string {
+	return c.smtpHost
+}
+
+// GetSMTPAddress returns the SMTP server address.
+func (c *notifierConfig) GetSMTPAddress() string {
+	return net.JoinHostPort(c.smtpHost, c.smtpPort)
+}
+
+// GetSMTPUsername returns the SMTP user name, empty for servers without authentication.
+func (c *notifierConfig) GetSMTPUsername() string {
+	return c.smtpUsername
+}
+
+// GetSMTPPassword returns the SMTP user password.
+func (c *notifierConfig) GetSMTPPassword() string {
+	return c.smtpPassword
+}
@@ -0,0 +1,91 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	oauthClientTokenTTLEnvName       = "OAUTH_CLIENT_TOKEN_TTL" // nolint: gosec
+	oauthIssuerEnvName               = "OAUTH_ISSUER"
+	oauthLoginURLEnvName             = "OAUTH_LOGIN_URL"
+	oauthAuthorizationCodeTTLEnvName = "OAUTH_AUTHORIZATION_CODE_TTL"
+	oauthIDTokenTTLEnvName           = "OAUTH_ID_TOKEN_TTL" // nolint: gosec
+)
+
+type oauthConfig struct {
+	clientTokenTTL       time.Duration
+	issuer               string
+	loginURL             string
+	authorizationCodeTTL time.Duration
+	idTokenTTL           time.Duration
+}
+
+// NewOAuthConfig returns a new config.OAuthConfig.
+func NewOAuthConfig() (config.OAuthConfig, error) {
+	clientTokenTTL, err := time.ParseDuration(os.Getenv(oauthClientTokenTTLEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse oauth client token ttl: %w", err)
+	}
+
+	issuer := strings.TrimSuffix(os.Getenv(oauthIssuerEnvName), "/")
+	if len(issuer) == 0 {
+		return nil, errors.New("oauth issuer not found")
+	}
+
+	loginURL := os.Getenv(oauthLoginURLEnvName)
+	if len(loginURL) == 0 {
+		return nil, errors.New("oauth login url not found")
+	}
+
+	authorizationCodeTTL, err := time.ParseDuration(os.Getenv(oauthAuthorizationCodeTTLEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse oauth authorization code ttl: %w", err)
+	}
+
+	idTokenTTL, err := time.ParseDuration(os.Getenv(oauthIDTokenTTLEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse oauth id token ttl: %w", err)
+	}
+
+	return &oauthConfig{
+		clientTokenTTL:       clientTokenTTL,
+		issuer:               issuer,
+		loginURL:             loginURL,
+		authorizationCodeTTL: authorizationCodeTTL,
+		idTokenTTL:           idTokenTTL,
+	}, nil
+}
+
+// GetClientTokenTTL returns how long an access token issued by the client credentials grant stays valid.
+// Revoking a client does not revoke its tokens, so keep it short.
+func (c *oauthConfig) GetClientTokenTTL() time.Duration {
+	return c.clientTokenTTL
+}
+
+// GetIssuer returns the public base URL of the HTTP server, it is the iss claim of ID tokens
+// and the prefix of endpoints published by OpenID Connect discovery.
+func (c *oauthConfig) GetIssuer() string {
+	return c.issuer
+}
+
+// GetLoginURL returns the page the authorization endpoint sends users without a session to.
+// The page gets the authorization URL to come back to in the return_to query parameter.
+func (c *oauthConfig) GetLoginURL() string {
+	return c.loginURL
+}
+
+// GetAuthorizationCodeTTL returns how long an authorization code may be exchanged for tokens.
+func (c *oauthConfig) GetAuthorizationCodeTTL() time.Duration {
+	return c.authorizationCodeTTL
+}
+
+// GetIDTokenTTL returns how long ID tokens and access tokens issued with them stay valid.
+func (c *oauthConfig) GetIDTokenTTL() time.Duration {
+	return c.idTokenTTL
+}
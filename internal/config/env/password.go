@@ -0,0 +1,87 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	passwordHashAlgorithmEnvName = "PASSWORD_HASH_ALGORITHM"
+	passwordArgon2TimeEnvName    = "PASSWORD_ARGON2_TIME"
+	passwordArgon2MemoryEnvName  = "PASSWORD_ARGON2_MEMORY"
+	passwordArgon2ThreadsEnvName = "PASSWORD_ARGON2_THREADS"
+	passwordBcryptCostEnvName    = "PASSWORD_BCRYPT_COST"
+)
+
+type passwordConfig struct {
+	algorithm     string
+	argon2Time    uint32
+	argon2Memory  uint32
+	argon2Threads uint8
+	bcryptCost    int
+}
+
+// NewPasswordConfig returns a new config.PasswordConfig.
+func NewPasswordConfig() (config.PasswordConfig, error) {
+	algorithm := os.Getenv(passwordHashAlgorithmEnvName)
+	if len(algorithm) == 0 {
+		return nil, errors.New("password hash algorithm not found")
+	}
+
+	argon2Time, err := strconv.ParseUint(os.Getenv(passwordArgon2TimeEnvName), 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse argon2 time: %w", err)
+	}
+
+	argon2Memory, err := strconv.ParseUint(os.Getenv(passwordArgon2MemoryEnvName), 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse argon2 memory: %w", err)
+	}
+
+	argon2Threads, err := strconv.ParseUint(os.Getenv(passwordArgon2ThreadsEnvName), 10, 8)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse argon2 threads: %w", err)
+	}
+
+	bcryptCost, err := strconv.Atoi(os.Getenv(passwordBcryptCostEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse bcrypt cost: %w", err)
+	}
+
+	return &passwordConfig{
+		algorithm:     algorithm,
+		argon2Time:    uint32(argon2Time),
+		argon2Memory:  uint32(argon2Memory),
+		argon2Threads: uint8(argon2Threads),
+		bcryptCost:    bcryptCost,
+	}, nil
+}
+
+// GetHashAlgorithm returns the password hash algorithm.
+func (c *passwordConfig) GetHashAlgorithm() string {
+	return c.algorithm
+}
+
+// GetArgon2Time returns the number of argon2 iterations.
+func (c *passwordConfig) GetArgon2Time() uint32 {
+	return c.argon2Time
+}
+
+// GetArgon2Memory returns the argon2 memory size in KiB.
+func (c *passwordConfig) GetArgon2Memory() uint32 {
+	return c.argon2Memory
+}
+
+// GetArgon2Threads returns the argon2 parallelism degree.
+func (c *passwordConfig) GetArgon2Threads() uint8 {
+	return c.argon2Threads
+}
+
+// GetBcryptCost returns the bcrypt cost.
+func (c *passwordConfig) GetBcryptCost() int {
+	return c.bcryptCost
+}
@@ -0,0 +1,120 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	passwordPolicyMinLengthEnvName        = "PASSWORD_POLICY_MIN_LENGTH"         // nolint: gosec
+	passwordPolicyMaxLengthEnvName        = "PASSWORD_POLICY_MAX_LENGTH"         // nolint: gosec
+	passwordPolicyRequireLowerEnvName     = "PASSWORD_POLICY_REQUIRE_LOWER"      // nolint: gosec
+	passwordPolicyRequireUpperEnvName     = "PASSWORD_POLICY_REQUIRE_UPPER"      // nolint: gosec
+	passwordPolicyRequireDigitEnvName     = "PASSWORD_POLICY_REQUIRE_DIGIT"      // nolint: gosec
+	passwordPolicyRequireSymbolEnvName    = "PASSWORD_POLICY_REQUIRE_SYMBOL"     // nolint: gosec
+	passwordPolicyBreachedListPathEnvName = "PASSWORD_POLICY_BREACHED_LIST_PATH" // nolint: gosec
+)
+
+// bcryptMaxPasswordLength is the number of bytes bcrypt takes into account.
+const bcryptMaxPasswordLength = 72
+
+type passwordPolicyConfig struct {
+	minLength        int
+	maxLength        int
+	requireLower     bool
+	requireUpper     bool
+	requireDigit     bool
+	requireSymbol    bool
+	breachedListPath string
+}
+
+// NewPasswordPolicyConfig returns a new config.PasswordPolicyConfig.
+func NewPasswordPolicyConfig() (config.PasswordPolicyConfig, error) {
+	minLength, err := strconv.Atoi(os.Getenv(passwordPolicyMinLengthEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse password policy min length: %w", err)
+	}
+
+	maxLength, err := strconv.Atoi(os.Getenv(passwordPolicyMaxLengthEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse password policy max length: %w", err)
+	}
+
+	if minLength < 1 || maxLength < minLength {
+		return nil, errors.New("password policy length bounds are invalid")
+	}
+
+	if maxLength > bcryptMaxPasswordLength {
+		return nil, fmt.Errorf("password policy max length exceeds %d bytes", bcryptMaxPasswordLength)
+	}
+
+	requireLower, err := strconv.ParseBool(os.Getenv(passwordPolicyRequireLowerEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse password policy require lower: %w", err)
+	}
+
+	requireUpper, err := strconv.ParseBool(os.Getenv(passwordPolicyRequireUpperEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse password policy require upper: %w", err)
+	}
+
+	requireDigit, err := strconv.ParseBool(os.Getenv(passwordPolicyRequireDigitEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse password policy require digit: %w", err)
+	}
+
+	requireSymbol, err := strconv.ParseBool(os.Getenv(passwordPolicyRequireSymbolEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse password policy require symbol: %w", err)
+	}
+
+	return &passwordPolicyConfig{
+		minLength:        minLength,
+		maxLength:        maxLength,
+		requireLower:     requireLower,
+		requireUpper:     requireUpper,
+		requireDigit:     requireDigit,
+		requireSymbol:    requireSymbol,
+		breachedListPath: os.Getenv(passwordPolicyBreachedListPathEnvName),
+	}, nil
+}
+
+// GetMinLength returns the minimum password length in characters.
+func (c *passwordPolicyConfig) GetMinLength() int {
+	return c.minLength
+}
+
+// GetMaxLength returns the maximum password length in bytes.
+func (c *passwordPolicyConfig) GetMaxLength() int {
+	return c.maxLength
+}
+
+// IsLowerRequired reports whether passwords must contain a lowercase letter.
+func (c *passwordPolicyConfig) IsLowerRequired() bool {
+	return c.requireLower
+}
+
+// IsUpperRequired reports whether passwords must contain an uppercase letter.
+func (c *passwordPolicyConfig) IsUpperRequired() bool {
+	return c.requireUpper
+}
+
+// IsDigitRequired reports whether passwords must contain a digit.
+func (c *passwordPolicyConfig) IsDigitRequired() bool {
+	return c.requireDigit
+}
+
+// IsSymbolRequired reports whether passwords must contain a character other than a letter or digit.
+func (c *passwordPolicyConfig) IsSymbolRequired() bool {
+	return c.requireSymbol
+}
+
+// GetBreachedListPath returns the path of the newline separated breached-password list.
+// An empty path disables the check.
+func (c *passwordPolicyConfig) GetBreachedListPath() string {
+	return c.breachedListPath
+}
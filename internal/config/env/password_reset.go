@@ -0,0 +1,53 @@
+package env
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	passwordResetTokenTTLEnvName = "PASSWORD_RESET_TOKEN_TTL" // nolint: gosec
+	passwordResetURLEnvName      = "PASSWORD_RESET_URL"
+)
+
+type passwordResetConfig struct {
+	tokenTTL time.Duration
+	url      string
+}
+
+// NewPasswordResetConfig returns a new config.PasswordResetConfig.
+func NewPasswordResetConfig() (config.PasswordResetConfig, error) {
+	tokenTTL, err := time.ParseDuration(os.Getenv(passwordResetTokenTTLEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse password reset token ttl: %w", err)
+	}
+
+	rawURL := os.Getenv(passwordResetURLEnvName)
+	if len(rawURL) == 0 {
+		return nil, errors.New("password reset url not found")
+	}
+
+	if _, err = url.Parse(rawURL); err != nil {
+		return nil, fmt.Errorf("failed to parse password reset url: %w", err)
+	}
+
+	return &passwordResetConfig{
+		tokenTTL: tokenTTL,
+		url:      rawURL,
+	}, nil
+}
+
+// GetTokenTTL returns how long a password reset token stays valid.
+func (c *passwordResetConfig) GetTokenTTL() time.Duration {
+	return c.tokenTTL
+}
+
+// GetURL returns the page address the reset token is appended to as the "token" query parameter.
+func (c *passwordResetConfig) GetURL() string {
+	return c.url
+}
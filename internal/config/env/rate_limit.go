@@ -0,0 +1,80 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+const rateLimitsEnvName = "RATE_LIMITS"
+
+type rateLimitConfig struct {
+	limits map[string]model.RateLimit
+}
+
+// NewRateLimitConfig returns a new config.RateLimitConfig.
+//
+// Limits are read in the form "/pkg.Service/Method:5/1h;POST /oauth/token:10/1m;*:100/1s", allowing
+// the number of requests per period. gRPC methods are limited by their full name and
+// HTTP routes served by the gateway itself by method and path. The "*" limit applies to gRPC methods
+// without a limit of their own. Nothing is limited if the variable is empty.
+func NewRateLimitConfig() (config.RateLimitConfig, error) {
+	limits, err := parseRateLimits(os.Getenv(rateLimitsEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse rate limits: %w", err)
+	}
+
+	return &rateLimitConfig{
+		limits: limits,
+	}, nil
+}
+
+func parseRateLimits(rawLimits string) (map[string]model.RateLimit, error) {
+	limits := make(map[string]model.RateLimit)
+
+	for _, rule := range strings.Split(rawLimits, ";") {
+		rule = strings.TrimSpace(rule)
+		if len(rule) == 0 {
+			continue
+		}
+
+		separator := strings.LastIndex(rule, ":")
+		if separator <= 0 {
+			return nil, fmt.Errorf("invalid rule %q", rule)
+		}
+
+		endpoint := strings.TrimSpace(rule[:separator])
+
+		rawRequests, rawPeriod, ok := strings.Cut(rule[separator+1:], "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid limit of rule %q", rule)
+		}
+
+		requests, err := strconv.Atoi(strings.TrimSpace(rawRequests))
+		if err != nil || requests <= 0 {
+			return nil, fmt.Errorf("invalid number of requests of rule %q", rule)
+		}
+
+		period, err := time.ParseDuration(strings.TrimSpace(rawPeriod))
+		if err != nil || period <= 0 {
+			return nil, fmt.Errorf("invalid period of rule %q", rule)
+		}
+
+		limits[endpoint] = model.RateLimit{
+			Requests: requests,
+			Period:   period,
+		}
+	}
+
+	return limits, nil
+}
+
+// GetLimits returns limits of endpoints.
+func (c *rateLimitConfig) GetLimits() map[string]model.RateLimit {
+	return c.limits
+}
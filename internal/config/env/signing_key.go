@@ -0,0 +1,74 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	signingKeyAlgorithmEnvName             = "SIGNING_KEY_ALGORITHM"
+	signingKeyRotationPeriodEnvName        = "SIGNING_KEY_ROTATION_PERIOD"
+	signingKeyRotationCheckIntervalEnvName = "SIGNING_KEY_ROTATION_CHECK_INTERVAL"
+	signingKeySecretKeyEnvName             = "SIGNING_KEY_SECRET_KEY" // nolint: gosec
+)
+
+type signingKeyConfig struct {
+	algorithm             string
+	rotationPeriod        time.Duration
+	rotationCheckInterval time.Duration
+	secretKey             []byte
+}
+
+// NewSigningKeyConfig returns a new config.SigningKeyConfig.
+func NewSigningKeyConfig() (config.SigningKeyConfig, error) {
+	algorithm := os.Getenv(signingKeyAlgorithmEnvName)
+	if len(algorithm) == 0 {
+		return nil, errors.New("signing key algorithm not found")
+	}
+
+	rotationPeriod, err := time.ParseDuration(os.Getenv(signingKeyRotationPeriodEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signing key rotation period: %w", err)
+	}
+
+	rotationCheckInterval, err := time.ParseDuration(os.Getenv(signingKeyRotationCheckIntervalEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signing key rotation check interval: %w", err)
+	}
+
+	secretKey := os.Getenv(signingKeySecretKeyEnvName)
+	if len(secretKey) == 0 {
+		return nil, errors.New("signing key secret key not found")
+	}
+
+	return &signingKeyConfig{
+		algorithm:             algorithm,
+		rotationPeriod:        rotationPeriod,
+		rotationCheckInterval: rotationCheckInterval,
+		secretKey:             []byte(secretKey),
+	}, nil
+}
+
+// GetAlgorithm returns the algorithm of newly generated keys, RS256 or EdDSA.
+func (c *signingKeyConfig) GetAlgorithm() string {
+	return c.algorithm
+}
+
+// GetRotationPeriod returns how long a key is used to sign new tokens.
+func (c *signingKeyConfig) GetRotationPeriod() time.Duration {
+	return c.rotationPeriod
+}
+
+// GetRotationCheckInterval returns how often keys are checked for rotation and reloaded.
+func (c *signingKeyConfig) GetRotationCheckInterval() time.Duration {
+	return c.rotationCheckInterval
+}
+
+// GetSecretKey returns the key private keys of signing keys are encrypted with at rest.
+func (c *signingKeyConfig) GetSecretKey() []byte {
+	return c.secretKey
+}
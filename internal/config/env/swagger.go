@@ -64,3 +64,8 @@ func (cfg *swaggerConfig) GetReadHeaderTimeout() time.Duration {
 func (cfg *swaggerConfig) GetGracefulShutdownTimeout() time.Duration {
 	return cfg.shutdownTimeout
 }
+
+// GetTLS returns the TLS configuration, swagger is served over plain HTTP.
+func (cfg *swaggerConfig) GetTLS() config.TLSConfig {
+	return &tlsConfig{}
+}
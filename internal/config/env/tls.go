@@ -0,0 +1,83 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+)
+
+const (
+	tlsCertFileEnvSuffix   = "_TLS_CERT_FILE"
+	tlsKeyFileEnvSuffix    = "_TLS_KEY_FILE"
+	tlsCAFileEnvSuffix     = "_TLS_CA_FILE"
+	tlsClientAuthEnvSuffix = "_TLS_CLIENT_AUTH"
+)
+
+type tlsConfig struct {
+	certFile   string
+	keyFile    string
+	caFile     string
+	clientAuth bool
+}
+
+// newTLSConfig reads the TLS configuration of a server from variables starting with prefix.
+//
+// TLS is enabled once a certificate and a key are set. The CA verifies client certificates and,
+// with client auth enabled, every client must present one signed by it.
+func newTLSConfig(prefix string) (config.TLSConfig, error) {
+	cfg := tlsConfig{
+		certFile: os.Getenv(prefix + tlsCertFileEnvSuffix),
+		keyFile:  os.Getenv(prefix + tlsKeyFileEnvSuffix),
+		caFile:   os.Getenv(prefix + tlsCAFileEnvSuffix),
+	}
+
+	if rawClientAuth := os.Getenv(prefix + tlsClientAuthEnvSuffix); len(rawClientAuth) != 0 {
+		clientAuth, err := strconv.ParseBool(rawClientAuth)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tls client auth: %w", err)
+		}
+		cfg.clientAuth = clientAuth
+	}
+
+	if (len(cfg.certFile) == 0) != (len(cfg.keyFile) == 0) {
+		return nil, errors.New("tls certificate and key must be set together")
+	}
+
+	if !cfg.IsEnabled() && (len(cfg.caFile) != 0 || cfg.clientAuth) {
+		return nil, errors.New("tls certificate and key are required to verify clients")
+	}
+
+	if cfg.clientAuth && len(cfg.caFile) == 0 {
+		return nil, errors.New("tls ca is required to verify clients")
+	}
+
+	return &cfg, nil
+}
+
+// IsEnabled reports whether the server accepts TLS connections only.
+func (cfg *tlsConfig) IsEnabled() bool {
+	return len(cfg.certFile) != 0
+}
+
+// GetCertFile returns path to the server certificate.
+func (cfg *tlsConfig) GetCertFile() string {
+	return cfg.certFile
+}
+
+// GetKeyFile returns path to the server private key.
+func (cfg *tlsConfig) GetKeyFile() string {
+	return cfg.keyFile
+}
+
+// GetCAFile returns path to the CA certificates verifying peers.
+func (cfg *tlsConfig) GetCAFile() string {
+	return cfg.caFile
+}
+
+// IsClientAuthRequired reports whether clients must present a certificate signed by the CA.
+func (cfg *tlsConfig) IsClientAuthRequired() bool {
+	return cfg.clientAuth
+}
@@ -0,0 +1,74 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	refreshTokenSecretKeyEnvName = "REFRESH_TOKEN_SECRET_KEY" // nolint: gosec
+	accessTokenTTLEnvName        = "ACCESS_TOKEN_TTL"
+	refreshTokenTTLEnvName       = "REFRESH_TOKEN_TTL"
+	impersonationTokenTTLEnvName = "IMPERSONATION_TOKEN_TTL"
+)
+
+type tokenConfig struct {
+	refreshTokenSecretKey []byte
+	accessTokenTTL        time.Duration
+	refreshTokenTTL       time.Duration
+	impersonationTokenTTL time.Duration
+}
+
+// NewTokenConfig returns a new config.TokenConfig.
+func NewTokenConfig() (config.TokenConfig, error) {
+	refreshTokenSecretKey := os.Getenv(refreshTokenSecretKeyEnvName)
+	if len(refreshTokenSecretKey) == 0 {
+		return nil, errors.New("refresh token secret key not found")
+	}
+
+	accessTokenTTL, err := time.ParseDuration(os.Getenv(accessTokenTTLEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse access token ttl: %w", err)
+	}
+
+	refreshTokenTTL, err := time.ParseDuration(os.Getenv(refreshTokenTTLEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse refresh token ttl: %w", err)
+	}
+
+	impersonationTokenTTL, err := time.ParseDuration(os.Getenv(impersonationTokenTTLEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse impersonation token ttl: %w", err)
+	}
+
+	return &tokenConfig{
+		refreshTokenSecretKey: []byte(refreshTokenSecretKey),
+		accessTokenTTL:        accessTokenTTL,
+		refreshTokenTTL:       refreshTokenTTL,
+		impersonationTokenTTL: impersonationTokenTTL,
+	}, nil
+}
+
+// GetRefreshTokenSecretKey returns the key used to sign refresh tokens.
+func (c *tokenConfig) GetRefreshTokenSecretKey() []byte {
+	return c.refreshTokenSecretKey
+}
+
+// GetAccessTokenTTL returns the access token lifetime.
+func (c *tokenConfig) GetAccessTokenTTL() time.Duration {
+	return c.accessTokenTTL
+}
+
+// GetRefreshTokenTTL returns the refresh token lifetime.
+func (c *tokenConfig) GetRefreshTokenTTL() time.Duration {
+	return c.refreshTokenTTL
+}
+
+// GetImpersonationTokenTTL returns the lifetime of access tokens issued to admins acting as users.
+func (c *tokenConfig) GetImpersonationTokenTTL() time.Duration {
+	return c.impersonationTokenTTL
+}
@@ -0,0 +1,56 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	workerCountEnvName     = "WORKER_COUNT"
+	workerQueueSizeEnvName = "WORKER_QUEUE_SIZE"
+)
+
+type workerConfig struct {
+	count     int
+	queueSize int
+}
+
+// NewWorkerConfig returns a new config.WorkerConfig.
+func NewWorkerConfig() (config.WorkerConfig, error) {
+	count, err := strconv.Atoi(os.Getenv(workerCountEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse worker count: %w", err)
+	}
+
+	if count < 1 {
+		return nil, errors.New("worker count must be positive")
+	}
+
+	queueSize, err := strconv.Atoi(os.Getenv(workerQueueSizeEnvName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse worker queue size: %w", err)
+	}
+
+	if queueSize < 1 {
+		return nil, errors.New("worker queue size must be positive")
+	}
+
+	return &workerConfig{
+		count:     count,
+		queueSize: queueSize,
+	}, nil
+}
+
+// GetCount returns how many background tasks run at once.
+func (c *workerConfig) GetCount() int {
+	return c.count
+}
+
+// GetQueueSize returns how many background tasks may wait for a worker, tasks beyond it are dropped.
+func (c *workerConfig) GetQueueSize() int {
+	return c.queueSize
+}
@@ -0,0 +1,27 @@
+package endpoint
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying address of the endpoint the request is made to.
+// It is used when the request is checked on behalf of another service.
+func NewContext(ctx context.Context, address string) context.Context {
+	return context.WithValue(ctx, contextKey{}, address)
+}
+
+// FromContext returns address of the endpoint the request is made to: the one put by NewContext,
+// otherwise the full method of the incoming gRPC request, or an empty string outside of one.
+func FromContext(ctx context.Context) string {
+	if address, ok := ctx.Value(contextKey{}).(string); ok {
+		return address
+	}
+
+	method, _ := grpc.Method(ctx)
+
+	return method
+}
@@ -0,0 +1,55 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/endpoint"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type serverTransportStream struct {
+	grpc.ServerTransportStream
+	method string
+}
+
+func (s *serverTransportStream) Method() string {
+	return s.method
+}
+
+func TestFromContext(t *testing.T) {
+	method := "/user_v1.UserV1/Get"
+	incoming := grpc.NewContextWithServerTransportStream(
+		context.Background(),
+		&serverTransportStream{method: method},
+	)
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{
+			name: "outside of grpc request",
+			ctx:  context.Background(),
+			want: "",
+		},
+		{
+			name: "grpc request",
+			ctx:  incoming,
+			want: method,
+		},
+		{
+			name: "endpoint checked on behalf of another service",
+			ctx:  endpoint.NewContext(incoming, "/chat_v1.Chat/SendMessage"),
+			want: "/chat_v1.Chat/SendMessage",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, endpoint.FromContext(tt.ctx))
+		})
+	}
+}
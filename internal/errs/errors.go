@@ -7,11 +7,133 @@ type ErrorCode int
 const (
 	Unknown ErrorCode = 1000 + iota
 	UserNotFound
+	InvalidCredentials
+	InvalidRefreshToken
+	RefreshTokenNotFound
+	InvalidAccessToken
+	AccessDenied
+	AccessPolicyNotFound
+	SigningKeyNotFound
+	WeakPassword
+	InvalidPasswordResetToken
+	PasswordResetTokenNotFound
+	InvalidEmailVerificationToken
+	EmailVerificationTokenNotFound
+	EmailNotVerified
+	AccountLocked
+	TooManyLoginAttempts
+	MFARequired
+	MFAAlreadyEnabled
+	MFANotEnrolled
+	InvalidMFACode
+	InvalidMFAChallenge
+	MFAChallengeNotFound
+	RecoveryCodeNotFound
+	InvalidAPIKey
+	APIKeyNotFound
+	InvalidClient
+	InvalidScope
+	OAuthClientNotFound
+	InvalidRedirectURI
+	InvalidAuthorizationRequest
+	InvalidGrant
+	AuthorizationCodeNotFound
+	InvalidOAuthClient
+	SessionNotFound
+	RoleNotFound
+	RoleAlreadyExists
+	InvalidRole
+	BuiltInRole
+	ImpersonatedSession
+	RateLimited
+	InvalidPageToken
 )
 
 var (
-	ErrUnknown      = NewError(Unknown, "unknown error")       // ErrUnknown represents an unknown error.
-	ErrUserNotFound = NewError(UserNotFound, "user not found") // ErrUserNotFound represents a user not found error.
+	// ErrUnknown represents an unknown error.
+	ErrUnknown = NewError(Unknown, "unknown error")
+	// ErrUserNotFound represents a user not found error.
+	ErrUserNotFound = NewError(UserNotFound, "user not found")
+	// ErrInvalidCredentials represents a wrong email or password error.
+	ErrInvalidCredentials = NewError(InvalidCredentials, "invalid credentials")
+	// ErrInvalidRefreshToken represents an expired, revoked or reused refresh token error.
+	ErrInvalidRefreshToken = NewError(InvalidRefreshToken, "invalid refresh token")
+	// ErrRefreshTokenNotFound represents a refresh token not found error.
+	ErrRefreshTokenNotFound = NewError(RefreshTokenNotFound, "refresh token not found")
+	// ErrInvalidAccessToken represents a missing, malformed or expired access token error.
+	ErrInvalidAccessToken = NewError(InvalidAccessToken, "invalid access token")
+	// ErrAccessDenied represents an endpoint access denied error.
+	ErrAccessDenied = NewError(AccessDenied, "access denied")
+	// ErrAccessPolicyNotFound represents an access policy not found error.
+	ErrAccessPolicyNotFound = NewError(AccessPolicyNotFound, "access policy not found")
+	// ErrSigningKeyNotFound represents a missing or expired signing key error.
+	ErrSigningKeyNotFound = NewError(SigningKeyNotFound, "signing key not found")
+	// ErrWeakPassword represents a password that does not satisfy the password policy error.
+	ErrWeakPassword = NewError(WeakPassword, "password does not satisfy password policy")
+	// ErrInvalidPasswordResetToken represents an unknown, expired or already used password reset token error.
+	ErrInvalidPasswordResetToken = NewError(InvalidPasswordResetToken, "invalid password reset token")
+	// ErrPasswordResetTokenNotFound represents a password reset token not found error.
+	ErrPasswordResetTokenNotFound = NewError(PasswordResetTokenNotFound, "password reset token not found")
+	// ErrInvalidEmailVerificationToken represents an unknown, expired, used or outdated email verification token error.
+	ErrInvalidEmailVerificationToken = NewError(InvalidEmailVerificationToken, "invalid email verification token")
+	// ErrEmailVerificationTokenNotFound represents an email verification token not found error.
+	ErrEmailVerificationTokenNotFound = NewError(EmailVerificationTokenNotFound, "email verification token not found")
+	// ErrEmailNotVerified represents an action which requires a verified email error.
+	ErrEmailNotVerified = NewError(EmailNotVerified, "email is not verified")
+	// ErrAccountLocked represents an account temporarily locked after too many failed login attempts error.
+	ErrAccountLocked = NewError(AccountLocked, "account is temporarily locked")
+	// ErrTooManyLoginAttempts represents a client address throttled after too many failed login attempts error.
+	ErrTooManyLoginAttempts = NewError(TooManyLoginAttempts, "too many login attempts")
+	// ErrMFARequired represents an action which requires a session opened with the second factor error.
+	ErrMFARequired = NewError(MFARequired, "two-factor authentication is required")
+	// ErrMFAAlreadyEnabled represents an enrollment of an already enabled second factor error.
+	ErrMFAAlreadyEnabled = NewError(MFAAlreadyEnabled, "two-factor authentication is already enabled")
+	// ErrMFANotEnrolled represents a confirmation or removal of a second factor which was not enrolled error.
+	ErrMFANotEnrolled = NewError(MFANotEnrolled, "two-factor authentication is not enrolled")
+	// ErrInvalidMFACode represents a wrong, reused or expired TOTP or recovery code error.
+	ErrInvalidMFACode = NewError(InvalidMFACode, "invalid two-factor authentication code")
+	// ErrInvalidMFAChallenge represents an unknown, expired or exhausted MFA challenge token error.
+	ErrInvalidMFAChallenge = NewError(InvalidMFAChallenge, "invalid two-factor authentication challenge")
+	// ErrMFAChallengeNotFound represents an MFA challenge not found error.
+	ErrMFAChallengeNotFound = NewError(MFAChallengeNotFound, "two-factor authentication challenge not found")
+	// ErrRecoveryCodeNotFound represents an unknown or already used recovery code error.
+	ErrRecoveryCodeNotFound = NewError(RecoveryCodeNotFound, "recovery code not found")
+	// ErrInvalidAPIKey represents an API key request with wrong scopes or expiration error.
+	ErrInvalidAPIKey = NewError(InvalidAPIKey, "invalid api key")
+	// ErrAPIKeyNotFound represents an unknown or already revoked API key error.
+	ErrAPIKeyNotFound = NewError(APIKeyNotFound, "api key not found")
+	// ErrInvalidClient represents an unknown or revoked OAuth client or a wrong client secret error.
+	ErrInvalidClient = NewError(InvalidClient, "invalid client")
+	// ErrInvalidScope represents a malformed scope or a scope not granted to the OAuth client error.
+	ErrInvalidScope = NewError(InvalidScope, "invalid scope")
+	// ErrOAuthClientNotFound represents an unknown or already revoked OAuth client error.
+	ErrOAuthClientNotFound = NewError(OAuthClientNotFound, "oauth client not found")
+	// ErrInvalidRedirectURI represents a redirect URI not registered for the OAuth client error.
+	ErrInvalidRedirectURI = NewError(InvalidRedirectURI, "invalid redirect uri")
+	// ErrInvalidAuthorizationRequest represents an authorization request with unsupported or missing parameters error.
+	ErrInvalidAuthorizationRequest = NewError(InvalidAuthorizationRequest, "invalid authorization request")
+	// ErrInvalidGrant represents an unknown, expired or already used authorization code or a failed PKCE check error.
+	ErrInvalidGrant = NewError(InvalidGrant, "invalid grant")
+	// ErrAuthorizationCodeNotFound represents an authorization code not found error.
+	ErrAuthorizationCodeNotFound = NewError(AuthorizationCodeNotFound, "authorization code not found")
+	// ErrInvalidOAuthClient represents an OAuth client registration with wrong scopes or redirect URIs error.
+	ErrInvalidOAuthClient = NewError(InvalidOAuthClient, "invalid oauth client")
+	// ErrSessionNotFound represents an unknown, expired or already revoked session error.
+	ErrSessionNotFound = NewError(SessionNotFound, "session not found")
+	// ErrRoleNotFound represents an unknown role or a role not assigned to the user error.
+	ErrRoleNotFound = NewError(RoleNotFound, "role not found")
+	// ErrRoleAlreadyExists represents a role name which is already taken error.
+	ErrRoleAlreadyExists = NewError(RoleAlreadyExists, "role already exists")
+	// ErrInvalidRole represents a role with a wrong name or permissions error.
+	ErrInvalidRole = NewError(InvalidRole, "invalid role")
+	// ErrBuiltInRole represents a change of a built-in role which may not be changed error.
+	ErrBuiltInRole = NewError(BuiltInRole, "built-in role cannot be changed")
+	// ErrImpersonatedSession represents an action which may not be done in an impersonated session error.
+	ErrImpersonatedSession = NewError(ImpersonatedSession, "not allowed in an impersonated session")
+	// ErrRateLimited represents a caller who made too many requests to the endpoint error.
+	ErrRateLimited = NewError(RateLimited, "too many requests")
+	// ErrInvalidPageToken represents a malformed page token or a token of a search with another sorting error.
+	ErrInvalidPageToken = NewError(InvalidPageToken, "invalid page token")
 )
 
 // Error represents an error.
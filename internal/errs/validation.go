@@ -0,0 +1,40 @@
+package errs
+
+import "strings"
+
+// FieldViolation describes a single reason why a request field is invalid.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// ValidationError represents a domain error caused by one or more field violations.
+// It unwraps to the domain error, so errors.Is keeps working for callers
+// which do not care about the details.
+type ValidationError struct {
+	Err        error
+	Violations []FieldViolation
+}
+
+// NewValidationError creates a new validation error.
+func NewValidationError(err error, violations ...FieldViolation) error {
+	return &ValidationError{
+		Err:        err,
+		Violations: violations,
+	}
+}
+
+// Error returns the domain error message followed by every violation description.
+func (e *ValidationError) Error() string {
+	descriptions := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		descriptions = append(descriptions, violation.Field+": "+violation.Description)
+	}
+
+	return e.Err.Error() + ": " + strings.Join(descriptions, "; ")
+}
+
+// Unwrap returns the domain error.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
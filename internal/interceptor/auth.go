@@ -0,0 +1,50 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/principal"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewAuthInterceptor returns an interceptor authenticating the caller by the bearer token of the request
+// and putting them into the context. Requests without a token are passed on anonymous, it is up to
+// the endpoint whether to serve them, while requests with an invalid token are rejected.
+// API keys and OAuth clients may call only the methods matching their scopes, except checkMethods
+// which check the scopes against the endpoint they are asked about themselves.
+func NewAuthInterceptor(sessionService service.SessionService, checkMethods ...string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handlerFunc grpc.UnaryHandler,
+	) (interface{}, error) {
+		accessToken, ok := token.FromIncomingContext(ctx)
+		if !ok {
+			return handlerFunc(ctx, req)
+		}
+
+		claims, err := sessionService.Authenticate(ctx, accessToken)
+		if err != nil {
+			if errors.Is(err, errs.ErrInvalidAccessToken) {
+				return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+			}
+
+			return nil, fmt.Errorf("failed to authenticate: %w", err)
+		}
+
+		if !claims.Allows(info.FullMethod) && !slices.Contains(checkMethods, info.FullMethod) {
+			return nil, status.Error(codes.PermissionDenied, errs.ErrAccessDenied.Error())
+		}
+
+		return handlerFunc(principal.NewContext(ctx, accessToken, claims), req)
+	}
+}
@@ -0,0 +1,78 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/principal"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Rule declares who may call a method.
+type Rule struct {
+	// Public reports whether anyone, including anonymous callers, may make the request.
+	Public func(req interface{}) bool
+	// Self returns the user the request is made for, if users may make it for themselves.
+	Self func(req interface{}) (int64, bool)
+	// Permission lets its holders make any request to the method. They must have opened
+	// their session with the second factor.
+	Permission string
+}
+
+// Rules maps full gRPC method names to their rules.
+type Rules map[string]Rule
+
+// NewAuthorizeInterceptor returns an interceptor enforcing rules on the caller put into the context
+// by the auth interceptor. API keys and OAuth clients are also limited to their scopes.
+// Methods without a rule are authorized by their handlers.
+func NewAuthorizeInterceptor(roleService service.RoleService, rules Rules) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handlerFunc grpc.UnaryHandler,
+	) (interface{}, error) {
+		rule, ok := rules[info.FullMethod]
+		if !ok || rule.Public != nil && rule.Public(req) {
+			return handlerFunc(ctx, req)
+		}
+
+		claims, ok := principal.FromContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidAccessToken.Error())
+		}
+
+		if !claims.Allows(info.FullMethod) {
+			return nil, status.Error(codes.PermissionDenied, errs.ErrAccessDenied.Error())
+		}
+
+		if rule.Self != nil && claims.ClientID == "" {
+			if userID, ok := rule.Self(req); ok && userID == claims.UserID {
+				return handlerFunc(ctx, req)
+			}
+		}
+
+		if rule.Permission == "" {
+			return nil, status.Error(codes.PermissionDenied, errs.ErrAccessDenied.Error())
+		}
+
+		granted, err := roleService.HasPermission(ctx, claims.UserID, claims.OrgID, rule.Permission)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check permission: %w", err)
+		}
+
+		if !granted {
+			return nil, status.Error(codes.PermissionDenied, errs.ErrAccessDenied.Error())
+		}
+
+		if !claims.MFA {
+			return nil, status.Error(codes.PermissionDenied, errs.ErrMFARequired.Error())
+		}
+
+		return handlerFunc(ctx, req)
+	}
+}
@@ -0,0 +1,68 @@
+package interceptor
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/clientip"
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/principal"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RetryAfterHeader is the response header telling rate limited callers when to retry, in seconds.
+const RetryAfterHeader = "retry-after"
+
+// NewRateLimitInterceptor returns an interceptor limiting the rate of requests to each method by caller:
+// the API key, OAuth client or user put into the context by the auth interceptor, otherwise the client address.
+// Requests are let through if the limiter is unavailable.
+func NewRateLimitInterceptor(
+	logger *slog.Logger,
+	rateLimitService service.RateLimitService,
+) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handlerFunc grpc.UnaryHandler,
+	) (interface{}, error) {
+		result, err := rateLimitService.Take(ctx, info.FullMethod, caller(ctx))
+		if err != nil {
+			logger.Error("failed to take rate limit token", slog.String("error", err.Error()))
+			return handlerFunc(ctx, req)
+		}
+
+		if !result.Allowed {
+			err = grpc.SetHeader(ctx, metadata.Pairs(
+				RetryAfterHeader,
+				strconv.FormatInt(result.RetryAfterSeconds(), 10),
+			))
+			if err != nil {
+				logger.Error("failed to set retry after header", slog.String("error", err.Error()))
+			}
+
+			return nil, status.Error(codes.ResourceExhausted, errs.ErrRateLimited.Error())
+		}
+
+		return handlerFunc(ctx, req)
+	}
+}
+
+func caller(ctx context.Context) string {
+	claims, ok := principal.FromContext(ctx)
+	switch {
+	case !ok:
+		return "ip:" + clientip.FromIncomingContext(ctx)
+	case claims.APIKeyID != "":
+		return "api_key:" + claims.APIKeyID
+	case claims.ClientID != "":
+		return "client:" + claims.ClientID
+	default:
+		return "user:" + strconv.FormatInt(claims.UserID, 10)
+	}
+}
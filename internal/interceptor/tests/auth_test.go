@@ -0,0 +1,171 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	accessv1 "github.com/Paul1k96/microservices_course_auth/internal/api/proto/access/v1"
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/interceptor"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/principal"
+	svcMocks "github.com/Paul1k96/microservices_course_auth/internal/service/mocks"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthSuite(t *testing.T) {
+	suite.Run(t, new(AuthSuite))
+}
+
+type AuthSuite struct {
+	suite.Suite
+	*require.Assertions
+	ctrl *gomock.Controller
+
+	sessions *svcMocks.MockSessionService
+
+	interceptor grpc.UnaryServerInterceptor
+}
+
+func (t *AuthSuite) SetupTest() {
+	t.Assertions = require.New(t.T())
+	t.ctrl = gomock.NewController(t.T())
+
+	t.sessions = svcMocks.NewMockSessionService(t.ctrl)
+
+	t.interceptor = interceptor.NewAuthInterceptor(t.sessions, accessv1.CheckMethod)
+}
+
+func (t *AuthSuite) TearDownTest() {
+	t.ctrl.Finish()
+}
+
+// call calls method with accessToken and returns the caller the handler was called with, if it was.
+func (t *AuthSuite) call(accessToken, method string) (*model.UserClaims, bool, error) {
+	ctx := context.Background()
+	if accessToken != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+accessToken))
+	}
+
+	var (
+		caller *model.UserClaims
+		called bool
+	)
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		caller, _ = principal.FromContext(ctx)
+		called = true
+		return nil, nil
+	}
+
+	_, err := t.interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+
+	return caller, called, err
+}
+
+func (t *AuthSuite) TestAnonymous() {
+	caller, called, err := t.call("", "/auth_v1.Auth/Login")
+	t.Require().NoError(err)
+	t.Require().True(called)
+	t.Require().Nil(caller)
+}
+
+func (t *AuthSuite) TestUserToken() {
+	accessToken := gofakeit.LetterN(64)
+	claims := &model.UserClaims{UserID: gofakeit.Int64(), OrgID: model.DefaultOrgID}
+
+	t.sessions.EXPECT().Authenticate(gomock.Any(), accessToken).Return(claims, nil)
+
+	caller, called, err := t.call(accessToken, "/auth_v1.Auth/Impersonate")
+	t.Require().NoError(err)
+	t.Require().True(called)
+	t.Require().Equal(claims, caller)
+}
+
+func (t *AuthSuite) TestInvalidToken() {
+	accessToken := gofakeit.LetterN(64)
+
+	t.sessions.EXPECT().Authenticate(gomock.Any(), accessToken).Return(nil, errs.ErrInvalidAccessToken)
+
+	_, called, err := t.call(accessToken, "/user_v1.User/Get")
+	t.Require().Equal(codes.Unauthenticated, status.Code(err))
+	t.Require().False(called)
+}
+
+func (t *AuthSuite) TestAPIKeyOutOfScope() {
+	methods := []string{
+		"/auth_v1.Auth/Impersonate",
+		"/auth_v1.Auth/RevokeUserTokens",
+		"/auth_v1.Auth/CreateOAuthClient",
+		"/user_v1.User/ChangePassword",
+		"/user_v1.User/UnlockUser",
+	}
+
+	for _, method := range methods {
+		t.Run(method, func() {
+			accessToken := gofakeit.LetterN(64)
+			claims := &model.UserClaims{
+				UserID:   gofakeit.Int64(),
+				APIKeyID: gofakeit.UUID(),
+				Scopes:   []string{"/chat_v1.Chat/SendMessage"},
+			}
+
+			t.sessions.EXPECT().Authenticate(gomock.Any(), accessToken).Return(claims, nil)
+
+			_, called, err := t.call(accessToken, method)
+			t.Require().Equal(codes.PermissionDenied, status.Code(err))
+			t.Require().False(called)
+		})
+	}
+}
+
+func (t *AuthSuite) TestClientOutOfScope() {
+	accessToken := gofakeit.LetterN(64)
+	claims := &model.UserClaims{
+		UserID:   gofakeit.Int64(),
+		ClientID: gofakeit.UUID(),
+		Scopes:   []string{model.ScopeOpenID, model.ScopeEmail},
+	}
+
+	t.sessions.EXPECT().Authenticate(gomock.Any(), accessToken).Return(claims, nil)
+
+	_, called, err := t.call(accessToken, "/auth_v1.Auth/CreateAPIKey")
+	t.Require().Equal(codes.PermissionDenied, status.Code(err))
+	t.Require().False(called)
+}
+
+func (t *AuthSuite) TestAPIKeyInScope() {
+	accessToken := gofakeit.LetterN(64)
+	claims := &model.UserClaims{
+		UserID:   gofakeit.Int64(),
+		APIKeyID: gofakeit.UUID(),
+		Scopes:   []string{"/user_v1.User/*"},
+	}
+
+	t.sessions.EXPECT().Authenticate(gomock.Any(), accessToken).Return(claims, nil)
+
+	_, called, err := t.call(accessToken, "/user_v1.User/Get")
+	t.Require().NoError(err)
+	t.Require().True(called)
+}
+
+func (t *AuthSuite) TestAPIKeyAccessCheck() {
+	accessToken := gofakeit.LetterN(64)
+	claims := &model.UserClaims{
+		UserID:   gofakeit.Int64(),
+		APIKeyID: gofakeit.UUID(),
+		Scopes:   []string{"/chat_v1.Chat/SendMessage"},
+	}
+
+	t.sessions.EXPECT().Authenticate(gomock.Any(), accessToken).Return(claims, nil)
+
+	_, called, err := t.call(accessToken, accessv1.CheckMethod)
+	t.Require().NoError(err)
+	t.Require().True(called)
+}
@@ -0,0 +1,167 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	userv1 "github.com/Paul1k96/microservices_course_auth/internal/api/proto/user/v1"
+	"github.com/Paul1k96/microservices_course_auth/internal/interceptor"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/principal"
+	svcMocks "github.com/Paul1k96/microservices_course_auth/internal/service/mocks"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthorizeSuite(t *testing.T) {
+	suite.Run(t, new(AuthorizeSuite))
+}
+
+type AuthorizeSuite struct {
+	suite.Suite
+	*require.Assertions
+	ctrl *gomock.Controller
+
+	roles *svcMocks.MockRoleService
+
+	interceptor grpc.UnaryServerInterceptor
+}
+
+func (t *AuthorizeSuite) SetupTest() {
+	t.Assertions = require.New(t.T())
+	t.ctrl = gomock.NewController(t.T())
+
+	t.roles = svcMocks.NewMockRoleService(t.ctrl)
+
+	t.interceptor = interceptor.NewAuthorizeInterceptor(t.roles, userv1.Rules())
+}
+
+func (t *AuthorizeSuite) TearDownTest() {
+	t.ctrl.Finish()
+}
+
+func (t *AuthorizeSuite) call(ctx context.Context, method string, req interface{}) (bool, error) {
+	var called bool
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		called = true
+		return nil, nil
+	}
+
+	_, err := t.interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+
+	return called, err
+}
+
+func (t *AuthorizeSuite) withCaller(claims *model.UserClaims) context.Context {
+	return principal.NewContext(context.Background(), gofakeit.LetterN(64), claims)
+}
+
+func (t *AuthorizeSuite) requireCode(code codes.Code, err error) {
+	t.Require().Error(err)
+	t.Require().Equal(code, status.Code(err))
+}
+
+func (t *AuthorizeSuite) TestMethodWithoutRule() {
+	called, err := t.call(context.Background(), "/user_v1.User/VerifyEmail", &desc.VerifyEmailRequest{})
+	t.Require().NoError(err)
+	t.Require().True(called)
+}
+
+func (t *AuthorizeSuite) TestCreate_Anonymous() {
+	called, err := t.call(context.Background(), "/user_v1.User/Create", &desc.CreateRequest{Role: desc.Role_USER})
+	t.Require().NoError(err)
+	t.Require().True(called)
+}
+
+func (t *AuthorizeSuite) TestCreate_AdminRoleAnonymous() {
+	called, err := t.call(context.Background(), "/user_v1.User/Create", &desc.CreateRequest{Role: desc.Role_ADMIN})
+	t.requireCode(codes.Unauthenticated, err)
+	t.Require().False(called)
+}
+
+func (t *AuthorizeSuite) TestGet_Self() {
+	claims := &model.UserClaims{UserID: gofakeit.Int64()}
+
+	called, err := t.call(t.withCaller(claims), "/user_v1.User/Get", &desc.GetRequest{Id: claims.UserID})
+	t.Require().NoError(err)
+	t.Require().True(called)
+}
+
+func (t *AuthorizeSuite) TestGet_Anonymous() {
+	called, err := t.call(context.Background(), "/user_v1.User/Get", &desc.GetRequest{Id: gofakeit.Int64()})
+	t.requireCode(codes.Unauthenticated, err)
+	t.Require().False(called)
+}
+
+func (t *AuthorizeSuite) TestGet_OtherUser() {
+	claims := &model.UserClaims{UserID: gofakeit.Int64(), OrgID: model.DefaultOrgID}
+	ctx := t.withCaller(claims)
+
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionManageUsers).Return(false, nil)
+
+	called, err := t.call(ctx, "/user_v1.User/Get", &desc.GetRequest{Id: gofakeit.Int64()})
+	t.requireCode(codes.PermissionDenied, err)
+	t.Require().False(called)
+}
+
+func (t *AuthorizeSuite) TestUpdate_SelfChangingRole() {
+	claims := &model.UserClaims{UserID: gofakeit.Int64(), OrgID: model.DefaultOrgID}
+	ctx := t.withCaller(claims)
+
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionManageUsers).Return(false, nil)
+
+	called, err := t.call(ctx, "/user_v1.User/Update", &desc.UpdateRequest{Id: claims.UserID, Role: desc.Role_ADMIN})
+	t.requireCode(codes.PermissionDenied, err)
+	t.Require().False(called)
+}
+
+func (t *AuthorizeSuite) TestDelete_Admin() {
+	claims := &model.UserClaims{UserID: gofakeit.Int64(), OrgID: model.DefaultOrgID, MFA: true}
+	ctx := t.withCaller(claims)
+
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionManageUsers).Return(true, nil)
+
+	called, err := t.call(ctx, "/user_v1.User/Delete", &desc.DeleteRequest{Id: gofakeit.Int64()})
+	t.Require().NoError(err)
+	t.Require().True(called)
+}
+
+func (t *AuthorizeSuite) TestDelete_AdminWithoutMFA() {
+	claims := &model.UserClaims{UserID: gofakeit.Int64(), OrgID: model.DefaultOrgID}
+	ctx := t.withCaller(claims)
+
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionManageUsers).Return(true, nil)
+
+	called, err := t.call(ctx, "/user_v1.User/Delete", &desc.DeleteRequest{Id: gofakeit.Int64()})
+	t.requireCode(codes.PermissionDenied, err)
+	t.Require().False(called)
+}
+
+func (t *AuthorizeSuite) TestDelete_SelfIsNotEnough() {
+	claims := &model.UserClaims{UserID: gofakeit.Int64(), OrgID: model.DefaultOrgID, MFA: true}
+	ctx := t.withCaller(claims)
+
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionManageUsers).Return(false, nil)
+
+	called, err := t.call(ctx, "/user_v1.User/Delete", &desc.DeleteRequest{Id: claims.UserID})
+	t.requireCode(codes.PermissionDenied, err)
+	t.Require().False(called)
+}
+
+func (t *AuthorizeSuite) TestGet_APIKeyOutOfScope() {
+	claims := &model.UserClaims{
+		UserID:   gofakeit.Int64(),
+		APIKeyID: gofakeit.UUID(),
+		Scopes:   []string{"/chat_v1.Chat/*"},
+	}
+
+	called, err := t.call(t.withCaller(claims), "/user_v1.User/Get", &desc.GetRequest{Id: claims.UserID})
+	t.requireCode(codes.PermissionDenied, err)
+	t.Require().False(called)
+}
@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/interceptor"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/principal"
+	svcMocks "github.com/Paul1k96/microservices_course_auth/internal/service/mocks"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const createMethod = "/user_v1.User/Create"
+
+type serverTransportStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *serverTransportStream) Method() string {
+	return createMethod
+}
+
+func (s *serverTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestRateLimitSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitSuite))
+}
+
+type RateLimitSuite struct {
+	suite.Suite
+	*require.Assertions
+	ctrl *gomock.Controller
+
+	rateLimits *svcMocks.MockRateLimitService
+
+	interceptor grpc.UnaryServerInterceptor
+}
+
+func (t *RateLimitSuite) SetupTest() {
+	t.Assertions = require.New(t.T())
+	t.ctrl = gomock.NewController(t.T())
+
+	t.rateLimits = svcMocks.NewMockRateLimitService(t.ctrl)
+
+	t.interceptor = interceptor.NewRateLimitInterceptor(slog.Default(), t.rateLimits)
+}
+
+func (t *RateLimitSuite) TearDownTest() {
+	t.ctrl.Finish()
+}
+
+func (t *RateLimitSuite) call(ctx context.Context) (bool, error) {
+	var called bool
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		called = true
+		return nil, nil
+	}
+
+	_, err := t.interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: createMethod}, handler)
+
+	return called, err
+}
+
+func (t *RateLimitSuite) TestRateLimit_Allowed() {
+	claims := &model.UserClaims{UserID: 42}
+	ctx := principal.NewContext(context.Background(), gofakeit.LetterN(64), claims)
+
+	t.rateLimits.EXPECT().Take(ctx, createMethod, "user:42").Return(&model.RateLimitResult{Allowed: true}, nil)
+
+	called, err := t.call(ctx)
+	t.Require().NoError(err)
+	t.Require().True(called)
+}
+
+func (t *RateLimitSuite) TestRateLimit_Exhausted() {
+	stream := &serverTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	claims := &model.UserClaims{UserID: 42, APIKeyID: "key"}
+	ctx = principal.NewContext(ctx, gofakeit.LetterN(64), claims)
+
+	t.rateLimits.EXPECT().
+		Take(ctx, createMethod, "api_key:key").
+		Return(&model.RateLimitResult{RetryAfter: 1500 * time.Millisecond}, nil)
+
+	called, err := t.call(ctx)
+	t.Require().False(called)
+	t.Require().Equal(codes.ResourceExhausted, status.Code(err))
+	t.Require().Equal([]string{"2"}, stream.header.Get(interceptor.RetryAfterHeader))
+}
+
+func (t *RateLimitSuite) TestRateLimit_LimiterUnavailable() {
+	ctx := context.Background()
+
+	t.rateLimits.EXPECT().Take(ctx, createMethod, "ip:").Return(nil, gofakeit.Error())
+
+	called, err := t.call(ctx)
+	t.Require().NoError(err)
+	t.Require().True(called)
+}
@@ -0,0 +1,125 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/auth_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ToLoginResponseFromService converts login result to api response.
+func ToLoginResponseFromService(result *model.LoginResult) *desc.LoginResponse {
+	if result.Tokens == nil {
+		return &desc.LoginResponse{
+			MfaRequired: true,
+			MfaToken:    result.MFAToken,
+		}
+	}
+
+	return &desc.LoginResponse{
+		AccessToken:  result.Tokens.AccessToken,
+		RefreshToken: result.Tokens.RefreshToken,
+	}
+}
+
+// ToVerifyMFAResponseFromService converts token pair to api response.
+func ToVerifyMFAResponseFromService(tokens *model.TokenPair) *desc.VerifyMFAResponse {
+	return &desc.VerifyMFAResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}
+}
+
+// ToRefreshTokenResponseFromService converts token pair to api response.
+func ToRefreshTokenResponseFromService(tokens *model.TokenPair) *desc.RefreshTokenResponse {
+	return &desc.RefreshTokenResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}
+}
+
+// ToImpersonateResponseFromService converts impersonation token to api response.
+func ToImpersonateResponseFromService(impersonation *model.ImpersonationToken) *desc.ImpersonateResponse {
+	return &desc.ImpersonateResponse{
+		AccessToken: impersonation.AccessToken,
+		ExpiresAt:   timestamppb.New(impersonation.ExpiresAt),
+	}
+}
+
+// ToCreateAPIKeyResponseFromService converts created API key to api response.
+func ToCreateAPIKeyResponseFromService(created *model.CreatedAPIKey) *desc.CreateAPIKeyResponse {
+	return &desc.CreateAPIKeyResponse{
+		Key:    created.Key,
+		ApiKey: ToAPIKeyFromService(created.APIKey),
+	}
+}
+
+// ToListAPIKeysResponseFromService converts API keys to api response.
+func ToListAPIKeysResponseFromService(keys []*model.APIKey) *desc.ListAPIKeysResponse {
+	apiKeys := make([]*desc.APIKey, 0, len(keys))
+	for _, key := range keys {
+		apiKeys = append(apiKeys, ToAPIKeyFromService(key))
+	}
+
+	return &desc.ListAPIKeysResponse{ApiKeys: apiKeys}
+}
+
+// ToAPIKeyFromService converts API key model to api message.
+func ToAPIKeyFromService(key *model.APIKey) *desc.APIKey {
+	apiKey := &desc.APIKey{
+		Id:         key.ID.String(),
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     key.Scopes,
+		LastUsedIp: key.LastUsedIP,
+		CreatedAt:  timestamppb.New(key.CreatedAt),
+	}
+
+	if key.ExpiresAt != nil {
+		apiKey.ExpiresAt = timestamppb.New(*key.ExpiresAt)
+	}
+
+	if key.LastUsedAt != nil {
+		apiKey.LastUsedAt = timestamppb.New(*key.LastUsedAt)
+	}
+
+	return apiKey
+}
+
+// ToListSessionsResponseFromService converts sessions to api response.
+func ToListSessionsResponseFromService(sessions []*model.Session) *desc.ListSessionsResponse {
+	result := make([]*desc.Session, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, &desc.Session{
+			Id:         session.ID.String(),
+			Device:     session.Device,
+			UserAgent:  session.UserAgent,
+			Ip:         session.IP,
+			CreatedAt:  timestamppb.New(session.CreatedAt),
+			LastSeenAt: timestamppb.New(session.LastSeenAt),
+			ExpiresAt:  timestamppb.New(session.ExpiresAt),
+		})
+	}
+
+	return &desc.ListSessionsResponse{Sessions: result}
+}
+
+// ToExpiresAtFromCreateAPIKeyRequest converts optional key expiration from api request.
+func ToExpiresAtFromCreateAPIKeyRequest(request *desc.CreateAPIKeyRequest) *time.Time {
+	if request.ExpiresAt == nil {
+		return nil
+	}
+
+	expiresAt := request.ExpiresAt.AsTime()
+
+	return &expiresAt
+}
+
+// ToCreateOAuthClientResponseFromService converts a created OAuth client to api response.
+func ToCreateOAuthClientResponseFromService(created *model.CreatedOAuthClient) *desc.CreateOAuthClientResponse {
+	return &desc.CreateOAuthClientResponse{
+		ClientId:     created.Client.ID,
+		ClientSecret: created.Secret,
+	}
+}
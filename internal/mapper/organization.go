@@ -0,0 +1,12 @@
+package mapper
+
+import "github.com/Paul1k96/microservices_course_auth/internal/model"
+
+// ToOrgIDFromDesc converts organization id of api request to model, empty id means the default organization.
+func ToOrgIDFromDesc(orgID int64) int64 {
+	if orgID == 0 {
+		return model.DefaultOrgID
+	}
+
+	return orgID
+}
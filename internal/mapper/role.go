@@ -0,0 +1,45 @@
+package mapper
+
+import (
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	desc "github.com/Paul1k96/microservices_course_auth/pkg/proto/gen/user_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ToRoleFromCreateRoleRequest converts api request to role model.
+func ToRoleFromCreateRoleRequest(request *desc.CreateRoleRequest) *model.Role {
+	return &model.Role{
+		Name:        request.Name,
+		Description: request.Description,
+		Permissions: request.Permissions,
+	}
+}
+
+// ToRoleFromUpdateRoleRequest converts api request to role model.
+func ToRoleFromUpdateRoleRequest(request *desc.UpdateRoleRequest) *model.Role {
+	return &model.Role{
+		ID:          request.Id,
+		Description: request.Description,
+		Permissions: request.Permissions,
+	}
+}
+
+// ToListRolesResponseFromService converts roles to api response.
+func ToListRolesResponseFromService(roles []*model.Role) *desc.ListRolesResponse {
+	resp := &desc.ListRolesResponse{
+		Roles: make([]*desc.RoleInfo, 0, len(roles)),
+	}
+
+	for _, role := range roles {
+		resp.Roles = append(resp.Roles, &desc.RoleInfo{
+			Id:          role.ID,
+			Name:        role.Name,
+			Description: role.Description,
+			Permissions: role.Permissions,
+			BuiltIn:     role.BuiltIn,
+			CreatedAt:   timestamppb.New(role.CreatedAt),
+		})
+	}
+
+	return resp
+}
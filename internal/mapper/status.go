@@ -0,0 +1,39 @@
+package mapper
+
+import (
+	"errors"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ToInvalidArgumentStatusFromError converts domain error to InvalidArgument status.
+// Field violations of errs.ValidationError found in the chain are attached as
+// errdetails.BadRequest, so clients can show every failed rule at once.
+func ToInvalidArgumentStatusFromError(err error, message string) error {
+	st := status.New(codes.InvalidArgument, message)
+
+	var validationErr *errs.ValidationError
+	if !errors.As(err, &validationErr) {
+		return st.Err()
+	}
+
+	badRequest := &errdetails.BadRequest{
+		FieldViolations: make([]*errdetails.BadRequest_FieldViolation, 0, len(validationErr.Violations)),
+	}
+	for _, violation := range validationErr.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       violation.Field,
+			Description: violation.Description,
+		})
+	}
+
+	detailed, detailsErr := st.WithDetails(badRequest)
+	if detailsErr != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
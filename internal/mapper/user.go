@@ -12,7 +12,8 @@ func ToUserFromCreateRequest(request *desc.CreateRequest) *model.User {
 		Name:     request.Name,
 		Email:    request.Email,
 		Password: request.Password,
-		Role:     model.Role(request.Role),
+		Roles:    []string{ToRoleFromDesc(request.Role)},
+		OrgID:    ToOrgIDFromDesc(request.OrgId),
 	}
 }
 
@@ -27,9 +28,11 @@ func ToCreateResponseFromUserService(userID int64) *desc.CreateResponse {
 func ToGetResponseFromUserService(user *model.User) *desc.GetResponse {
 	resp := &desc.GetResponse{
 		Id:        user.ID,
+		OrgId:     user.OrgID,
 		Name:      user.Name,
 		Email:     user.Email,
-		Role:      desc.Role(user.Role),
+		Role:      ToDescFromRoles(user.Roles),
+		Roles:     user.Roles,
 		CreatedAt: timestamppb.New(user.CreatedAt),
 	}
 
@@ -37,6 +40,10 @@ func ToGetResponseFromUserService(user *model.User) *desc.GetResponse {
 		resp.UpdatedAt = timestamppb.New(*user.UpdatedAt)
 	}
 
+	if user.EmailVerifiedAt != nil {
+		resp.EmailVerifiedAt = timestamppb.New(*user.EmailVerifiedAt)
+	}
+
 	return resp
 }
 
@@ -53,11 +60,75 @@ func ToGetListResponseFromUserService(users []*model.User) *desc.GetListResponse
 	return resp
 }
 
+// ToUserFilterFromSearchRequest converts api request to users search filter.
+func ToUserFilterFromSearchRequest(request *desc.SearchRequest) *model.UserFilter {
+	filter := &model.UserFilter{
+		Role:        request.Role,
+		EmailDomain: request.EmailDomain,
+		SortBy:      ToUserSortFieldFromDesc(request.SortBy),
+		Descending:  request.Descending,
+		Limit:       uint64(request.PageSize), // nolint: gosec
+	}
+
+	if request.CreatedFrom != nil {
+		createdFrom := request.CreatedFrom.AsTime()
+		filter.CreatedFrom = &createdFrom
+	}
+
+	if request.CreatedTo != nil {
+		createdTo := request.CreatedTo.AsTime()
+		filter.CreatedTo = &createdTo
+	}
+
+	if request.UpdatedFrom != nil {
+		updatedFrom := request.UpdatedFrom.AsTime()
+		filter.UpdatedFrom = &updatedFrom
+	}
+
+	if request.UpdatedTo != nil {
+		updatedTo := request.UpdatedTo.AsTime()
+		filter.UpdatedTo = &updatedTo
+	}
+
+	return filter
+}
+
+// ToUserSortFieldFromDesc converts api sort field to the field users are sorted by.
+func ToUserSortFieldFromDesc(field desc.UserSortField) model.UserSortField {
+	switch field {
+	case desc.UserSortField_USER_SORT_FIELD_CREATED_AT:
+		return model.UserSortByCreatedAt
+	case desc.UserSortField_USER_SORT_FIELD_UPDATED_AT:
+		return model.UserSortByUpdatedAt
+	case desc.UserSortField_USER_SORT_FIELD_EMAIL:
+		return model.UserSortByEmail
+	default:
+		return model.UserSortByID
+	}
+}
+
+// ToSearchResponseFromUserService converts a page of users to api response.
+func ToSearchResponseFromUserService(page *model.UsersPage) *desc.SearchResponse {
+	resp := &desc.SearchResponse{
+		Users:         make([]*desc.GetResponse, 0, len(page.Users)),
+		NextPageToken: page.NextPageToken,
+	}
+
+	for _, user := range page.Users {
+		resp.Users = append(resp.Users, ToGetResponseFromUserService(user))
+	}
+
+	return resp
+}
+
 // ToUserFromUpdateRequest converts api request to user model.
 func ToUserFromUpdateRequest(request *desc.UpdateRequest) *model.User {
 	user := &model.User{
-		ID:   request.Id,
-		Role: model.Role(request.Role),
+		ID: request.Id,
+	}
+
+	if request.Role != desc.Role_UNKNOWN {
+		user.Roles = []string{ToRoleFromDesc(request.Role)}
 	}
 
 	if request.Name != nil {
@@ -70,3 +141,21 @@ func ToUserFromUpdateRequest(request *desc.UpdateRequest) *model.User {
 
 	return user
 }
+
+// ToRoleFromDesc converts api role to the name of the built-in role, api role names match them.
+func ToRoleFromDesc(role desc.Role) string {
+	return role.String()
+}
+
+// ToDescFromRoles converts roles to the api role of the most privileged built-in role among them.
+func ToDescFromRoles(roles []string) desc.Role {
+	return desc.Role(desc.Role_value[model.PrimaryRole(roles)])
+}
+
+// ToEnrollTOTPResponseFromService converts TOTP enrollment to api response.
+func ToEnrollTOTPResponseFromService(enrollment *model.TOTPEnrollment) *desc.EnrollTOTPResponse {
+	return &desc.EnrollTOTPResponse{
+		Secret: enrollment.Secret,
+		Uri:    enrollment.URI,
+	}
+}
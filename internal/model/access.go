@@ -0,0 +1,4 @@
+package model
+
+// AccessPolicy represents permissions allowing to call each endpoint address, any of them is enough.
+type AccessPolicy map[string][]string
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKey represents a stored personal access token of a user.
+// Only the public Prefix and the hash of the whole key are kept,
+// Prefix is used to find the key when it is presented.
+type APIKey struct {
+	ID         uuid.UUID
+	UserID     int64
+	Name       string
+	Prefix     string
+	KeyHash    string
+	Scopes     []string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	LastUsedIP string
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+// IsActive reports whether the key is neither revoked nor expired at now.
+func (k *APIKey) IsActive(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+// CreatedAPIKey represents a new API key together with its secret value, which is shown only once.
+type CreatedAPIKey struct {
+	Key    string
+	APIKey *APIKey
+}
@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// AuthorizationRequest represents the parameters of an OpenID Connect authorization request.
+type AuthorizationRequest struct {
+	ClientID            string
+	RedirectURI         string
+	ResponseType        string
+	Scopes              []string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+}
+
+// AuthorizationCode represents a pending authorization of an OAuth client by a user.
+// It can be exchanged for tokens once, by the same client, with the PKCE code verifier.
+type AuthorizationCode struct {
+	ClientID      string
+	UserID        int64
+	RedirectURI   string
+	Scopes        []string
+	Nonce         string
+	CodeChallenge string
+	ExpiresAt     time.Time
+}
+
+// UserInfo represents the user an OpenID Connect access token was issued for
+// and the scopes selecting the claims released about them.
+type UserInfo struct {
+	User   *User
+	Scopes []string
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmailVerificationToken represents a stored single-use email verification token.
+// Email is the address the token was sent to: the token only verifies it while
+// it is still the user's email.
+type EmailVerificationToken struct {
+	ID        uuid.UUID
+	UserID    int64
+	Email     string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
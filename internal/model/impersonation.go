@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// ImpersonationToken represents a short-lived access token issued to an admin acting as the user.
+// It comes without a refresh token and expires at ExpiresAt.
+type ImpersonationToken struct {
+	// ID is the ID of the token, events of the calls made with it refer to it.
+	ID          string
+	AccessToken string
+	ExpiresAt   time.Time
+}
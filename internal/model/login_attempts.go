@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// LoginAttempts represents failed login attempts made for an account or from a client address.
+type LoginAttempts struct {
+	// Failures is the number of failed attempts since the last lockout.
+	Failures int
+	// Lockouts is the number of lockouts in a row, each one lasts twice as long as the previous.
+	Lockouts    int
+	LockedUntil *time.Time
+}
+
+// IsLocked reports whether login attempts are refused at now.
+func (a *LoginAttempts) IsLocked(now time.Time) bool {
+	return a.LockedUntil != nil && now.Before(*a.LockedUntil)
+}
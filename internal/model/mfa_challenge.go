@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// MFAChallenge represents a login which passed the password step and waits for the second factor.
+type MFAChallenge struct {
+	UserID int64
+	// Attempts is the number of codes entered for the challenge, including the one being checked.
+	Attempts  int
+	ExpiresAt time.Time
+}
@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// OAuthClient represents a registered OAuth client, either another service of the platform
+// or a third-party tool signing users in with OpenID Connect.
+// Only the hash of the client secret is stored.
+type OAuthClient struct {
+	ID         string
+	Name       string
+	SecretHash string
+	Scopes     []string
+	// RedirectURIs are the only URIs the authorization endpoint redirects users of the client to.
+	RedirectURIs []string
+	RevokedAt    *time.Time
+	CreatedAt    time.Time
+}
+
+// CreatedOAuthClient represents a new OAuth client together with its secret, which is shown only once.
+type CreatedOAuthClient struct {
+	Secret string
+	Client *OAuthClient
+}
+
+// ClientToken represents an access token issued to an OAuth client.
+// IDToken is set only by the authorization code grant.
+type ClientToken struct {
+	AccessToken string
+	IDToken     string
+	ExpiresIn   time.Duration
+	Scopes      []string
+}
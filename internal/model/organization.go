@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// DefaultOrgID is the ID of the organization seeded by migrations, it owns accounts
+// created and signed in without an explicit organization.
+const DefaultOrgID int64 = 1
+
+// Organization represents a tenant owning user accounts.
+type Organization struct {
+	ID        int64
+	Name      string
+	CreatedAt time.Time
+}
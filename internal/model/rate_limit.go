@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+// DefaultRateLimitEndpoint is the endpoint the limit of gRPC methods without a limit of their own is configured for.
+const DefaultRateLimitEndpoint = "*"
+
+// RateLimit is a token bucket holding up to Requests tokens and refilled with Requests tokens every Period.
+// Every request takes a token, so bursts of up to Requests are allowed.
+type RateLimit struct {
+	Requests int
+	Period   time.Duration
+}
+
+// RateLimitResult represents the outcome of taking a token from a bucket.
+type RateLimitResult struct {
+	Allowed bool
+	// RetryAfter is the time until the next token is added, zero if the request is allowed.
+	RetryAfter time.Duration
+}
+
+// RetryAfterSeconds returns RetryAfter rounded up to whole seconds, as the Retry-After header expects.
+func (r *RateLimitResult) RetryAfterSeconds() int64 {
+	seconds := int64(r.RetryAfter / time.Second)
+	if r.RetryAfter%time.Second != 0 || seconds == 0 {
+		seconds++
+	}
+
+	return seconds
+}
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RefreshToken represents a stored refresh token.
+// Tokens issued one after another by rotation share the same FamilyID.
+// AccessTokenID is the ID of the access token issued in the same pair.
+type RefreshToken struct {
+	ID                   uuid.UUID
+	FamilyID             uuid.UUID
+	UserID               int64
+	TokenHash            string
+	ExpiresAt            time.Time
+	RotatedAt            *time.Time
+	RevokedAt            *time.Time
+	CreatedAt            time.Time
+	AccessTokenID        string
+	AccessTokenExpiresAt time.Time
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"regexp"
+	"slices"
+	"time"
+)
+
+// Built-in roles seeded by migrations. Their names stay stable so callers may rely on them.
+const (
+	RoleUnknown = "UNKNOWN"
+	RoleUser    = "USER"
+	RoleAdmin   = "ADMIN"
+)
+
+// Built-in permissions checked by the service itself. Permissions of other
+// services are referenced only by the access policy.
+const (
+	PermissionRevokeTokens       = "tokens.revoke"
+	PermissionManageSessions     = "sessions.manage"
+	PermissionUnlockUsers        = "users.unlock"
+	PermissionManageOAuthClients = "oauth_clients.manage"
+	PermissionManageRoles        = "roles.manage"
+	PermissionImpersonateUsers   = "users.impersonate"
+	PermissionManageUsers        = "users.manage"
+)
+
+var permissionPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*(\.[a-z][a-z0-9_]*)+$`)
+
+// Role represents a named set of permissions granted to the users holding it.
+type Role struct {
+	ID          int64
+	Name        string
+	Description string
+	Permissions []string
+	// OrgID is the organization the custom role belongs to, built-in roles are shared by all organizations.
+	OrgID int64
+	// BuiltIn roles are seeded by migrations and cannot be deleted.
+	BuiltIn   bool
+	CreatedAt time.Time
+}
+
+// IsBuiltInRole reports whether role is the name of a built-in role.
+func IsBuiltInRole(role string) bool {
+	return slices.Contains([]string{RoleUnknown, RoleUser, RoleAdmin}, role)
+}
+
+// IsValidPermission reports whether permission is a dot separated name like "chat.send_message".
+func IsValidPermission(permission string) bool {
+	return permissionPattern.MatchString(permission)
+}
+
+// PrimaryRole returns the most privileged built-in role among roles,
+// it is reported where a single role is expected.
+func PrimaryRole(roles []string) string {
+	for _, role := range []string{RoleAdmin, RoleUser} {
+		if slices.Contains(roles, role) {
+			return role
+		}
+	}
+
+	return RoleUnknown
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"slices"
+	"strings"
+)
+
+// OpenID Connect scopes, they select the user claims released to the OAuth client.
+const (
+	ScopeOpenID  = "openid"
+	ScopeProfile = "profile"
+	ScopeEmail   = "email"
+)
+
+// IsOIDCScope reports whether scope is one of the supported OpenID Connect scopes.
+func IsOIDCScope(scope string) bool {
+	return slices.Contains([]string{ScopeOpenID, ScopeProfile, ScopeEmail}, scope)
+}
+
+// IsValidScope reports whether scope is a full gRPC method name or a service wildcard.
+func IsValidScope(scope string) bool {
+	parts := strings.Split(scope, "/")
+	if len(parts) != 3 || parts[0] != "" {
+		return false
+	}
+
+	return parts[1] != "" && parts[2] != ""
+}
+
+// MatchScope reports whether scope covers endpoint. A scope is either a full
+// gRPC method name like "/chat_v1.Chat/SendMessage" or a service wildcard like "/chat_v1.Chat/*".
+func MatchScope(scope, endpoint string) bool {
+	if service, ok := strings.CutSuffix(scope, "/*"); ok {
+		return strings.HasPrefix(endpoint, service+"/")
+	}
+
+	return scope == endpoint
+}
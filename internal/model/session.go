@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session represents a login of the user on a device. Its ID is the FamilyID of the refresh tokens
+// issued by the login and the following rotations, LastSeenAt is updated on every rotation.
+type Session struct {
+	ID         uuid.UUID
+	UserID     int64
+	Device     string
+	UserAgent  string
+	IP         string
+	ExpiresAt  time.Time
+	LastSeenAt time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+	// MFA reports whether the session was opened with the second factor, tokens of the session carry it.
+	MFA bool
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"crypto"
+	"time"
+)
+
+// Allowed signing key algorithms.
+const (
+	SigningAlgorithmRS256 = "RS256"
+	SigningAlgorithmEdDSA = "EdDSA"
+)
+
+// SigningKey represents an asymmetric key used to sign access tokens.
+// A key is published for verification from CreatedAt, signs new tokens for
+// the rotation period after ActivatesAt and verifies already issued tokens until ExpiresAt.
+type SigningKey struct {
+	ID          string
+	Algorithm   string
+	PrivateKey  crypto.Signer
+	CreatedAt   time.Time
+	ActivatesAt time.Time
+	ExpiresAt   time.Time
+}
+
+// PublicKey returns public part of the key.
+func (k *SigningKey) PublicKey() crypto.PublicKey {
+	return k.PrivateKey.Public()
+}
@@ -0,0 +1,84 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// TokenPair represents a pair of access and refresh tokens.
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// UserClaims represents user data carried by a token.
+type UserClaims struct {
+	ID     string
+	UserID int64
+	// OrgID is the organization the token acts in, zero for OAuth client tokens.
+	OrgID     int64
+	Roles     []string
+	ExpiresAt time.Time
+	// EmailVerified reports whether the user email was verified when the token was issued.
+	EmailVerified bool
+	// MFA reports whether the session was opened with the second factor.
+	MFA bool
+	// APIKeyID is the ID of the API key the request is authenticated with, empty for tokens.
+	APIKeyID string
+	// ClientID is the ID of the OAuth client the token was issued to, empty for user tokens.
+	ClientID string
+	// Scopes limits the endpoints an API key or OAuth client may call, empty for user tokens.
+	Scopes []string
+	// ActorID is the ID of the admin acting as the user in an impersonated session, zero otherwise.
+	ActorID int64
+}
+
+// Actor returns the ID of the user acting with the token: the admin in an impersonated
+// session, the token owner otherwise. Events record it as the author of the change.
+func (c *UserClaims) Actor() int64 {
+	if c.ActorID != 0 {
+		return c.ActorID
+	}
+
+	return c.UserID
+}
+
+// IsImpersonated reports whether the token was issued to an admin acting as the user.
+func (c *UserClaims) IsImpersonated() bool {
+	return c.ActorID != 0
+}
+
+// HasRole reports whether the token was issued to a holder of the role.
+func (c *UserClaims) HasRole(role string) bool {
+	return slices.Contains(c.Roles, role)
+}
+
+// Allows reports whether the claims allow calling endpoint. User tokens allow every endpoint
+// their roles grant a permission for, API keys and OAuth clients only the ones matching their scopes.
+func (c *UserClaims) Allows(endpoint string) bool {
+	if c.APIKeyID == "" && c.ClientID == "" {
+		return true
+	}
+
+	for _, scope := range c.Scopes {
+		if MatchScope(scope, endpoint) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// LoginResult represents the outcome of the password step of login.
+// Either Tokens are issued or the user has to pass the second factor
+// with MFAToken first.
+type LoginResult struct {
+	Tokens   *TokenPair
+	MFAToken string
+}
+
+// TOTPEnrollment represents a pending TOTP enrollment shown to the user once.
+type TOTPEnrollment struct {
+	Secret string
+	URI    string
+}
@@ -1,28 +1,85 @@
 package model
 
 import (
+	"slices"
 	"time"
 )
 
-//go:generate ../../bin/enumer -transform snake-upper -trimprefix Role -type Role -output user_role_string.go user.go
+// User represents user model.
+type User struct {
+	ID int64
+	// OrgID is the organization owning the account, Email is unique within it.
+	OrgID    int64
+	Name     string
+	Email    string
+	Password string `json:"-"`
+	// Roles are names of the roles the user holds.
+	Roles     []string
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+	// EmailVerifiedAt is nil until the user proves they own Email.
+	EmailVerifiedAt *time.Time
+	// TOTPSecret is the encrypted TOTP secret, it is set on enrollment before being confirmed.
+	TOTPSecret string `json:"-"`
+	// TOTPEnabledAt is nil until the user confirms TOTP enrollment with a valid code.
+	TOTPEnabledAt *time.Time
+	// TOTPCounter is the time step of the last accepted code, codes of it and earlier steps are refused.
+	TOTPCounter int64 `json:"-"`
+}
+
+// IsMFAEnabled reports whether the user has to pass the second factor on login.
+func (u *User) IsMFAEnabled() bool {
+	return u.TOTPEnabledAt != nil
+}
+
+// HasRole reports whether the user holds the role.
+func (u *User) HasRole(role string) bool {
+	return slices.Contains(u.Roles, role)
+}
 
-// User roles.
+// UserSortField is a field users are sorted by in a search.
+type UserSortField int
+
+// Fields users are sorted by in a search, ties are broken by id.
 const (
-	RoleUnknown Role = iota // default value
-	RoleAdmin
-	RoleUser
+	UserSortByID UserSortField = iota
+	UserSortByCreatedAt
+	// UserSortByUpdatedAt sorts users never updated by CreatedAt.
+	UserSortByUpdatedAt
+	UserSortByEmail
 )
 
-// Role represents user role.
-type Role int32
+// UserFilter represents filters, sorting and the page of a users search.
+// Time ranges include the lower bound and exclude the upper one, nil bounds are not checked.
+type UserFilter struct {
+	OrgID       int64
+	Role        string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	UpdatedFrom *time.Time
+	UpdatedTo   *time.Time
+	// EmailDomain matches the part of Email after @, case-insensitive.
+	EmailDomain string
+	SortBy      UserSortField
+	Descending  bool
+	// Limit is the maximum number of users returned.
+	Limit uint64
+	// After is the last user of the previous page, the search continues after them.
+	After *UserCursor
+}
 
-// User represents user model.
-type User struct {
+// UserCursor is the position of a user in a search, it holds the values users are sorted by.
+type UserCursor struct {
 	ID        int64
-	Name      string
-	Email     string
-	Password  string
-	Role      Role
 	CreatedAt time.Time
-	UpdatedAt *time.Time
+	// UpdatedAt is the last update time, or the creation time of users never updated.
+	UpdatedAt time.Time
+	Email     string
+}
+
+// UsersPage represents a page of a users search.
+type UsersPage struct {
+	Users []*User
+	// NextPageToken continues the search, it is empty on the last page.
+	NextPageToken string
 }
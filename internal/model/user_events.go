@@ -12,6 +12,20 @@ const (
 	UserEventTypeCreateUser
 	UserEventTypeUpdateUser
 	UserEventTypeDeleteUser
+	UserEventTypeRefreshTokenReused
+	UserEventTypePasswordChanged
+	UserEventTypeLocked
+	UserEventTypeUnlocked
+	UserEventTypeMFAEnabled
+	UserEventTypeMFADisabled
+	UserEventTypeRoleCreated
+	UserEventTypeRoleUpdated
+	UserEventTypeRoleDeleted
+	UserEventTypeRoleAssigned
+	UserEventTypeRoleUnassigned
+	UserEventTypeImpersonationStarted
+	UserEventTypeImpersonatedCall
+	UserEventTypeOrgMemberAdded
 )
 
 // UserEventType represents user event type.
@@ -50,16 +64,162 @@ func (v DeleteUserEventValue) Value() interface{} {
 	return nil
 }
 
+// RefreshTokenReusedEventValue represents refresh token reused event value.
+type RefreshTokenReusedEventValue struct {
+	FamilyID uuid.UUID `json:"family_id"`
+}
+
+// Value returns value.
+func (v RefreshTokenReusedEventValue) Value() interface{} {
+	return &v
+}
+
+// PasswordChangedEventValue represents password changed event value.
+// It intentionally carries neither the old nor the new password.
+type PasswordChangedEventValue struct{}
+
+// Value returns value.
+func (v PasswordChangedEventValue) Value() interface{} {
+	return nil
+}
+
+// LockedEventValue represents account locked event value.
+type LockedEventValue struct {
+	LockedUntil time.Time `json:"locked_until"`
+	Lockouts    int       `json:"lockouts"`
+	IP          string    `json:"ip"`
+}
+
+// Value returns value.
+func (v LockedEventValue) Value() interface{} {
+	return &v
+}
+
+// UnlockedEventValue represents account unlocked event value.
+type UnlockedEventValue struct{}
+
+// Value returns value.
+func (v UnlockedEventValue) Value() interface{} {
+	return nil
+}
+
+// MFAEnabledEventValue represents two-factor authentication enabled event value.
+type MFAEnabledEventValue struct{}
+
+// Value returns value.
+func (v MFAEnabledEventValue) Value() interface{} {
+	return nil
+}
+
+// MFADisabledEventValue represents two-factor authentication disabled event value.
+type MFADisabledEventValue struct{}
+
+// Value returns value.
+func (v MFADisabledEventValue) Value() interface{} {
+	return nil
+}
+
+// RoleCreatedEventValue represents role created event value.
+type RoleCreatedEventValue struct {
+	Role *Role
+}
+
+// Value returns value.
+func (v RoleCreatedEventValue) Value() interface{} {
+	return &v.Role
+}
+
+// RoleUpdatedEventValue represents role updated event value.
+type RoleUpdatedEventValue struct {
+	Role *Role
+}
+
+// Value returns value.
+func (v RoleUpdatedEventValue) Value() interface{} {
+	return &v.Role
+}
+
+// RoleDeletedEventValue represents role deleted event value.
+type RoleDeletedEventValue struct {
+	Name string `json:"name"`
+}
+
+// Value returns value.
+func (v RoleDeletedEventValue) Value() interface{} {
+	return &v
+}
+
+// RoleAssignedEventValue represents role assigned to the user event value.
+type RoleAssignedEventValue struct {
+	RoleID int64  `json:"role_id"`
+	Name   string `json:"name"`
+}
+
+// Value returns value.
+func (v RoleAssignedEventValue) Value() interface{} {
+	return &v
+}
+
+// RoleUnassignedEventValue represents role unassigned from the user event value.
+type RoleUnassignedEventValue struct {
+	RoleID int64  `json:"role_id"`
+	Name   string `json:"name"`
+}
+
+// Value returns value.
+func (v RoleUnassignedEventValue) Value() interface{} {
+	return &v
+}
+
+// ImpersonationStartedEventValue represents impersonation token issued to the admin event value.
+type ImpersonationStartedEventValue struct {
+	TokenID   string    `json:"token_id"`
+	Reason    string    `json:"reason"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Value returns value.
+func (v ImpersonationStartedEventValue) Value() interface{} {
+	return &v
+}
+
+// ImpersonatedCallEventValue represents call made with an impersonation token event value.
+type ImpersonatedCallEventValue struct {
+	TokenID  string `json:"token_id"`
+	Endpoint string `json:"endpoint"`
+}
+
+// Value returns value.
+func (v ImpersonatedCallEventValue) Value() interface{} {
+	return &v
+}
+
+// OrgMemberAddedEventValue represents user added to the organization event value.
+type OrgMemberAddedEventValue struct{}
+
+// Value returns value.
+func (v OrgMemberAddedEventValue) Value() interface{} {
+	return nil
+}
+
 // UserEvent represents user event model.
 type UserEvent struct {
-	ID        uuid.UUID
-	UserID    int64
+	ID     uuid.UUID
+	UserID int64
+	// OrgID is the organization the event happened in, zero for events not bound to one.
+	OrgID     int64
 	Type      UserEventType
 	EntityID  int64
 	Value     UserEventValue
 	CreatedAt time.Time
 }
 
+// WithOrgID sets the organization the event happened in.
+func (e *UserEvent) WithOrgID(orgID int64) *UserEvent {
+	e.OrgID = orgID
+	return e
+}
+
 // NewUserEvent creates a new user event.
 func NewUserEvent(userID, entityID int64, eventType UserEventType, value UserEventValue) *UserEvent {
 	return &UserEvent{
@@ -77,17 +237,117 @@ func NewUnspecifiedUserEvent(userID, entityID int64) *UserEvent {
 	return NewUserEvent(userID, entityID, UserEventTypeUnspecified, nil)
 }
 
-// NewCreateUserEvent creates a new create user event.
-func NewCreateUserEvent(userID int64, user *User) *UserEvent {
-	return NewUserEvent(userID, user.ID, UserEventTypeCreateUser, &CreateUserEventValue{User: user})
+// NewCreateUserEvent creates a new event about the user created by the actor.
+func NewCreateUserEvent(actorID int64, user *User) *UserEvent {
+	return NewUserEvent(actorID, user.ID, UserEventTypeCreateUser, &CreateUserEventValue{User: user})
+}
+
+// NewUpdateUserEvent creates a new event about the user updated by the actor.
+func NewUpdateUserEvent(actorID int64, user *User) *UserEvent {
+	return NewUserEvent(actorID, user.ID, UserEventTypeUpdateUser, &UpdateUserEventValue{User: user})
+}
+
+// NewDeleteUserEvent creates a new event about the user deleted by the actor.
+func NewDeleteUserEvent(actorID, userID int64) *UserEvent {
+	return NewUserEvent(actorID, userID, UserEventTypeDeleteUser, &DeleteUserEventValue{})
+}
+
+// NewRefreshTokenReusedEvent creates a new security event about reuse of an already rotated refresh token.
+func NewRefreshTokenReusedEvent(userID int64, familyID uuid.UUID) *UserEvent {
+	return NewUserEvent(
+		userID,
+		userID,
+		UserEventTypeRefreshTokenReused,
+		&RefreshTokenReusedEventValue{FamilyID: familyID},
+	)
+}
+
+// NewPasswordChangedEvent creates a new password changed event.
+func NewPasswordChangedEvent(userID int64) *UserEvent {
+	return NewUserEvent(userID, userID, UserEventTypePasswordChanged, &PasswordChangedEventValue{})
+}
+
+// NewLockedEvent creates a new event about the account locked after too many failed login attempts.
+func NewLockedEvent(userID int64, lockedUntil time.Time, lockouts int, ip string) *UserEvent {
+	return NewUserEvent(
+		userID,
+		userID,
+		UserEventTypeLocked,
+		&LockedEventValue{LockedUntil: lockedUntil, Lockouts: lockouts, IP: ip},
+	)
+}
+
+// NewUnlockedEvent creates a new event about the account lockout cleared by the actor.
+func NewUnlockedEvent(actorID, userID int64) *UserEvent {
+	return NewUserEvent(actorID, userID, UserEventTypeUnlocked, &UnlockedEventValue{})
+}
+
+// NewMFAEnabledEvent creates a new two-factor authentication enabled event.
+func NewMFAEnabledEvent(userID int64) *UserEvent {
+	return NewUserEvent(userID, userID, UserEventTypeMFAEnabled, &MFAEnabledEventValue{})
+}
+
+// NewMFADisabledEvent creates a new two-factor authentication disabled event.
+func NewMFADisabledEvent(userID int64) *UserEvent {
+	return NewUserEvent(userID, userID, UserEventTypeMFADisabled, &MFADisabledEventValue{})
+}
+
+// NewRoleCreatedEvent creates a new event about the role created by the actor.
+func NewRoleCreatedEvent(actorID int64, role *Role) *UserEvent {
+	return NewUserEvent(actorID, role.ID, UserEventTypeRoleCreated, &RoleCreatedEventValue{Role: role})
+}
+
+// NewRoleUpdatedEvent creates a new event about the role updated by the actor.
+func NewRoleUpdatedEvent(actorID int64, role *Role) *UserEvent {
+	return NewUserEvent(actorID, role.ID, UserEventTypeRoleUpdated, &RoleUpdatedEventValue{Role: role})
+}
+
+// NewRoleDeletedEvent creates a new event about the role deleted by the actor.
+func NewRoleDeletedEvent(actorID int64, role *Role) *UserEvent {
+	return NewUserEvent(actorID, role.ID, UserEventTypeRoleDeleted, &RoleDeletedEventValue{Name: role.Name})
+}
+
+// NewRoleAssignedEvent creates a new event about the role assigned to the user by the actor.
+func NewRoleAssignedEvent(actorID, userID int64, role *Role) *UserEvent {
+	return NewUserEvent(
+		actorID,
+		userID,
+		UserEventTypeRoleAssigned,
+		&RoleAssignedEventValue{RoleID: role.ID, Name: role.Name},
+	)
+}
+
+// NewRoleUnassignedEvent creates a new event about the role unassigned from the user by the actor.
+func NewRoleUnassignedEvent(actorID, userID int64, role *Role) *UserEvent {
+	return NewUserEvent(
+		actorID,
+		userID,
+		UserEventTypeRoleUnassigned,
+		&RoleUnassignedEventValue{RoleID: role.ID, Name: role.Name},
+	)
+}
+
+// NewImpersonationStartedEvent creates a new event about the admin starting to act as the user.
+func NewImpersonationStartedEvent(actorID, userID int64, tokenID, reason string, expiresAt time.Time) *UserEvent {
+	return NewUserEvent(
+		actorID,
+		userID,
+		UserEventTypeImpersonationStarted,
+		&ImpersonationStartedEventValue{TokenID: tokenID, Reason: reason, ExpiresAt: expiresAt},
+	)
 }
 
-// NewUpdateUserEvent creates a new update user event.
-func NewUpdateUserEvent(userID int64, user *User) *UserEvent {
-	return NewUserEvent(userID, user.ID, UserEventTypeUpdateUser, &UpdateUserEventValue{User: user})
+// NewImpersonatedCallEvent creates a new event about the admin calling endpoint as the user.
+func NewImpersonatedCallEvent(actorID, userID int64, tokenID, endpoint string) *UserEvent {
+	return NewUserEvent(
+		actorID,
+		userID,
+		UserEventTypeImpersonatedCall,
+		&ImpersonatedCallEventValue{TokenID: tokenID, Endpoint: endpoint},
+	)
 }
 
-// NewDeleteUserEvent creates a new delete user event.
-func NewDeleteUserEvent(userID, entityID int64) *UserEvent {
-	return NewUserEvent(userID, entityID, UserEventTypeDeleteUser, &DeleteUserEventValue{})
+// NewOrgMemberAddedEvent creates a new event about the user added to an organization by the actor.
+func NewOrgMemberAddedEvent(actorID, userID int64) *UserEvent {
+	return NewUserEvent(actorID, userID, UserEventTypeOrgMemberAdded, &OrgMemberAddedEventValue{})
 }
@@ -0,0 +1,79 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// fileNotifier is meant for local development: instead of delivering
+// notifications it appends them to a file as JSON lines, or writes them to
+// the log when no file is configured.
+type fileNotifier struct {
+	logger *slog.Logger
+	from   string
+	path   string
+
+	mu sync.Mutex
+}
+
+func newFileNotifier(logger *slog.Logger, from, path string) *fileNotifier {
+	return &fileNotifier{
+		logger: logger,
+		from:   from,
+		path:   path,
+	}
+}
+
+type fileMessage struct {
+	From    string    `json:"from"`
+	To      string    `json:"to"`
+	Subject string    `json:"subject"`
+	Body    string    `json:"body"`
+	SentAt  time.Time `json:"sent_at"`
+}
+
+// Send appends msg to the file or logs it.
+func (n *fileNotifier) Send(ctx context.Context, msg *Message) error {
+	if len(n.path) == 0 {
+		n.logger.InfoContext(ctx, "notification",
+			slog.String("from", n.from),
+			slog.String("to", msg.To),
+			slog.String("subject", msg.Subject),
+			slog.String("body", msg.Body),
+		)
+
+		return nil
+	}
+
+	raw, err := json.Marshal(&fileMessage{
+		From:    n.from,
+		To:      msg.To,
+		Subject: msg.Subject,
+		Body:    msg.Body,
+		SentAt:  time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal message: %w", err)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	file, err := os.OpenFile(n.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("open file: %w", err)
+	}
+	defer file.Close() // nolint: errcheck
+
+	_, err = file.Write(append(raw, '\n'))
+	if err != nil {
+		return fmt.Errorf("write message: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package notifier
+
+import (
+	"fmt"
+	"net/url"
+)
+
+const tokenQueryParam = "token"
+
+// LinkWithToken returns baseURL with token added as the "token" query parameter.
+func LinkWithToken(baseURL, token string) (string, error) {
+	link, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("parse url: %w", err)
+	}
+
+	query := link.Query()
+	query.Set(tokenQueryParam, token)
+	link.RawQuery = query.Encode()
+
+	return link.String(), nil
+}
@@ -0,0 +1,46 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/pkg/errors"
+)
+
+//go:generate ../../bin/mockgen -source $GOFILE -destination "mocks/notifier.go" -package "mocks"
+
+// Supported notifier kinds.
+const (
+	KindSMTP = "smtp"
+	KindFile = "file"
+)
+
+// Message represents a notification addressed to a user.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Notifier delivers notifications to users.
+type Notifier interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// NewNotifier creates a new Notifier of the kind from config.
+func NewNotifier(logger *slog.Logger, cfg config.NotifierConfig) (Notifier, error) {
+	switch cfg.GetKind() {
+	case KindSMTP:
+		if len(cfg.GetSMTPHost()) == 0 {
+			return nil, errors.New("smtp host not found")
+		}
+
+		return newSMTPNotifier(cfg), nil
+	case KindFile:
+		return newFileNotifier(logger, cfg.GetFrom(), cfg.GetFilePath()), nil
+	default:
+		return nil, fmt.Errorf("unsupported notifier kind: %s", cfg.GetKind())
+	}
+}
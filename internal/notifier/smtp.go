@@ -0,0 +1,48 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+	"strings"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+)
+
+type smtpNotifier struct {
+	address string
+	from    string
+	auth    smtp.Auth
+}
+
+func newSMTPNotifier(cfg config.NotifierConfig) *smtpNotifier {
+	var auth smtp.Auth
+	if len(cfg.GetSMTPUsername()) != 0 {
+		auth = smtp.PlainAuth("", cfg.GetSMTPUsername(), cfg.GetSMTPPassword(), cfg.GetSMTPHost())
+	}
+
+	return &smtpNotifier{
+		address: cfg.GetSMTPAddress(),
+		from:    cfg.GetFrom(),
+		auth:    auth,
+	}
+}
+
+// Send sends msg as a plain text email.
+func (n *smtpNotifier) Send(_ context.Context, msg *Message) error {
+	var body strings.Builder
+	body.WriteString("From: " + n.from + "\r\n")
+	body.WriteString("To: " + msg.To + "\r\n")
+	body.WriteString("Subject: " + msg.Subject + "\r\n")
+	body.WriteString("MIME-Version: 1.0\r\n")
+	body.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	body.WriteString("\r\n")
+	body.WriteString(msg.Body)
+
+	err := smtp.SendMail(n.address, n.auth, n.from, []string{msg.To}, []byte(body.String()))
+	if err != nil {
+		return fmt.Errorf("send mail: %w", err)
+	}
+
+	return nil
+}
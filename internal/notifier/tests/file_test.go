@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/notifier"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+)
+
+type notifierConfig struct {
+	kind     string
+	from     string
+	filePath string
+}
+
+func (c *notifierConfig) GetKind() string         { return c.kind }
+func (c *notifierConfig) GetFrom() string         { return c.from }
+func (c *notifierConfig) GetFilePath() string     { return c.filePath }
+func (c *notifierConfig) GetSMTPHost() string     { return "" }
+func (c *notifierConfig) GetSMTPAddress() string  { return "" }
+func (c *notifierConfig) GetSMTPUsername() string { return "" }
+func (c *notifierConfig) GetSMTPPassword() string { return "" }
+
+func TestFileNotifier_AppendsMessages(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notifications.jsonl")
+	cfg := &notifierConfig{kind: notifier.KindFile, from: gofakeit.Email(), filePath: path}
+
+	n, err := notifier.NewNotifier(slog.Default(), cfg)
+	require.NoError(t, err)
+
+	messages := []*notifier.Message{
+		{To: gofakeit.Email(), Subject: gofakeit.Sentence(3), Body: gofakeit.Sentence(10)},
+		{To: gofakeit.Email(), Subject: gofakeit.Sentence(3), Body: gofakeit.Sentence(10)},
+	}
+	for _, msg := range messages {
+		require.NoError(t, n.Send(context.Background(), msg))
+	}
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(raw)), "\n")
+	require.Len(t, lines, len(messages))
+
+	for i, line := range lines {
+		var got struct {
+			From    string `json:"from"`
+			To      string `json:"to"`
+			Subject string `json:"subject"`
+			Body    string `json:"body"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(line), &got))
+		require.Equal(t, cfg.from, got.From)
+		require.Equal(t, messages[i].To, got.To)
+		require.Equal(t, messages[i].Subject, got.Subject)
+		require.Equal(t, messages[i].Body, got.Body)
+	}
+}
+
+func TestNewNotifier_SMTPRequiresHost(t *testing.T) {
+	_, err := notifier.NewNotifier(slog.Default(), &notifierConfig{kind: notifier.KindSMTP, from: gofakeit.Email()})
+	require.Error(t, err)
+}
+
+func TestNewNotifier_UnknownKind(t *testing.T) {
+	_, err := notifier.NewNotifier(slog.Default(), &notifierConfig{kind: gofakeit.Word(), from: gofakeit.Email()})
+	require.Error(t, err)
+}
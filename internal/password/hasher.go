@@ -0,0 +1,84 @@
+package password
+
+import (
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+)
+
+//go:generate ../../bin/mockgen -source $GOFILE -destination "mocks/hasher.go" -package "mocks"
+
+// Supported hash algorithms.
+const (
+	AlgorithmArgon2id = "argon2id"
+	AlgorithmBcrypt   = "bcrypt"
+)
+
+// Hasher hashes and verifies passwords.
+type Hasher interface {
+	Hash(password string) (string, error)
+	Verify(encoded, password string) (bool, error)
+	NeedsRehash(encoded string) bool
+}
+
+// NewHasher creates a new Hasher for the algorithm from config.
+// Hashes produced by any other supported algorithm, as well as legacy
+// plaintext values, are still accepted by Verify and reported by NeedsRehash.
+func NewHasher(cfg config.PasswordConfig) (Hasher, error) {
+	argon2id := newArgon2idHasher(cfg.GetArgon2Time(), cfg.GetArgon2Memory(), cfg.GetArgon2Threads())
+	bcrypt := newBcryptHasher(cfg.GetBcryptCost())
+
+	var primary algorithmHasher
+	switch cfg.GetHashAlgorithm() {
+	case AlgorithmArgon2id:
+		primary = argon2id
+	case AlgorithmBcrypt:
+		primary = bcrypt
+	default:
+		return nil, fmt.Errorf("unsupported password hash algorithm: %s", cfg.GetHashAlgorithm())
+	}
+
+	return &hasher{
+		primary:    primary,
+		algorithms: []algorithmHasher{argon2id, bcrypt},
+	}, nil
+}
+
+type algorithmHasher interface {
+	Hasher
+	Match(encoded string) bool
+}
+
+type hasher struct {
+	primary    algorithmHasher
+	algorithms []algorithmHasher
+}
+
+// Hash hashes password with the configured algorithm.
+func (h *hasher) Hash(password string) (string, error) {
+	return h.primary.Hash(password)
+}
+
+// Verify checks password against the encoded hash.
+// Values not produced by any supported algorithm are legacy plaintext
+// passwords stored before hashing was introduced.
+func (h *hasher) Verify(encoded, password string) (bool, error) {
+	for _, algorithm := range h.algorithms {
+		if algorithm.Match(encoded) {
+			return algorithm.Verify(encoded, password)
+		}
+	}
+
+	return subtle.ConstantTimeCompare([]byte(encoded), []byte(password)) == 1, nil
+}
+
+// NeedsRehash reports whether the encoded hash is a legacy plaintext value or
+// was produced with an algorithm or parameters other than the configured ones.
+func (h *hasher) NeedsRehash(encoded string) bool {
+	if !h.primary.Match(encoded) {
+		return true
+	}
+
+	return h.primary.NeedsRehash(encoded)
+}
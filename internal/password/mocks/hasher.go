@@ -0,0 +1,166 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: hasher.go
+//
+// Generated by this command:
+//
+//	mockgen -source hasher.go -destination mocks/hasher.go -package mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHasher is a mock of Hasher interface.
+type MockHasher struct {
+	ctrl     *gomock.Controller
+	recorder *MockHasherMockRecorder
+	isgomock struct{}
+}
+
+// MockHasherMockRecorder is the mock recorder for MockHasher.
+type MockHasherMockRecorder struct {
+	mock *MockHasher
+}
+
+// NewMockHasher creates a new mock instance.
+func NewMockHasher(ctrl *gomock.Controller) *MockHasher {
+	mock := &MockHasher{ctrl: ctrl}
+	mock.recorder = &MockHasherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHasher) EXPECT() *MockHasherMockRecorder {
+	return m.recorder
+}
+
+// Hash mocks base method.
+func (m *MockHasher) Hash(password string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hash", password)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Hash indicates an expected call of Hash.
+func (mr *MockHasherMockRecorder) Hash(password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hash", reflect.TypeOf((*MockHasher)(nil).Hash), password)
+}
+
+// NeedsRehash mocks base method.
+func (m *MockHasher) NeedsRehash(encoded string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NeedsRehash", encoded)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// NeedsRehash indicates an expected call of NeedsRehash.
+func (mr *MockHasherMockRecorder) NeedsRehash(encoded any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NeedsRehash", reflect.TypeOf((*MockHasher)(nil).NeedsRehash), encoded)
+}
+
+// Verify mocks base method.
+func (m *MockHasher) Verify(encoded, password string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", encoded, password)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockHasherMockRecorder) Verify(encoded, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockHasher)(nil).Verify), encoded, password)
+}
+
+// MockalgorithmHasher is a mock of algorithmHasher interface.
+type MockalgorithmHasher struct {
+	ctrl     *gomock.Controller
+	recorder *MockalgorithmHasherMockRecorder
+	isgomock struct{}
+}
+
+// MockalgorithmHasherMockRecorder is the mock recorder for MockalgorithmHasher.
+type MockalgorithmHasherMockRecorder struct {
+	mock *MockalgorithmHasher
+}
+
+// NewMockalgorithmHasher creates a new mock instance.
+func NewMockalgorithmHasher(ctrl *gomock.Controller) *MockalgorithmHasher {
+	mock := &MockalgorithmHasher{ctrl: ctrl}
+	mock.recorder = &MockalgorithmHasherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockalgorithmHasher) EXPECT() *MockalgorithmHasherMockRecorder {
+	return m.recorder
+}
+
+// Hash mocks base method.
+func (m *MockalgorithmHasher) Hash(password string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hash", password)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Hash indicates an expected call of Hash.
+func (mr *MockalgorithmHasherMockRecorder) Hash(password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hash", reflect.TypeOf((*MockalgorithmHasher)(nil).Hash), password)
+}
+
+// Match mocks base method.
+func (m *MockalgorithmHasher) Match(encoded string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Match", encoded)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Match indicates an expected call of Match.
+func (mr *MockalgorithmHasherMockRecorder) Match(encoded any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Match", reflect.TypeOf((*MockalgorithmHasher)(nil).Match), encoded)
+}
+
+// NeedsRehash mocks base method.
+func (m *MockalgorithmHasher) NeedsRehash(encoded string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NeedsRehash", encoded)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// NeedsRehash indicates an expected call of NeedsRehash.
+func (mr *MockalgorithmHasherMockRecorder) NeedsRehash(encoded any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NeedsRehash", reflect.TypeOf((*MockalgorithmHasher)(nil).NeedsRehash), encoded)
+}
+
+// Verify mocks base method.
+func (m *MockalgorithmHasher) Verify(encoded, password string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", encoded, password)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockalgorithmHasherMockRecorder) Verify(encoded, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockalgorithmHasher)(nil).Verify), encoded, password)
+}
@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/password"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+)
+
+type passwordConfig struct {
+	algorithm string
+	time      uint32
+	memory    uint32
+	threads   uint8
+	cost      int
+}
+
+func (c passwordConfig) GetHashAlgorithm() string { return c.algorithm }
+func (c passwordConfig) GetArgon2Time() uint32    { return c.time }
+func (c passwordConfig) GetArgon2Memory() uint32  { return c.memory }
+func (c passwordConfig) GetArgon2Threads() uint8  { return c.threads }
+func (c passwordConfig) GetBcryptCost() int       { return c.cost }
+
+func newConfig(algorithm string) passwordConfig {
+	return passwordConfig{algorithm: algorithm, time: 1, memory: 1024, threads: 1, cost: 4}
+}
+
+func TestHasher_Argon2id(t *testing.T) {
+	hasher, err := password.NewHasher(newConfig(password.AlgorithmArgon2id))
+	require.NoError(t, err)
+
+	pass := gofakeit.Password(true, true, true, true, false, 12)
+
+	hash, err := hasher.Hash(pass)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"))
+	require.False(t, hasher.NeedsRehash(hash))
+
+	ok, err := hasher.Verify(hash, pass)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = hasher.Verify(hash, pass+"x")
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestHasher_Bcrypt(t *testing.T) {
+	hasher, err := password.NewHasher(newConfig(password.AlgorithmBcrypt))
+	require.NoError(t, err)
+
+	pass := gofakeit.Password(true, true, true, true, false, 12)
+
+	hash, err := hasher.Hash(pass)
+	require.NoError(t, err)
+	require.False(t, hasher.NeedsRehash(hash))
+
+	ok, err := hasher.Verify(hash, pass)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestHasher_NeedsRehash(t *testing.T) {
+	argon2idHasher, err := password.NewHasher(newConfig(password.AlgorithmArgon2id))
+	require.NoError(t, err)
+
+	bcryptHasher, err := password.NewHasher(newConfig(password.AlgorithmBcrypt))
+	require.NoError(t, err)
+
+	pass := gofakeit.Password(true, true, true, true, false, 12)
+
+	bcryptHash, err := bcryptHasher.Hash(pass)
+	require.NoError(t, err)
+
+	ok, err := argon2idHasher.Verify(bcryptHash, pass)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, argon2idHasher.NeedsRehash(bcryptHash))
+
+	cfg := newConfig(password.AlgorithmArgon2id)
+	cfg.time = 2
+	strongerHasher, err := password.NewHasher(cfg)
+	require.NoError(t, err)
+
+	argon2idHash, err := argon2idHasher.Hash(pass)
+	require.NoError(t, err)
+	require.True(t, strongerHasher.NeedsRehash(argon2idHash))
+}
+
+func TestHasher_LegacyPlaintext(t *testing.T) {
+	hasher, err := password.NewHasher(newConfig(password.AlgorithmArgon2id))
+	require.NoError(t, err)
+
+	pass := gofakeit.Password(true, true, true, true, false, 12)
+
+	ok, err := hasher.Verify(pass, pass)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, hasher.NeedsRehash(pass))
+
+	ok, err = hasher.Verify(pass, pass+"x")
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestHasher_UnsupportedAlgorithm(t *testing.T) {
+	_, err := password.NewHasher(newConfig("md5"))
+	require.Error(t, err)
+}
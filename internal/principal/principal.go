@@ -0,0 +1,51 @@
+package principal
+
+import (
+	"context"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+type contextKey struct{}
+
+type principal struct {
+	accessToken string
+	claims      *model.UserClaims
+}
+
+// NewContext returns a copy of ctx carrying the caller authenticated with accessToken.
+func NewContext(ctx context.Context, accessToken string, claims *model.UserClaims) context.Context {
+	return context.WithValue(ctx, contextKey{}, &principal{accessToken: accessToken, claims: claims})
+}
+
+// FromContext returns claims of the caller authenticated for the request, if any.
+func FromContext(ctx context.Context) (*model.UserClaims, bool) {
+	p, ok := ctx.Value(contextKey{}).(*principal)
+	if !ok {
+		return nil, false
+	}
+
+	return p.claims, true
+}
+
+// ClaimsOf returns claims of the caller if they were authenticated with accessToken,
+// so the token already verified for the request is not verified again.
+func ClaimsOf(ctx context.Context, accessToken string) (*model.UserClaims, bool) {
+	p, ok := ctx.Value(contextKey{}).(*principal)
+	if !ok || p.accessToken != accessToken {
+		return nil, false
+	}
+
+	return p.claims, true
+}
+
+// ActorID returns id of the user acting in the request: the admin behind an impersonation token,
+// otherwise the authenticated caller. Anonymous requests act on behalf of userID.
+func ActorID(ctx context.Context, userID int64) int64 {
+	claims, ok := FromContext(ctx)
+	if !ok {
+		return userID
+	}
+
+	return claims.Actor()
+}
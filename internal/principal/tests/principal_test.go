@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/principal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActorID(t *testing.T) {
+	const userID int64 = 7
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want int64
+	}{
+		{
+			name: "anonymous request",
+			ctx:  context.Background(),
+			want: userID,
+		},
+		{
+			name: "authenticated user",
+			ctx:  principal.NewContext(context.Background(), "token", &model.UserClaims{UserID: 3}),
+			want: 3,
+		},
+		{
+			name: "impersonated session",
+			ctx:  principal.NewContext(context.Background(), "token", &model.UserClaims{UserID: userID, ActorID: 5}),
+			want: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, principal.ActorID(tt.ctx, userID))
+		})
+	}
+}
+
+func TestClaimsOf(t *testing.T) {
+	claims := &model.UserClaims{UserID: 3}
+	ctx := principal.NewContext(context.Background(), "token", claims)
+
+	got, ok := principal.ClaimsOf(ctx, "token")
+	require.True(t, ok)
+	require.Same(t, claims, got)
+
+	_, ok = principal.ClaimsOf(ctx, "other")
+	require.False(t, ok)
+
+	_, ok = principal.ClaimsOf(context.Background(), "token")
+	require.False(t, ok)
+}
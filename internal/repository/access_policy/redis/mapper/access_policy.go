@@ -0,0 +1,38 @@
+package mapper
+
+import (
+	"strings"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+const permissionsSeparator = ","
+
+// ToRepoFromAccessPolicy converts access policy to hash fields of endpoint address and comma separated permissions.
+func ToRepoFromAccessPolicy(policy model.AccessPolicy) map[string]string {
+	rules := make(map[string]string, len(policy))
+
+	for endpoint, permissions := range policy {
+		rules[endpoint] = strings.Join(permissions, permissionsSeparator)
+	}
+
+	return rules
+}
+
+// ToAccessPolicyFromRepo converts hash fields to access policy.
+func ToAccessPolicyFromRepo(rules map[string]string) model.AccessPolicy {
+	policy := make(model.AccessPolicy, len(rules))
+
+	for endpoint, rawPermissions := range rules {
+		permissions := make([]string, 0)
+		for _, name := range strings.Split(rawPermissions, permissionsSeparator) {
+			if name != "" {
+				permissions = append(permissions, name)
+			}
+		}
+
+		policy[endpoint] = permissions
+	}
+
+	return policy
+}
@@ -0,0 +1,60 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/access_policy/redis/mapper"
+	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/cache"
+	redigo "github.com/gomodule/redigo/redis"
+)
+
+const accessPolicyKey = "access_policy:permissions"
+
+// Repository represents access policy cache repository.
+type Repository struct {
+	redisCache cache.RedisClient
+	ttl        time.Duration
+}
+
+// NewRepository creates a new instance of repository.AccessPolicyCache.
+func NewRepository(redisCache cache.RedisClient, ttl time.Duration) *Repository {
+	return &Repository{redisCache: redisCache, ttl: ttl}
+}
+
+// Set access policy.
+func (r *Repository) Set(ctx context.Context, policy model.AccessPolicy) error {
+	err := r.redisCache.HSet(ctx, accessPolicyKey, mapper.ToRepoFromAccessPolicy(policy))
+	if err != nil {
+		return fmt.Errorf("set access policy: %w", err)
+	}
+
+	err = r.redisCache.Expire(ctx, accessPolicyKey, r.ttl)
+	if err != nil {
+		return fmt.Errorf("set ttl: %w", err)
+	}
+
+	return nil
+}
+
+// Get access policy.
+func (r *Repository) Get(ctx context.Context) (model.AccessPolicy, error) {
+	values, err := r.redisCache.HGetAll(ctx, accessPolicyKey)
+	if err != nil {
+		return nil, fmt.Errorf("get access policy: %w", err)
+	}
+
+	if len(values) == 0 {
+		return nil, errs.ErrAccessPolicyNotFound
+	}
+
+	rules, err := redigo.StringMap(values, nil)
+	if err != nil {
+		return nil, fmt.Errorf("scan access policy: %w", err)
+	}
+
+	return mapper.ToAccessPolicyFromRepo(rules), nil
+}
@@ -0,0 +1,49 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/cache"
+)
+
+const keyPrefix = "access_token_denylist:"
+
+// Repository represents revoked access tokens repository.
+type Repository struct {
+	redisCache cache.RedisClient
+}
+
+// NewRepository creates a new instance of repository.AccessTokenDenylist.
+func NewRepository(redisCache cache.RedisClient) *Repository {
+	return &Repository{redisCache: redisCache}
+}
+
+// Add puts token ID into denylist for ttl, which should match the remaining token lifetime.
+func (r *Repository) Add(ctx context.Context, tokenID string, ttl time.Duration) error {
+	// EXPIRE works with whole seconds, round up so the token does not outlive its denylist entry
+	ttl = ttl.Truncate(time.Second) + time.Second
+
+	err := r.redisCache.Set(ctx, keyPrefix+tokenID, 1)
+	if err != nil {
+		return fmt.Errorf("add token to denylist: %w", err)
+	}
+
+	err = r.redisCache.Expire(ctx, keyPrefix+tokenID, ttl)
+	if err != nil {
+		return fmt.Errorf("set ttl: %w", err)
+	}
+
+	return nil
+}
+
+// Contains checks whether token ID is in denylist.
+func (r *Repository) Contains(ctx context.Context, tokenID string) (bool, error) {
+	value, err := r.redisCache.Get(ctx, keyPrefix+tokenID)
+	if err != nil {
+		return false, fmt.Errorf("get token from denylist: %w", err)
+	}
+
+	return value != nil, nil
+}
@@ -0,0 +1,41 @@
+package mapper
+
+import (
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	modelRepo "github.com/Paul1k96/microservices_course_auth/internal/repository/api_key/pg/model"
+)
+
+// ToAPIKeyFromRepo converts API key from repository model to service model.
+func ToAPIKeyFromRepo(key *modelRepo.APIKey) *model.APIKey {
+	var serviceKey model.APIKey
+
+	serviceKey.ID = key.ID
+	serviceKey.UserID = key.UserID
+	serviceKey.Name = key.Name
+	serviceKey.Prefix = key.Prefix
+	serviceKey.KeyHash = key.KeyHash
+	serviceKey.Scopes = key.Scopes
+	serviceKey.CreatedAt = key.CreatedAt
+	if key.ExpiresAt.Valid {
+		serviceKey.ExpiresAt = &key.ExpiresAt.Time
+	}
+	if key.LastUsedAt.Valid {
+		serviceKey.LastUsedAt = &key.LastUsedAt.Time
+	}
+	serviceKey.LastUsedIP = key.LastUsedIP.String
+	if key.RevokedAt.Valid {
+		serviceKey.RevokedAt = &key.RevokedAt.Time
+	}
+
+	return &serviceKey
+}
+
+// ToAPIKeysFromRepo converts API keys from repository model to service model.
+func ToAPIKeysFromRepo(keys []*modelRepo.APIKey) []*model.APIKey {
+	res := make([]*model.APIKey, 0, len(keys))
+	for _, key := range keys {
+		res = append(res, ToAPIKeyFromRepo(key))
+	}
+
+	return res
+}
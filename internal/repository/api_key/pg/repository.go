@@ -0,0 +1,198 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/api_key/pg/mapper"
+	modelRepo "github.com/Paul1k96/microservices_course_auth/internal/repository/api_key/pg/model"
+	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+)
+
+const (
+	apiKeyTable = "api_keys"
+
+	idColumn         = "id"
+	userIDColumn     = "user_id"
+	nameColumn       = "name"
+	prefixColumn     = "prefix"
+	keyHashColumn    = "key_hash"
+	scopesColumn     = "scopes"
+	expiresAtColumn  = "expires_at"
+	lastUsedAtColumn = "last_used_at"
+	lastUsedIPColumn = "last_used_ip"
+	revokedAtColumn  = "revoked_at"
+	createdAtColumn  = "created_at"
+)
+
+// Repository represents API key repository.
+type Repository struct {
+	db db.DB
+}
+
+// NewRepository creates a new instance of repository.APIKeysRepository.
+func NewRepository(pg db.DB) *Repository {
+	return &Repository{db: pg}
+}
+
+// Create API key.
+func (r *Repository) Create(ctx context.Context, key *model.APIKey) error {
+	queryBuilder := sq.Insert(apiKeyTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(
+			idColumn,
+			userIDColumn,
+			nameColumn,
+			prefixColumn,
+			keyHashColumn,
+			scopesColumn,
+			expiresAtColumn,
+			createdAtColumn,
+		).
+		Values(
+			key.ID,
+			key.UserID,
+			key.Name,
+			key.Prefix,
+			key.KeyHash,
+			key.Scopes,
+			key.ExpiresAt,
+			key.CreatedAt,
+		)
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "api_key_repository.Create",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	return nil
+}
+
+// GetByPrefix returns API key by its public prefix.
+func (r *Repository) GetByPrefix(ctx context.Context, prefix string) (*model.APIKey, error) {
+	queryBuilder := sq.Select("*").
+		PlaceholderFormat(sq.Dollar).
+		From(apiKeyTable).
+		Where(sq.Eq{prefixColumn: prefix})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "api_key_repository.GetByPrefix",
+		QueryRaw: query,
+	}
+
+	var key modelRepo.APIKey
+	err = r.db.ScanOneContext(ctx, &key, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("get api key: %w", errs.ErrAPIKeyNotFound)
+		}
+
+		return nil, fmt.Errorf("get api key: %w", err)
+	}
+
+	return mapper.ToAPIKeyFromRepo(&key), nil
+}
+
+// GetByUserID returns not revoked API keys of the user, the newest first.
+func (r *Repository) GetByUserID(ctx context.Context, userID int64) ([]*model.APIKey, error) {
+	queryBuilder := sq.Select("*").
+		PlaceholderFormat(sq.Dollar).
+		From(apiKeyTable).
+		Where(sq.Eq{userIDColumn: userID, revokedAtColumn: nil}).
+		OrderBy(createdAtColumn + " DESC")
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "api_key_repository.GetByUserID",
+		QueryRaw: query,
+	}
+
+	var keys []*modelRepo.APIKey
+	err = r.db.ScanAllContext(ctx, &keys, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("get api keys: %w", err)
+	}
+
+	return mapper.ToAPIKeysFromRepo(keys), nil
+}
+
+// Revoke revokes the not yet revoked API key of the user.
+func (r *Repository) Revoke(ctx context.Context, userID int64, id uuid.UUID, revokedAt time.Time) error {
+	queryBuilder := sq.Update(apiKeyTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(revokedAtColumn, revokedAt).
+		Where(sq.Eq{idColumn: id, userIDColumn: userID, revokedAtColumn: nil})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "api_key_repository.Revoke",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("revoke api key: %w", errs.ErrAPIKeyNotFound)
+	}
+
+	return nil
+}
+
+// Touch records when and from which address the API key was last used.
+func (r *Repository) Touch(ctx context.Context, id uuid.UUID, usedAt time.Time, ip string) error {
+	queryBuilder := sq.Update(apiKeyTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(lastUsedAtColumn, usedAt).
+		Set(lastUsedIPColumn, ip).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "api_key_repository.Touch",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package model
+
+// AuthorizationCode represents repository authorization code model.
+type AuthorizationCode struct {
+	ClientID    string `redis:"client_id"`
+	UserID      int64  `redis:"user_id"`
+	RedirectURI string `redis:"redirect_uri"`
+	// Scopes are space separated.
+	Scopes        string `redis:"scopes"`
+	Nonce         string `redis:"nonce"`
+	CodeChallenge string `redis:"code_challenge"`
+	// ExpiresAt is unix time in nanoseconds.
+	ExpiresAt int64 `redis:"expires_at"`
+}
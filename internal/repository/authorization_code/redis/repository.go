@@ -0,0 +1,104 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	modelRepo "github.com/Paul1k96/microservices_course_auth/internal/repository/authorization_code/redis/model"
+	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/cache"
+	"github.com/gomodule/redigo/redis"
+)
+
+const keyPrefix = "authorization_code:"
+
+// redeemScript returns fields of the code and deletes it, nothing is returned if no code was deleted.
+var redeemScript = redis.NewScript(1, `
+local code = redis.call('HGETALL', KEYS[1])
+if redis.call('DEL', KEYS[1]) == 0 then
+	return {}
+end
+
+return code
+`)
+
+// Repository represents authorization code repository.
+// cache.RedisClient cannot run scripts, so codes are redeemed through the pool it is built on.
+type Repository struct {
+	redisCache cache.RedisClient
+	pool       *redis.Pool
+}
+
+// NewRepository creates a new instance of repository.AuthorizationCodesRepository.
+func NewRepository(redisCache cache.RedisClient, pool *redis.Pool) *Repository {
+	return &Repository{redisCache: redisCache, pool: pool}
+}
+
+// Set stores authorization code by its hash for ttl.
+func (r *Repository) Set(
+	ctx context.Context,
+	codeHash string,
+	code *model.AuthorizationCode,
+	ttl time.Duration,
+) error {
+	codeToSet := modelRepo.AuthorizationCode{
+		ClientID:      code.ClientID,
+		UserID:        code.UserID,
+		RedirectURI:   code.RedirectURI,
+		Scopes:        strings.Join(code.Scopes, " "),
+		Nonce:         code.Nonce,
+		CodeChallenge: code.CodeChallenge,
+		ExpiresAt:     code.ExpiresAt.UnixNano(),
+	}
+
+	err := r.redisCache.HSet(ctx, keyPrefix+codeHash, codeToSet)
+	if err != nil {
+		return fmt.Errorf("set authorization code: %w", err)
+	}
+
+	// EXPIRE works with whole seconds, round up so the code does not expire early
+	err = r.redisCache.Expire(ctx, keyPrefix+codeHash, ttl.Truncate(time.Second)+time.Second)
+	if err != nil {
+		return fmt.Errorf("set ttl: %w", err)
+	}
+
+	return nil
+}
+
+// Redeem returns authorization code by its hash and removes it, so the code can be redeemed only once
+// even by concurrent requests.
+func (r *Repository) Redeem(ctx context.Context, codeHash string) (*model.AuthorizationCode, error) {
+	conn, err := r.pool.GetContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get redis connection: %w", err)
+	}
+	defer conn.Close()
+
+	values, err := redis.Values(redeemScript.DoContext(ctx, conn, keyPrefix+codeHash))
+	if err != nil {
+		return nil, fmt.Errorf("redeem authorization code: %w", err)
+	}
+
+	if len(values) == 0 {
+		return nil, errs.ErrAuthorizationCodeNotFound
+	}
+
+	var code modelRepo.AuthorizationCode
+	err = redis.ScanStruct(values, &code)
+	if err != nil {
+		return nil, fmt.Errorf("scan authorization code: %w", err)
+	}
+
+	return &model.AuthorizationCode{
+		ClientID:      code.ClientID,
+		UserID:        code.UserID,
+		RedirectURI:   code.RedirectURI,
+		Scopes:        strings.Fields(code.Scopes),
+		Nonce:         code.Nonce,
+		CodeChallenge: code.CodeChallenge,
+		ExpiresAt:     time.Unix(0, code.ExpiresAt),
+	}, nil
+}
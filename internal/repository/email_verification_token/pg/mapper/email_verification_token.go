@@ -0,0 +1,23 @@
+package mapper
+
+import (
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	modelRepo "github.com/Paul1k96/microservices_course_auth/internal/repository/email_verification_token/pg/model"
+)
+
+// ToEmailVerificationTokenFromRepo converts email verification token from repository model to service model.
+func ToEmailVerificationTokenFromRepo(token *modelRepo.EmailVerificationToken) *model.EmailVerificationToken {
+	var serviceToken model.EmailVerificationToken
+
+	serviceToken.ID = token.ID
+	serviceToken.UserID = token.UserID
+	serviceToken.Email = token.Email
+	serviceToken.TokenHash = token.TokenHash
+	serviceToken.ExpiresAt = token.ExpiresAt
+	serviceToken.CreatedAt = token.CreatedAt
+	if token.UsedAt.Valid {
+		serviceToken.UsedAt = &token.UsedAt.Time
+	}
+
+	return &serviceToken
+}
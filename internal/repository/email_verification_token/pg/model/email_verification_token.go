@@ -0,0 +1,19 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmailVerificationToken represents repository email verification token model.
+type EmailVerificationToken struct {
+	ID        uuid.UUID    `db:"id"`
+	UserID    int64        `db:"user_id"`
+	Email     string       `db:"email"`
+	TokenHash string       `db:"token_hash"`
+	ExpiresAt time.Time    `db:"expires_at"`
+	UsedAt    sql.NullTime `db:"used_at"`
+	CreatedAt time.Time    `db:"created_at"`
+}
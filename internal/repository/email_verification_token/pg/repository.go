@@ -0,0 +1,120 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/email_verification_token/pg/mapper"
+	modelRepo "github.com/Paul1k96/microservices_course_auth/internal/repository/email_verification_token/pg/model"
+	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/db"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+)
+
+const (
+	emailVerificationTokenTable = "email_verification_tokens"
+
+	idColumn        = "id"
+	userIDColumn    = "user_id"
+	emailColumn     = "email"
+	tokenHashColumn = "token_hash"
+	expiresAtColumn = "expires_at"
+	usedAtColumn    = "used_at"
+	createdAtColumn = "created_at"
+)
+
+// Repository represents email verification token repository.
+type Repository struct {
+	db db.DB
+}
+
+// NewRepository creates a new instance of repository.EmailVerificationTokensRepository.
+func NewRepository(pg db.DB) *Repository {
+	return &Repository{db: pg}
+}
+
+// Create email verification token.
+func (r *Repository) Create(ctx context.Context, token *model.EmailVerificationToken) error {
+	queryBuilder := sq.Insert(emailVerificationTokenTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(idColumn, userIDColumn, emailColumn, tokenHashColumn, expiresAtColumn, createdAtColumn).
+		Values(token.ID, token.UserID, token.Email, token.TokenHash, token.ExpiresAt, token.CreatedAt)
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "email_verification_token_repository.Create",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	return nil
+}
+
+// GetByHashForUpdate returns email verification token by its hash and locks the row
+// until the end of the current transaction.
+func (r *Repository) GetByHashForUpdate(ctx context.Context, tokenHash string) (*model.EmailVerificationToken, error) {
+	queryBuilder := sq.Select("*").
+		PlaceholderFormat(sq.Dollar).
+		From(emailVerificationTokenTable).
+		Where(sq.Eq{tokenHashColumn: tokenHash}).
+		Suffix("FOR UPDATE")
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "email_verification_token_repository.GetByHashForUpdate",
+		QueryRaw: query,
+	}
+
+	var token modelRepo.EmailVerificationToken
+	err = r.db.ScanOneContext(ctx, &token, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("get email verification token: %w", errs.ErrEmailVerificationTokenNotFound)
+		}
+
+		return nil, fmt.Errorf("get email verification token: %w", err)
+	}
+
+	return mapper.ToEmailVerificationTokenFromRepo(&token), nil
+}
+
+// MarkUsedByUserID marks every unused email verification token of the user as used.
+func (r *Repository) MarkUsedByUserID(ctx context.Context, userID int64, usedAt time.Time) error {
+	queryBuilder := sq.Update(emailVerificationTokenTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(usedAtColumn, usedAt).
+		Where(sq.Eq{userIDColumn: userID, usedAtColumn: nil})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "email_verification_token_repository.MarkUsedByUserID",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	return nil
+}
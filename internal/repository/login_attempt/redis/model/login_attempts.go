@@ -0,0 +1,9 @@
+package model
+
+// LoginAttempts represents repository login attempts model.
+type LoginAttempts struct {
+	Failures int `redis:"failures"`
+	Lockouts int `redis:"lockouts"`
+	// LockedUntil is unix time in nanoseconds, zero when not locked.
+	LockedUntil int64 `redis:"locked_until"`
+}
@@ -0,0 +1,157 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	modelRepo "github.com/Paul1k96/microservices_course_auth/internal/repository/login_attempt/redis/model"
+	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/cache"
+	"github.com/gomodule/redigo/redis"
+)
+
+const keyPrefix = "login_attempts:"
+
+// addFailureScript increments failures unless attempts are locked, ARGV[2] is the current unix time
+// in nanoseconds. Reaching the threshold in ARGV[1] resets failures and increments lockouts.
+// It returns failures, lockouts, the lock time and whether the threshold was reached.
+var addFailureScript = redis.NewScript(1, `
+local attempts = redis.call('HMGET', KEYS[1], 'failures', 'lockouts', 'locked_until')
+local failures = tonumber(attempts[1]) or 0
+local lockouts = tonumber(attempts[2]) or 0
+local locked_until = attempts[3] or '0'
+if tonumber(locked_until) > tonumber(ARGV[2]) then
+	return {failures, lockouts, locked_until, 0}
+end
+
+failures = redis.call('HINCRBY', KEYS[1], 'failures', 1)
+local reached = 0
+if failures >= tonumber(ARGV[1]) then
+	redis.call('HSET', KEYS[1], 'failures', 0)
+	lockouts = redis.call('HINCRBY', KEYS[1], 'lockouts', 1)
+	failures = 0
+	reached = 1
+end
+
+redis.call('PEXPIRE', KEYS[1], ARGV[3])
+
+return {failures, lockouts, locked_until, reached}
+`)
+
+// lockScript sets the lock time of attempts and their ttl in milliseconds.
+var lockScript = redis.NewScript(1, `
+redis.call('HSET', KEYS[1], 'locked_until', ARGV[1])
+redis.call('PEXPIRE', KEYS[1], ARGV[2])
+
+return 1
+`)
+
+// Repository represents failed login attempts repository.
+// cache.RedisClient cannot run scripts, so failures are counted through the pool it is built on.
+type Repository struct {
+	redisCache cache.RedisClient
+	pool       *redis.Pool
+}
+
+// NewRepository creates a new instance of repository.LoginAttemptsRepository.
+func NewRepository(redisCache cache.RedisClient, pool *redis.Pool) *Repository {
+	return &Repository{redisCache: redisCache, pool: pool}
+}
+
+// Get returns login attempts by key, empty attempts are returned if there are none.
+func (r *Repository) Get(ctx context.Context, key string) (*model.LoginAttempts, error) {
+	values, err := r.redisCache.HGetAll(ctx, keyPrefix+key)
+	if err != nil {
+		return nil, fmt.Errorf("get login attempts: %w", err)
+	}
+
+	var attempts modelRepo.LoginAttempts
+	err = redis.ScanStruct(values, &attempts)
+	if err != nil {
+		return nil, fmt.Errorf("scan login attempts: %w", err)
+	}
+
+	return toLoginAttempts(&attempts), nil
+}
+
+// AddFailure atomically counts a failed attempt by key unless attempts are locked at now and keeps them
+// for ttl. Reaching threshold resets failures and counts a lockout, it is reported only to the caller
+// whose failure reached it.
+func (r *Repository) AddFailure(
+	ctx context.Context,
+	key string,
+	threshold int,
+	now time.Time,
+	ttl time.Duration,
+) (*model.LoginAttempts, bool, error) {
+	conn, err := r.pool.GetContext(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("get redis connection: %w", err)
+	}
+	defer conn.Close()
+
+	values, err := redis.Values(addFailureScript.DoContext(
+		ctx,
+		conn,
+		keyPrefix+key,
+		threshold,
+		now.UnixNano(),
+		ttl.Milliseconds(),
+	))
+	if err != nil {
+		return nil, false, fmt.Errorf("add login failure: %w", err)
+	}
+
+	var (
+		attempts modelRepo.LoginAttempts
+		reached  bool
+	)
+
+	_, err = redis.Scan(values, &attempts.Failures, &attempts.Lockouts, &attempts.LockedUntil, &reached)
+	if err != nil {
+		return nil, false, fmt.Errorf("scan login attempts: %w", err)
+	}
+
+	return toLoginAttempts(&attempts), reached, nil
+}
+
+// Lock refuses attempts by key until lockedUntil and keeps them for ttl.
+func (r *Repository) Lock(ctx context.Context, key string, lockedUntil time.Time, ttl time.Duration) error {
+	conn, err := r.pool.GetContext(ctx)
+	if err != nil {
+		return fmt.Errorf("get redis connection: %w", err)
+	}
+	defer conn.Close()
+
+	_, err = lockScript.DoContext(ctx, conn, keyPrefix+key, lockedUntil.UnixNano(), ttl.Milliseconds())
+	if err != nil {
+		return fmt.Errorf("lock login attempts: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes login attempts by key.
+func (r *Repository) Delete(ctx context.Context, key string) error {
+	err := r.redisCache.Delete(ctx, keyPrefix+key)
+	if err != nil {
+		return fmt.Errorf("delete login attempts: %w", err)
+	}
+
+	return nil
+}
+
+func toLoginAttempts(attempts *modelRepo.LoginAttempts) *model.LoginAttempts {
+	res := &model.LoginAttempts{
+		Failures: attempts.Failures,
+		Lockouts: attempts.Lockouts,
+	}
+
+	if attempts.LockedUntil != 0 {
+		lockedUntil := time.Unix(0, attempts.LockedUntil)
+		res.LockedUntil = &lockedUntil
+	}
+
+	return res
+}
@@ -0,0 +1,9 @@
+package model
+
+// MFAChallenge represents repository MFA challenge model.
+type MFAChallenge struct {
+	UserID   int64 `redis:"user_id"`
+	Attempts int   `redis:"attempts"`
+	// ExpiresAt is unix time in nanoseconds.
+	ExpiresAt int64 `redis:"expires_at"`
+}
@@ -0,0 +1,123 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	modelRepo "github.com/Paul1k96/microservices_course_auth/internal/repository/mfa_challenge/redis/model"
+	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/cache"
+	"github.com/gomodule/redigo/redis"
+)
+
+const keyPrefix = "mfa_challenge:"
+
+// takeAttemptScript increments attempts of the challenge and deletes it once they reach ARGV[1].
+// It returns the user ID, attempts and expiration time, nothing is returned if there is no challenge.
+var takeAttemptScript = redis.NewScript(1, `
+local challenge = redis.call('HMGET', KEYS[1], 'user_id', 'expires_at')
+if not challenge[1] then
+	return {}
+end
+
+local attempts = redis.call('HINCRBY', KEYS[1], 'attempts', 1)
+if attempts >= tonumber(ARGV[1]) then
+	redis.call('DEL', KEYS[1])
+end
+
+return {challenge[1], attempts, challenge[2]}
+`)
+
+// Repository represents MFA challenge repository.
+// cache.RedisClient cannot run scripts, so attempts are counted through the pool it is built on.
+type Repository struct {
+	redisCache cache.RedisClient
+	pool       *redis.Pool
+}
+
+// NewRepository creates a new instance of repository.MFAChallengesRepository.
+func NewRepository(redisCache cache.RedisClient, pool *redis.Pool) *Repository {
+	return &Repository{redisCache: redisCache, pool: pool}
+}
+
+// Set stores challenge by the hash of its token for ttl.
+func (r *Repository) Set(
+	ctx context.Context,
+	tokenHash string,
+	challenge *model.MFAChallenge,
+	ttl time.Duration,
+) error {
+	challengeToSet := modelRepo.MFAChallenge{
+		UserID:    challenge.UserID,
+		Attempts:  challenge.Attempts,
+		ExpiresAt: challenge.ExpiresAt.UnixNano(),
+	}
+
+	err := r.redisCache.HSet(ctx, keyPrefix+tokenHash, challengeToSet)
+	if err != nil {
+		return fmt.Errorf("set mfa challenge: %w", err)
+	}
+
+	// EXPIRE works with whole seconds, round up so the challenge does not expire early
+	err = r.redisCache.Expire(ctx, keyPrefix+tokenHash, ttl.Truncate(time.Second)+time.Second)
+	if err != nil {
+		return fmt.Errorf("set ttl: %w", err)
+	}
+
+	return nil
+}
+
+// TakeAttempt counts an attempt to complete challenge by the hash of its token and returns the challenge
+// with the attempts counted so far. The challenge is removed with the last of maxAttempts, so concurrent
+// requests cannot make more attempts than allowed.
+func (r *Repository) TakeAttempt(ctx context.Context, tokenHash string, maxAttempts int) (*model.MFAChallenge, error) {
+	conn, err := r.pool.GetContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get redis connection: %w", err)
+	}
+	defer conn.Close()
+
+	values, err := redis.Values(takeAttemptScript.DoContext(ctx, conn, keyPrefix+tokenHash, maxAttempts))
+	if err != nil {
+		return nil, fmt.Errorf("take mfa challenge attempt: %w", err)
+	}
+
+	if len(values) == 0 {
+		return nil, errs.ErrMFAChallengeNotFound
+	}
+
+	var challenge modelRepo.MFAChallenge
+	_, err = redis.Scan(values, &challenge.UserID, &challenge.Attempts, &challenge.ExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("scan mfa challenge: %w", err)
+	}
+
+	return &model.MFAChallenge{
+		UserID:    challenge.UserID,
+		Attempts:  challenge.Attempts,
+		ExpiresAt: time.Unix(0, challenge.ExpiresAt),
+	}, nil
+}
+
+// Delete removes challenge by the hash of its token. ErrMFAChallengeNotFound is returned if there
+// was no challenge, so only one of concurrent requests completes it.
+func (r *Repository) Delete(ctx context.Context, tokenHash string) error {
+	conn, err := r.pool.GetContext(ctx)
+	if err != nil {
+		return fmt.Errorf("get redis connection: %w", err)
+	}
+	defer conn.Close()
+
+	deleted, err := redis.Int(conn.Do("DEL", keyPrefix+tokenHash))
+	if err != nil {
+		return fmt.Errorf("delete mfa challenge: %w", err)
+	}
+
+	if deleted == 0 {
+		return errs.ErrMFAChallengeNotFound
+	}
+
+	return nil
+}
@@ -12,8 +12,10 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/Paul1k96/microservices_course_auth/internal/model"
+	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,6 +43,20 @@ func (m *MockUsersRepository) EXPECT() *MockUsersRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddMember mocks base method.
+func (m *MockUsersRepository) AddMember(ctx context.Context, orgID, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx, orgID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockUsersRepositoryMockRecorder) AddMember(ctx, orgID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockUsersRepository)(nil).AddMember), ctx, orgID, userID)
+}
+
 // Create mocks base method.
 func (m *MockUsersRepository) Create(ctx context.Context, user *model.User) (int64, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +86,21 @@ func (mr *MockUsersRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUsersRepository)(nil).Delete), ctx, id)
 }
 
+// GetByEmail mocks base method.
+func (m *MockUsersRepository) GetByEmail(ctx context.Context, orgID int64, email string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEmail", ctx, orgID, email)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEmail indicates an expected call of GetByEmail.
+func (mr *MockUsersRepositoryMockRecorder) GetByEmail(ctx, orgID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmail", reflect.TypeOf((*MockUsersRepository)(nil).GetByEmail), ctx, orgID, email)
+}
+
 // GetByID mocks base method.
 func (m *MockUsersRepository) GetByID(ctx context.Context, id int64) (*model.User, error) {
 	m.ctrl.T.Helper()
@@ -85,19 +116,63 @@ func (mr *MockUsersRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUsersRepository)(nil).GetByID), ctx, id)
 }
 
-// GetByIDs mocks base method.
-func (m *MockUsersRepository) GetByIDs(ctx context.Context, ids []int64) ([]*model.User, error) {
+// GetByIDInOrg mocks base method.
+func (m *MockUsersRepository) GetByIDInOrg(ctx context.Context, orgID, id int64) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDInOrg", ctx, orgID, id)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDInOrg indicates an expected call of GetByIDInOrg.
+func (mr *MockUsersRepositoryMockRecorder) GetByIDInOrg(ctx, orgID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDInOrg", reflect.TypeOf((*MockUsersRepository)(nil).GetByIDInOrg), ctx, orgID, id)
+}
+
+// GetByIDsInOrg mocks base method.
+func (m *MockUsersRepository) GetByIDsInOrg(ctx context.Context, orgID int64, ids []int64) ([]*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDsInOrg", ctx, orgID, ids)
+	ret0, _ := ret[0].([]*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDsInOrg indicates an expected call of GetByIDsInOrg.
+func (mr *MockUsersRepositoryMockRecorder) GetByIDsInOrg(ctx, orgID, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDsInOrg", reflect.TypeOf((*MockUsersRepository)(nil).GetByIDsInOrg), ctx, orgID, ids)
+}
+
+// MarkEmailVerified mocks base method.
+func (m *MockUsersRepository) MarkEmailVerified(ctx context.Context, id int64, email string, verifiedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkEmailVerified", ctx, id, email, verifiedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkEmailVerified indicates an expected call of MarkEmailVerified.
+func (mr *MockUsersRepositoryMockRecorder) MarkEmailVerified(ctx, id, email, verifiedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEmailVerified", reflect.TypeOf((*MockUsersRepository)(nil).MarkEmailVerified), ctx, id, email, verifiedAt)
+}
+
+// Search mocks base method.
+func (m *MockUsersRepository) Search(ctx context.Context, filter *model.UserFilter) ([]*model.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByIDs", ctx, ids)
+	ret := m.ctrl.Call(m, "Search", ctx, filter)
 	ret0, _ := ret[0].([]*model.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetByIDs indicates an expected call of GetByIDs.
-func (mr *MockUsersRepositoryMockRecorder) GetByIDs(ctx, ids any) *gomock.Call {
+// Search indicates an expected call of Search.
+func (mr *MockUsersRepositoryMockRecorder) Search(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockUsersRepository)(nil).GetByIDs), ctx, ids)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockUsersRepository)(nil).Search), ctx, filter)
 }
 
 // Update mocks base method.
@@ -114,6 +189,34 @@ func (mr *MockUsersRepositoryMockRecorder) Update(ctx, user any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUsersRepository)(nil).Update), ctx, user)
 }
 
+// UpdatePassword mocks base method.
+func (m *MockUsersRepository) UpdatePassword(ctx context.Context, id int64, passwordHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", ctx, id, passwordHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockUsersRepositoryMockRecorder) UpdatePassword(ctx, id, passwordHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUsersRepository)(nil).UpdatePassword), ctx, id, passwordHash)
+}
+
+// UpdateTOTP mocks base method.
+func (m *MockUsersRepository) UpdateTOTP(ctx context.Context, user *model.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTOTP", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTOTP indicates an expected call of UpdateTOTP.
+func (mr *MockUsersRepositoryMockRecorder) UpdateTOTP(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTOTP", reflect.TypeOf((*MockUsersRepository)(nil).UpdateTOTP), ctx, user)
+}
+
 // MockUsersCache is a mock of UsersCache interface.
 type MockUsersCache struct {
 	ctrl     *gomock.Controller
@@ -153,32 +256,32 @@ func (mr *MockUsersCacheMockRecorder) Delete(ctx, id any) *gomock.Call {
 }
 
 // Get mocks base method.
-func (m *MockUsersCache) Get(ctx context.Context, id int64) (*model.User, error) {
+func (m *MockUsersCache) Get(ctx context.Context, orgID, id int64) (*model.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret := m.ctrl.Call(m, "Get", ctx, orgID, id)
 	ret0, _ := ret[0].(*model.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockUsersCacheMockRecorder) Get(ctx, id any) *gomock.Call {
+func (mr *MockUsersCacheMockRecorder) Get(ctx, orgID, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUsersCache)(nil).Get), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUsersCache)(nil).Get), ctx, orgID, id)
 }
 
 // Set mocks base method.
-func (m *MockUsersCache) Set(ctx context.Context, user *model.User) error {
+func (m *MockUsersCache) Set(ctx context.Context, orgID int64, user *model.User) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Set", ctx, user)
+	ret := m.ctrl.Call(m, "Set", ctx, orgID, user)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Set indicates an expected call of Set.
-func (mr *MockUsersCacheMockRecorder) Set(ctx, user any) *gomock.Call {
+func (mr *MockUsersCacheMockRecorder) Set(ctx, orgID, user any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockUsersCache)(nil).Set), ctx, user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockUsersCache)(nil).Set), ctx, orgID, user)
 }
 
 // MockUserEventsRepository is a mock of UserEventsRepository interface.
@@ -218,3 +321,1227 @@ func (mr *MockUserEventsRepositoryMockRecorder) Save(ctx, event any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockUserEventsRepository)(nil).Save), ctx, event)
 }
+
+// MockRefreshTokensRepository is a mock of RefreshTokensRepository interface.
+type MockRefreshTokensRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshTokensRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRefreshTokensRepositoryMockRecorder is the mock recorder for MockRefreshTokensRepository.
+type MockRefreshTokensRepositoryMockRecorder struct {
+	mock *MockRefreshTokensRepository
+}
+
+// NewMockRefreshTokensRepository creates a new mock instance.
+func NewMockRefreshTokensRepository(ctrl *gomock.Controller) *MockRefreshTokensRepository {
+	mock := &MockRefreshTokensRepository{ctrl: ctrl}
+	mock.recorder = &MockRefreshTokensRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshTokensRepository) EXPECT() *MockRefreshTokensRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRefreshTokensRepository) Create(ctx context.Context, token *model.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRefreshTokensRepositoryMockRecorder) Create(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRefreshTokensRepository)(nil).Create), ctx, token)
+}
+
+// GetByFamilyID mocks base method.
+func (m *MockRefreshTokensRepository) GetByFamilyID(ctx context.Context, familyID uuid.UUID, accessExpiresAfter time.Time) ([]*model.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByFamilyID", ctx, familyID, accessExpiresAfter)
+	ret0, _ := ret[0].([]*model.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByFamilyID indicates an expected call of GetByFamilyID.
+func (mr *MockRefreshTokensRepositoryMockRecorder) GetByFamilyID(ctx, familyID, accessExpiresAfter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByFamilyID", reflect.TypeOf((*MockRefreshTokensRepository)(nil).GetByFamilyID), ctx, familyID, accessExpiresAfter)
+}
+
+// GetByHashForUpdate mocks base method.
+func (m *MockRefreshTokensRepository) GetByHashForUpdate(ctx context.Context, tokenHash string) (*model.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHashForUpdate", ctx, tokenHash)
+	ret0, _ := ret[0].(*model.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHashForUpdate indicates an expected call of GetByHashForUpdate.
+func (mr *MockRefreshTokensRepositoryMockRecorder) GetByHashForUpdate(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHashForUpdate", reflect.TypeOf((*MockRefreshTokensRepository)(nil).GetByHashForUpdate), ctx, tokenHash)
+}
+
+// GetByUserID mocks base method.
+func (m *MockRefreshTokensRepository) GetByUserID(ctx context.Context, userID int64, accessExpiresAfter time.Time) ([]*model.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID, accessExpiresAfter)
+	ret0, _ := ret[0].([]*model.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockRefreshTokensRepositoryMockRecorder) GetByUserID(ctx, userID, accessExpiresAfter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockRefreshTokensRepository)(nil).GetByUserID), ctx, userID, accessExpiresAfter)
+}
+
+// MarkRotated mocks base method.
+func (m *MockRefreshTokensRepository) MarkRotated(ctx context.Context, id uuid.UUID, rotatedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRotated", ctx, id, rotatedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRotated indicates an expected call of MarkRotated.
+func (mr *MockRefreshTokensRepositoryMockRecorder) MarkRotated(ctx, id, rotatedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRotated", reflect.TypeOf((*MockRefreshTokensRepository)(nil).MarkRotated), ctx, id, rotatedAt)
+}
+
+// RevokeByUserID mocks base method.
+func (m *MockRefreshTokensRepository) RevokeByUserID(ctx context.Context, userID int64, revokedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeByUserID", ctx, userID, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeByUserID indicates an expected call of RevokeByUserID.
+func (mr *MockRefreshTokensRepositoryMockRecorder) RevokeByUserID(ctx, userID, revokedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeByUserID", reflect.TypeOf((*MockRefreshTokensRepository)(nil).RevokeByUserID), ctx, userID, revokedAt)
+}
+
+// RevokeByUserIDExceptFamily mocks base method.
+func (m *MockRefreshTokensRepository) RevokeByUserIDExceptFamily(ctx context.Context, userID int64, familyID uuid.UUID, revokedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeByUserIDExceptFamily", ctx, userID, familyID, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeByUserIDExceptFamily indicates an expected call of RevokeByUserIDExceptFamily.
+func (mr *MockRefreshTokensRepositoryMockRecorder) RevokeByUserIDExceptFamily(ctx, userID, familyID, revokedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeByUserIDExceptFamily", reflect.TypeOf((*MockRefreshTokensRepository)(nil).RevokeByUserIDExceptFamily), ctx, userID, familyID, revokedAt)
+}
+
+// RevokeFamily mocks base method.
+func (m *MockRefreshTokensRepository) RevokeFamily(ctx context.Context, familyID uuid.UUID, revokedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeFamily", ctx, familyID, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeFamily indicates an expected call of RevokeFamily.
+func (mr *MockRefreshTokensRepositoryMockRecorder) RevokeFamily(ctx, familyID, revokedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeFamily", reflect.TypeOf((*MockRefreshTokensRepository)(nil).RevokeFamily), ctx, familyID, revokedAt)
+}
+
+// MockSessionsRepository is a mock of SessionsRepository interface.
+type MockSessionsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSessionsRepositoryMockRecorder is the mock recorder for MockSessionsRepository.
+type MockSessionsRepositoryMockRecorder struct {
+	mock *MockSessionsRepository
+}
+
+// NewMockSessionsRepository creates a new mock instance.
+func NewMockSessionsRepository(ctrl *gomock.Controller) *MockSessionsRepository {
+	mock := &MockSessionsRepository{ctrl: ctrl}
+	mock.recorder = &MockSessionsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionsRepository) EXPECT() *MockSessionsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetActiveByUserID mocks base method.
+func (m *MockSessionsRepository) GetActiveByUserID(ctx context.Context, userID int64, now time.Time) ([]*model.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveByUserID", ctx, userID, now)
+	ret0, _ := ret[0].([]*model.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveByUserID indicates an expected call of GetActiveByUserID.
+func (mr *MockSessionsRepositoryMockRecorder) GetActiveByUserID(ctx, userID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveByUserID", reflect.TypeOf((*MockSessionsRepository)(nil).GetActiveByUserID), ctx, userID, now)
+}
+
+// GetByID mocks base method.
+func (m *MockSessionsRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*model.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockSessionsRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockSessionsRepository)(nil).GetByID), ctx, id)
+}
+
+// Revoke mocks base method.
+func (m *MockSessionsRepository) Revoke(ctx context.Context, id uuid.UUID, revokedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockSessionsRepositoryMockRecorder) Revoke(ctx, id, revokedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockSessionsRepository)(nil).Revoke), ctx, id, revokedAt)
+}
+
+// RevokeByUserID mocks base method.
+func (m *MockSessionsRepository) RevokeByUserID(ctx context.Context, userID int64, revokedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeByUserID", ctx, userID, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeByUserID indicates an expected call of RevokeByUserID.
+func (mr *MockSessionsRepositoryMockRecorder) RevokeByUserID(ctx, userID, revokedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeByUserID", reflect.TypeOf((*MockSessionsRepository)(nil).RevokeByUserID), ctx, userID, revokedAt)
+}
+
+// RevokeByUserIDExcept mocks base method.
+func (m *MockSessionsRepository) RevokeByUserIDExcept(ctx context.Context, userID int64, id uuid.UUID, revokedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeByUserIDExcept", ctx, userID, id, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeByUserIDExcept indicates an expected call of RevokeByUserIDExcept.
+func (mr *MockSessionsRepositoryMockRecorder) RevokeByUserIDExcept(ctx, userID, id, revokedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeByUserIDExcept", reflect.TypeOf((*MockSessionsRepository)(nil).RevokeByUserIDExcept), ctx, userID, id, revokedAt)
+}
+
+// Save mocks base method.
+func (m *MockSessionsRepository) Save(ctx context.Context, session *model.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockSessionsRepositoryMockRecorder) Save(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockSessionsRepository)(nil).Save), ctx, session)
+}
+
+// MockRolesRepository is a mock of RolesRepository interface.
+type MockRolesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRolesRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRolesRepositoryMockRecorder is the mock recorder for MockRolesRepository.
+type MockRolesRepositoryMockRecorder struct {
+	mock *MockRolesRepository
+}
+
+// NewMockRolesRepository creates a new mock instance.
+func NewMockRolesRepository(ctrl *gomock.Controller) *MockRolesRepository {
+	mock := &MockRolesRepository{ctrl: ctrl}
+	mock.recorder = &MockRolesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRolesRepository) EXPECT() *MockRolesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockRolesRepository) Assign(ctx context.Context, orgID, userID, roleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, orgID, userID, roleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockRolesRepositoryMockRecorder) Assign(ctx, orgID, userID, roleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockRolesRepository)(nil).Assign), ctx, orgID, userID, roleID)
+}
+
+// Create mocks base method.
+func (m *MockRolesRepository) Create(ctx context.Context, role *model.Role) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, role)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRolesRepositoryMockRecorder) Create(ctx, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRolesRepository)(nil).Create), ctx, role)
+}
+
+// CreatePermissions mocks base method.
+func (m *MockRolesRepository) CreatePermissions(ctx context.Context, permissions []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePermissions", ctx, permissions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePermissions indicates an expected call of CreatePermissions.
+func (mr *MockRolesRepositoryMockRecorder) CreatePermissions(ctx, permissions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePermissions", reflect.TypeOf((*MockRolesRepository)(nil).CreatePermissions), ctx, permissions)
+}
+
+// Delete mocks base method.
+func (m *MockRolesRepository) Delete(ctx context.Context, orgID, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, orgID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRolesRepositoryMockRecorder) Delete(ctx, orgID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRolesRepository)(nil).Delete), ctx, orgID, id)
+}
+
+// GetByID mocks base method.
+func (m *MockRolesRepository) GetByID(ctx context.Context, orgID, id int64) (*model.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, orgID, id)
+	ret0, _ := ret[0].(*model.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRolesRepositoryMockRecorder) GetByID(ctx, orgID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRolesRepository)(nil).GetByID), ctx, orgID, id)
+}
+
+// GrantByName mocks base method.
+func (m *MockRolesRepository) GrantByName(ctx context.Context, roleName string, permissions []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantByName", ctx, roleName, permissions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantByName indicates an expected call of GrantByName.
+func (mr *MockRolesRepositoryMockRecorder) GrantByName(ctx, roleName, permissions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantByName", reflect.TypeOf((*MockRolesRepository)(nil).GrantByName), ctx, roleName, permissions)
+}
+
+// HasPermission mocks base method.
+func (m *MockRolesRepository) HasPermission(ctx context.Context, userID, orgID int64, permission string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPermission", ctx, userID, orgID, permission)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasPermission indicates an expected call of HasPermission.
+func (mr *MockRolesRepositoryMockRecorder) HasPermission(ctx, userID, orgID, permission any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPermission", reflect.TypeOf((*MockRolesRepository)(nil).HasPermission), ctx, userID, orgID, permission)
+}
+
+// List mocks base method.
+func (m *MockRolesRepository) List(ctx context.Context, orgID int64) ([]*model.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, orgID)
+	ret0, _ := ret[0].([]*model.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRolesRepositoryMockRecorder) List(ctx, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRolesRepository)(nil).List), ctx, orgID)
+}
+
+// ListPermissions mocks base method.
+func (m *MockRolesRepository) ListPermissions(ctx context.Context, userID, orgID int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPermissions", ctx, userID, orgID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPermissions indicates an expected call of ListPermissions.
+func (mr *MockRolesRepositoryMockRecorder) ListPermissions(ctx, userID, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPermissions", reflect.TypeOf((*MockRolesRepository)(nil).ListPermissions), ctx, userID, orgID)
+}
+
+// SetMemberRole mocks base method.
+func (m *MockRolesRepository) SetMemberRole(ctx context.Context, orgID, userID, roleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMemberRole", ctx, orgID, userID, roleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMemberRole indicates an expected call of SetMemberRole.
+func (mr *MockRolesRepositoryMockRecorder) SetMemberRole(ctx, orgID, userID, roleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMemberRole", reflect.TypeOf((*MockRolesRepository)(nil).SetMemberRole), ctx, orgID, userID, roleID)
+}
+
+// Unassign mocks base method.
+func (m *MockRolesRepository) Unassign(ctx context.Context, orgID, userID, roleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unassign", ctx, orgID, userID, roleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unassign indicates an expected call of Unassign.
+func (mr *MockRolesRepositoryMockRecorder) Unassign(ctx, orgID, userID, roleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockRolesRepository)(nil).Unassign), ctx, orgID, userID, roleID)
+}
+
+// Update mocks base method.
+func (m *MockRolesRepository) Update(ctx context.Context, role *model.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRolesRepositoryMockRecorder) Update(ctx, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRolesRepository)(nil).Update), ctx, role)
+}
+
+// MockPasswordResetTokensRepository is a mock of PasswordResetTokensRepository interface.
+type MockPasswordResetTokensRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordResetTokensRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPasswordResetTokensRepositoryMockRecorder is the mock recorder for MockPasswordResetTokensRepository.
+type MockPasswordResetTokensRepositoryMockRecorder struct {
+	mock *MockPasswordResetTokensRepository
+}
+
+// NewMockPasswordResetTokensRepository creates a new mock instance.
+func NewMockPasswordResetTokensRepository(ctrl *gomock.Controller) *MockPasswordResetTokensRepository {
+	mock := &MockPasswordResetTokensRepository{ctrl: ctrl}
+	mock.recorder = &MockPasswordResetTokensRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordResetTokensRepository) EXPECT() *MockPasswordResetTokensRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPasswordResetTokensRepository) Create(ctx context.Context, token *model.PasswordResetToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPasswordResetTokensRepositoryMockRecorder) Create(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPasswordResetTokensRepository)(nil).Create), ctx, token)
+}
+
+// GetByHashForUpdate mocks base method.
+func (m *MockPasswordResetTokensRepository) GetByHashForUpdate(ctx context.Context, tokenHash string) (*model.PasswordResetToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHashForUpdate", ctx, tokenHash)
+	ret0, _ := ret[0].(*model.PasswordResetToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHashForUpdate indicates an expected call of GetByHashForUpdate.
+func (mr *MockPasswordResetTokensRepositoryMockRecorder) GetByHashForUpdate(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHashForUpdate", reflect.TypeOf((*MockPasswordResetTokensRepository)(nil).GetByHashForUpdate), ctx, tokenHash)
+}
+
+// MarkUsedByUserID mocks base method.
+func (m *MockPasswordResetTokensRepository) MarkUsedByUserID(ctx context.Context, userID int64, usedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUsedByUserID", ctx, userID, usedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkUsedByUserID indicates an expected call of MarkUsedByUserID.
+func (mr *MockPasswordResetTokensRepositoryMockRecorder) MarkUsedByUserID(ctx, userID, usedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUsedByUserID", reflect.TypeOf((*MockPasswordResetTokensRepository)(nil).MarkUsedByUserID), ctx, userID, usedAt)
+}
+
+// MockEmailVerificationTokensRepository is a mock of EmailVerificationTokensRepository interface.
+type MockEmailVerificationTokensRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailVerificationTokensRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockEmailVerificationTokensRepositoryMockRecorder is the mock recorder for MockEmailVerificationTokensRepository.
+type MockEmailVerificationTokensRepositoryMockRecorder struct {
+	mock *MockEmailVerificationTokensRepository
+}
+
+// NewMockEmailVerificationTokensRepository creates a new mock instance.
+func NewMockEmailVerificationTokensRepository(ctrl *gomock.Controller) *MockEmailVerificationTokensRepository {
+	mock := &MockEmailVerificationTokensRepository{ctrl: ctrl}
+	mock.recorder = &MockEmailVerificationTokensRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailVerificationTokensRepository) EXPECT() *MockEmailVerificationTokensRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockEmailVerificationTokensRepository) Create(ctx context.Context, token *model.EmailVerificationToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockEmailVerificationTokensRepositoryMockRecorder) Create(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockEmailVerificationTokensRepository)(nil).Create), ctx, token)
+}
+
+// GetByHashForUpdate mocks base method.
+func (m *MockEmailVerificationTokensRepository) GetByHashForUpdate(ctx context.Context, tokenHash string) (*model.EmailVerificationToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHashForUpdate", ctx, tokenHash)
+	ret0, _ := ret[0].(*model.EmailVerificationToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHashForUpdate indicates an expected call of GetByHashForUpdate.
+func (mr *MockEmailVerificationTokensRepositoryMockRecorder) GetByHashForUpdate(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHashForUpdate", reflect.TypeOf((*MockEmailVerificationTokensRepository)(nil).GetByHashForUpdate), ctx, tokenHash)
+}
+
+// MarkUsedByUserID mocks base method.
+func (m *MockEmailVerificationTokensRepository) MarkUsedByUserID(ctx context.Context, userID int64, usedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUsedByUserID", ctx, userID, usedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkUsedByUserID indicates an expected call of MarkUsedByUserID.
+func (mr *MockEmailVerificationTokensRepositoryMockRecorder) MarkUsedByUserID(ctx, userID, usedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUsedByUserID", reflect.TypeOf((*MockEmailVerificationTokensRepository)(nil).MarkUsedByUserID), ctx, userID, usedAt)
+}
+
+// MockRecoveryCodesRepository is a mock of RecoveryCodesRepository interface.
+type MockRecoveryCodesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecoveryCodesRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRecoveryCodesRepositoryMockRecorder is the mock recorder for MockRecoveryCodesRepository.
+type MockRecoveryCodesRepositoryMockRecorder struct {
+	mock *MockRecoveryCodesRepository
+}
+
+// NewMockRecoveryCodesRepository creates a new mock instance.
+func NewMockRecoveryCodesRepository(ctrl *gomock.Controller) *MockRecoveryCodesRepository {
+	mock := &MockRecoveryCodesRepository{ctrl: ctrl}
+	mock.recorder = &MockRecoveryCodesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecoveryCodesRepository) EXPECT() *MockRecoveryCodesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByUserID mocks base method.
+func (m *MockRecoveryCodesRepository) DeleteByUserID(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserID", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserID indicates an expected call of DeleteByUserID.
+func (mr *MockRecoveryCodesRepositoryMockRecorder) DeleteByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserID", reflect.TypeOf((*MockRecoveryCodesRepository)(nil).DeleteByUserID), ctx, userID)
+}
+
+// MarkUsed mocks base method.
+func (m *MockRecoveryCodesRepository) MarkUsed(ctx context.Context, userID int64, codeHash string, usedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUsed", ctx, userID, codeHash, usedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkUsed indicates an expected call of MarkUsed.
+func (mr *MockRecoveryCodesRepositoryMockRecorder) MarkUsed(ctx, userID, codeHash, usedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUsed", reflect.TypeOf((*MockRecoveryCodesRepository)(nil).MarkUsed), ctx, userID, codeHash, usedAt)
+}
+
+// Replace mocks base method.
+func (m *MockRecoveryCodesRepository) Replace(ctx context.Context, userID int64, codeHashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replace", ctx, userID, codeHashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Replace indicates an expected call of Replace.
+func (mr *MockRecoveryCodesRepositoryMockRecorder) Replace(ctx, userID, codeHashes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replace", reflect.TypeOf((*MockRecoveryCodesRepository)(nil).Replace), ctx, userID, codeHashes)
+}
+
+// MockMFAChallengesRepository is a mock of MFAChallengesRepository interface.
+type MockMFAChallengesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMFAChallengesRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMFAChallengesRepositoryMockRecorder is the mock recorder for MockMFAChallengesRepository.
+type MockMFAChallengesRepositoryMockRecorder struct {
+	mock *MockMFAChallengesRepository
+}
+
+// NewMockMFAChallengesRepository creates a new mock instance.
+func NewMockMFAChallengesRepository(ctrl *gomock.Controller) *MockMFAChallengesRepository {
+	mock := &MockMFAChallengesRepository{ctrl: ctrl}
+	mock.recorder = &MockMFAChallengesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMFAChallengesRepository) EXPECT() *MockMFAChallengesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockMFAChallengesRepository) Delete(ctx context.Context, tokenHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, tokenHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMFAChallengesRepositoryMockRecorder) Delete(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMFAChallengesRepository)(nil).Delete), ctx, tokenHash)
+}
+
+// Set mocks base method.
+func (m *MockMFAChallengesRepository) Set(ctx context.Context, tokenHash string, challenge *model.MFAChallenge, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, tokenHash, challenge, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockMFAChallengesRepositoryMockRecorder) Set(ctx, tokenHash, challenge, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockMFAChallengesRepository)(nil).Set), ctx, tokenHash, challenge, ttl)
+}
+
+// TakeAttempt mocks base method.
+func (m *MockMFAChallengesRepository) TakeAttempt(ctx context.Context, tokenHash string, maxAttempts int) (*model.MFAChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeAttempt", ctx, tokenHash, maxAttempts)
+	ret0, _ := ret[0].(*model.MFAChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeAttempt indicates an expected call of TakeAttempt.
+func (mr *MockMFAChallengesRepositoryMockRecorder) TakeAttempt(ctx, tokenHash, maxAttempts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeAttempt", reflect.TypeOf((*MockMFAChallengesRepository)(nil).TakeAttempt), ctx, tokenHash, maxAttempts)
+}
+
+// MockAPIKeysRepository is a mock of APIKeysRepository interface.
+type MockAPIKeysRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeysRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIKeysRepositoryMockRecorder is the mock recorder for MockAPIKeysRepository.
+type MockAPIKeysRepositoryMockRecorder struct {
+	mock *MockAPIKeysRepository
+}
+
+// NewMockAPIKeysRepository creates a new mock instance.
+func NewMockAPIKeysRepository(ctrl *gomock.Controller) *MockAPIKeysRepository {
+	mock := &MockAPIKeysRepository{ctrl: ctrl}
+	mock.recorder = &MockAPIKeysRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeysRepository) EXPECT() *MockAPIKeysRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAPIKeysRepository) Create(ctx context.Context, key *model.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAPIKeysRepositoryMockRecorder) Create(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAPIKeysRepository)(nil).Create), ctx, key)
+}
+
+// GetByPrefix mocks base method.
+func (m *MockAPIKeysRepository) GetByPrefix(ctx context.Context, prefix string) (*model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByPrefix", ctx, prefix)
+	ret0, _ := ret[0].(*model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByPrefix indicates an expected call of GetByPrefix.
+func (mr *MockAPIKeysRepositoryMockRecorder) GetByPrefix(ctx, prefix any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPrefix", reflect.TypeOf((*MockAPIKeysRepository)(nil).GetByPrefix), ctx, prefix)
+}
+
+// GetByUserID mocks base method.
+func (m *MockAPIKeysRepository) GetByUserID(ctx context.Context, userID int64) ([]*model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockAPIKeysRepositoryMockRecorder) GetByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockAPIKeysRepository)(nil).GetByUserID), ctx, userID)
+}
+
+// Revoke mocks base method.
+func (m *MockAPIKeysRepository) Revoke(ctx context.Context, userID int64, id uuid.UUID, revokedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, userID, id, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockAPIKeysRepositoryMockRecorder) Revoke(ctx, userID, id, revokedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockAPIKeysRepository)(nil).Revoke), ctx, userID, id, revokedAt)
+}
+
+// Touch mocks base method.
+func (m *MockAPIKeysRepository) Touch(ctx context.Context, id uuid.UUID, usedAt time.Time, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", ctx, id, usedAt, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockAPIKeysRepositoryMockRecorder) Touch(ctx, id, usedAt, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockAPIKeysRepository)(nil).Touch), ctx, id, usedAt, ip)
+}
+
+// MockOAuthClientsRepository is a mock of OAuthClientsRepository interface.
+type MockOAuthClientsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOAuthClientsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockOAuthClientsRepositoryMockRecorder is the mock recorder for MockOAuthClientsRepository.
+type MockOAuthClientsRepositoryMockRecorder struct {
+	mock *MockOAuthClientsRepository
+}
+
+// NewMockOAuthClientsRepository creates a new mock instance.
+func NewMockOAuthClientsRepository(ctrl *gomock.Controller) *MockOAuthClientsRepository {
+	mock := &MockOAuthClientsRepository{ctrl: ctrl}
+	mock.recorder = &MockOAuthClientsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOAuthClientsRepository) EXPECT() *MockOAuthClientsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockOAuthClientsRepository) Create(ctx context.Context, client *model.OAuthClient) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, client)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOAuthClientsRepositoryMockRecorder) Create(ctx, client any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOAuthClientsRepository)(nil).Create), ctx, client)
+}
+
+// GetByID mocks base method.
+func (m *MockOAuthClientsRepository) GetByID(ctx context.Context, id string) (*model.OAuthClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*model.OAuthClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockOAuthClientsRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockOAuthClientsRepository)(nil).GetByID), ctx, id)
+}
+
+// Revoke mocks base method.
+func (m *MockOAuthClientsRepository) Revoke(ctx context.Context, id string, revokedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockOAuthClientsRepositoryMockRecorder) Revoke(ctx, id, revokedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockOAuthClientsRepository)(nil).Revoke), ctx, id, revokedAt)
+}
+
+// MockAuthorizationCodesRepository is a mock of AuthorizationCodesRepository interface.
+type MockAuthorizationCodesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizationCodesRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthorizationCodesRepositoryMockRecorder is the mock recorder for MockAuthorizationCodesRepository.
+type MockAuthorizationCodesRepositoryMockRecorder struct {
+	mock *MockAuthorizationCodesRepository
+}
+
+// NewMockAuthorizationCodesRepository creates a new mock instance.
+func NewMockAuthorizationCodesRepository(ctrl *gomock.Controller) *MockAuthorizationCodesRepository {
+	mock := &MockAuthorizationCodesRepository{ctrl: ctrl}
+	mock.recorder = &MockAuthorizationCodesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizationCodesRepository) EXPECT() *MockAuthorizationCodesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Redeem mocks base method.
+func (m *MockAuthorizationCodesRepository) Redeem(ctx context.Context, codeHash string) (*model.AuthorizationCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", ctx, codeHash)
+	ret0, _ := ret[0].(*model.AuthorizationCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockAuthorizationCodesRepositoryMockRecorder) Redeem(ctx, codeHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockAuthorizationCodesRepository)(nil).Redeem), ctx, codeHash)
+}
+
+// Set mocks base method.
+func (m *MockAuthorizationCodesRepository) Set(ctx context.Context, codeHash string, code *model.AuthorizationCode, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, codeHash, code, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockAuthorizationCodesRepositoryMockRecorder) Set(ctx, codeHash, code, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockAuthorizationCodesRepository)(nil).Set), ctx, codeHash, code, ttl)
+}
+
+// MockAccessTokenDenylist is a mock of AccessTokenDenylist interface.
+type MockAccessTokenDenylist struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessTokenDenylistMockRecorder
+	isgomock struct{}
+}
+
+// MockAccessTokenDenylistMockRecorder is the mock recorder for MockAccessTokenDenylist.
+type MockAccessTokenDenylistMockRecorder struct {
+	mock *MockAccessTokenDenylist
+}
+
+// NewMockAccessTokenDenylist creates a new mock instance.
+func NewMockAccessTokenDenylist(ctrl *gomock.Controller) *MockAccessTokenDenylist {
+	mock := &MockAccessTokenDenylist{ctrl: ctrl}
+	mock.recorder = &MockAccessTokenDenylistMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessTokenDenylist) EXPECT() *MockAccessTokenDenylistMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockAccessTokenDenylist) Add(ctx context.Context, tokenID string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, tokenID, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockAccessTokenDenylistMockRecorder) Add(ctx, tokenID, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockAccessTokenDenylist)(nil).Add), ctx, tokenID, ttl)
+}
+
+// Contains mocks base method.
+func (m *MockAccessTokenDenylist) Contains(ctx context.Context, tokenID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Contains", ctx, tokenID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Contains indicates an expected call of Contains.
+func (mr *MockAccessTokenDenylistMockRecorder) Contains(ctx, tokenID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Contains", reflect.TypeOf((*MockAccessTokenDenylist)(nil).Contains), ctx, tokenID)
+}
+
+// MockRateLimitRepository is a mock of RateLimitRepository interface.
+type MockRateLimitRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimitRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRateLimitRepositoryMockRecorder is the mock recorder for MockRateLimitRepository.
+type MockRateLimitRepositoryMockRecorder struct {
+	mock *MockRateLimitRepository
+}
+
+// NewMockRateLimitRepository creates a new mock instance.
+func NewMockRateLimitRepository(ctrl *gomock.Controller) *MockRateLimitRepository {
+	mock := &MockRateLimitRepository{ctrl: ctrl}
+	mock.recorder = &MockRateLimitRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimitRepository) EXPECT() *MockRateLimitRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Take mocks base method.
+func (m *MockRateLimitRepository) Take(ctx context.Context, key string, limit model.RateLimit) (*model.RateLimitResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Take", ctx, key, limit)
+	ret0, _ := ret[0].(*model.RateLimitResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockRateLimitRepositoryMockRecorder) Take(ctx, key, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockRateLimitRepository)(nil).Take), ctx, key, limit)
+}
+
+// MockLoginAttemptsRepository is a mock of LoginAttemptsRepository interface.
+type MockLoginAttemptsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginAttemptsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLoginAttemptsRepositoryMockRecorder is the mock recorder for MockLoginAttemptsRepository.
+type MockLoginAttemptsRepositoryMockRecorder struct {
+	mock *MockLoginAttemptsRepository
+}
+
+// NewMockLoginAttemptsRepository creates a new mock instance.
+func NewMockLoginAttemptsRepository(ctrl *gomock.Controller) *MockLoginAttemptsRepository {
+	mock := &MockLoginAttemptsRepository{ctrl: ctrl}
+	mock.recorder = &MockLoginAttemptsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginAttemptsRepository) EXPECT() *MockLoginAttemptsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddFailure mocks base method.
+func (m *MockLoginAttemptsRepository) AddFailure(ctx context.Context, key string, threshold int, now time.Time, ttl time.Duration) (*model.LoginAttempts, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFailure", ctx, key, threshold, now, ttl)
+	ret0, _ := ret[0].(*model.LoginAttempts)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddFailure indicates an expected call of AddFailure.
+func (mr *MockLoginAttemptsRepositoryMockRecorder) AddFailure(ctx, key, threshold, now, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFailure", reflect.TypeOf((*MockLoginAttemptsRepository)(nil).AddFailure), ctx, key, threshold, now, ttl)
+}
+
+// Delete mocks base method.
+func (m *MockLoginAttemptsRepository) Delete(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLoginAttemptsRepositoryMockRecorder) Delete(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLoginAttemptsRepository)(nil).Delete), ctx, key)
+}
+
+// Get mocks base method.
+func (m *MockLoginAttemptsRepository) Get(ctx context.Context, key string) (*model.LoginAttempts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].(*model.LoginAttempts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockLoginAttemptsRepositoryMockRecorder) Get(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockLoginAttemptsRepository)(nil).Get), ctx, key)
+}
+
+// Lock mocks base method.
+func (m *MockLoginAttemptsRepository) Lock(ctx context.Context, key string, lockedUntil time.Time, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx, key, lockedUntil, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockLoginAttemptsRepositoryMockRecorder) Lock(ctx, key, lockedUntil, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockLoginAttemptsRepository)(nil).Lock), ctx, key, lockedUntil, ttl)
+}
+
+// MockAccessPolicyCache is a mock of AccessPolicyCache interface.
+type MockAccessPolicyCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessPolicyCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockAccessPolicyCacheMockRecorder is the mock recorder for MockAccessPolicyCache.
+type MockAccessPolicyCacheMockRecorder struct {
+	mock *MockAccessPolicyCache
+}
+
+// NewMockAccessPolicyCache creates a new mock instance.
+func NewMockAccessPolicyCache(ctrl *gomock.Controller) *MockAccessPolicyCache {
+	mock := &MockAccessPolicyCache{ctrl: ctrl}
+	mock.recorder = &MockAccessPolicyCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessPolicyCache) EXPECT() *MockAccessPolicyCacheMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockAccessPolicyCache) Get(ctx context.Context) (model.AccessPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].(model.AccessPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAccessPolicyCacheMockRecorder) Get(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAccessPolicyCache)(nil).Get), ctx)
+}
+
+// Set mocks base method.
+func (m *MockAccessPolicyCache) Set(ctx context.Context, policy model.AccessPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockAccessPolicyCacheMockRecorder) Set(ctx, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockAccessPolicyCache)(nil).Set), ctx, policy)
+}
+
+// MockSigningKeysRepository is a mock of SigningKeysRepository interface.
+type MockSigningKeysRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSigningKeysRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSigningKeysRepositoryMockRecorder is the mock recorder for MockSigningKeysRepository.
+type MockSigningKeysRepositoryMockRecorder struct {
+	mock *MockSigningKeysRepository
+}
+
+// NewMockSigningKeysRepository creates a new mock instance.
+func NewMockSigningKeysRepository(ctrl *gomock.Controller) *MockSigningKeysRepository {
+	mock := &MockSigningKeysRepository{ctrl: ctrl}
+	mock.recorder = &MockSigningKeysRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSigningKeysRepository) EXPECT() *MockSigningKeysRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSigningKeysRepository) Create(ctx context.Context, key *model.SigningKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSigningKeysRepositoryMockRecorder) Create(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSigningKeysRepository)(nil).Create), ctx, key)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockSigningKeysRepository) DeleteExpired(ctx context.Context, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", ctx, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockSigningKeysRepositoryMockRecorder) DeleteExpired(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockSigningKeysRepository)(nil).DeleteExpired), ctx, now)
+}
+
+// GetActive mocks base method.
+func (m *MockSigningKeysRepository) GetActive(ctx context.Context, now time.Time) ([]*model.SigningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActive", ctx, now)
+	ret0, _ := ret[0].([]*model.SigningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActive indicates an expected call of GetActive.
+func (mr *MockSigningKeysRepositoryMockRecorder) GetActive(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActive", reflect.TypeOf((*MockSigningKeysRepository)(nil).GetActive), ctx, now)
+}
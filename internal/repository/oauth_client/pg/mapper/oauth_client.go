@@ -0,0 +1,23 @@
+package mapper
+
+import (
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	modelRepo "github.com/Paul1k96/microservices_course_auth/internal/repository/oauth_client/pg/model"
+)
+
+// ToOAuthClientFromRepo converts OAuth client from repository model to service model.
+func ToOAuthClientFromRepo(client *modelRepo.OAuthClient) *model.OAuthClient {
+	var serviceClient model.OAuthClient
+
+	serviceClient.ID = client.ID
+	serviceClient.Name = client.Name
+	serviceClient.SecretHash = client.SecretHash
+	serviceClient.Scopes = client.Scopes
+	serviceClient.RedirectURIs = client.RedirectURIs
+	serviceClient.CreatedAt = client.CreatedAt
+	if client.RevokedAt.Valid {
+		serviceClient.RevokedAt = &client.RevokedAt.Time
+	}
+
+	return &serviceClient
+}
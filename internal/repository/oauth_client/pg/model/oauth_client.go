@@ -0,0 +1,17 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// OAuthClient represents repository OAuth client model.
+type OAuthClient struct {
+	ID           string       `db:"id"`
+	Name         string       `db:"name"`
+	SecretHash   string       `db:"secret_hash"`
+	Scopes       []string     `db:"scopes"`
+	RevokedAt    sql.NullTime `db:"revoked_at"`
+	CreatedAt    time.Time    `db:"created_at"`
+	RedirectURIs []string     `db:"redirect_uris"`
+}
@@ -0,0 +1,122 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/oauth_client/pg/mapper"
+	modelRepo "github.com/Paul1k96/microservices_course_auth/internal/repository/oauth_client/pg/model"
+	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/db"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+)
+
+const (
+	oauthClientTable = "oauth_clients"
+
+	idColumn           = "id"
+	nameColumn         = "name"
+	secretHashColumn   = "secret_hash"
+	scopesColumn       = "scopes"
+	redirectURIsColumn = "redirect_uris"
+	revokedAtColumn    = "revoked_at"
+	createdAtColumn    = "created_at"
+)
+
+// Repository represents OAuth client repository.
+type Repository struct {
+	db db.DB
+}
+
+// NewRepository creates a new instance of repository.OAuthClientsRepository.
+func NewRepository(pg db.DB) *Repository {
+	return &Repository{db: pg}
+}
+
+// Create OAuth client.
+func (r *Repository) Create(ctx context.Context, client *model.OAuthClient) error {
+	queryBuilder := sq.Insert(oauthClientTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(idColumn, nameColumn, secretHashColumn, scopesColumn, redirectURIsColumn, createdAtColumn).
+		Values(client.ID, client.Name, client.SecretHash, client.Scopes, client.RedirectURIs, client.CreatedAt)
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "oauth_client_repository.Create",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID returns OAuth client by its client ID.
+func (r *Repository) GetByID(ctx context.Context, id string) (*model.OAuthClient, error) {
+	queryBuilder := sq.Select("*").
+		PlaceholderFormat(sq.Dollar).
+		From(oauthClientTable).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "oauth_client_repository.GetByID",
+		QueryRaw: query,
+	}
+
+	var client modelRepo.OAuthClient
+	err = r.db.ScanOneContext(ctx, &client, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("get oauth client: %w", errs.ErrOAuthClientNotFound)
+		}
+
+		return nil, fmt.Errorf("get oauth client: %w", err)
+	}
+
+	return mapper.ToOAuthClientFromRepo(&client), nil
+}
+
+// Revoke revokes the not yet revoked OAuth client.
+func (r *Repository) Revoke(ctx context.Context, id string, revokedAt time.Time) error {
+	queryBuilder := sq.Update(oauthClientTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(revokedAtColumn, revokedAt).
+		Where(sq.Eq{idColumn: id, revokedAtColumn: nil})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "oauth_client_repository.Revoke",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("revoke oauth client: %w", errs.ErrOAuthClientNotFound)
+	}
+
+	return nil
+}
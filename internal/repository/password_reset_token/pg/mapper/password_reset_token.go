@@ -0,0 +1,22 @@
+package mapper
+
+import (
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	modelRepo "github.com/Paul1k96/microservices_course_auth/internal/repository/password_reset_token/pg/model"
+)
+
+// ToPasswordResetTokenFromRepo converts password reset token from repository model to service model.
+func ToPasswordResetTokenFromRepo(token *modelRepo.PasswordResetToken) *model.PasswordResetToken {
+	var serviceToken model.PasswordResetToken
+
+	serviceToken.ID = token.ID
+	serviceToken.UserID = token.UserID
+	serviceToken.TokenHash = token.TokenHash
+	serviceToken.ExpiresAt = token.ExpiresAt
+	serviceToken.CreatedAt = token.CreatedAt
+	if token.UsedAt.Valid {
+		serviceToken.UsedAt = &token.UsedAt.Time
+	}
+
+	return &serviceToken
+}
@@ -0,0 +1,18 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PasswordResetToken represents repository password reset token model.
+type PasswordResetToken struct {
+	ID        uuid.UUID    `db:"id"`
+	UserID    int64        `db:"user_id"`
+	TokenHash string       `db:"token_hash"`
+	ExpiresAt time.Time    `db:"expires_at"`
+	UsedAt    sql.NullTime `db:"used_at"`
+	CreatedAt time.Time    `db:"created_at"`
+}
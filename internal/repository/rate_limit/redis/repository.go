@@ -0,0 +1,87 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/gomodule/redigo/redis"
+)
+
+const keyPrefix = "rate_limit:"
+
+// takeScript refills the bucket for the time passed since its last update and takes a token from it.
+// Redis server time is used, so instances with skewed clocks share buckets consistently.
+// It returns whether the token was taken and otherwise the milliseconds until the next one is added.
+var takeScript = redis.NewScript(1, `
+local capacity = tonumber(ARGV[1])
+local period = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+local rate = capacity / period
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'updated_at')
+local tokens = tonumber(bucket[1])
+local updated_at = tonumber(bucket[2])
+if tokens == nil or updated_at == nil then
+	tokens = capacity
+	updated_at = now
+end
+
+tokens = math.min(capacity, tokens + math.max(0, now - updated_at) * rate)
+
+local allowed = 0
+local retry_after = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry_after = math.ceil((1 - tokens) / rate)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'updated_at', now)
+redis.call('PEXPIRE', KEYS[1], period)
+
+return {allowed, retry_after}
+`)
+
+// Repository represents rate limit token buckets repository.
+// cache.RedisClient cannot run scripts, so buckets are kept through the pool it is built on.
+type Repository struct {
+	pool *redis.Pool
+}
+
+// NewRepository creates a new instance of repository.RateLimitRepository.
+func NewRepository(pool *redis.Pool) *Repository {
+	return &Repository{pool: pool}
+}
+
+// Take atomically takes a token from the bucket by key refilled according to limit.
+func (r *Repository) Take(ctx context.Context, key string, limit model.RateLimit) (*model.RateLimitResult, error) {
+	conn, err := r.pool.GetContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get redis connection: %w", err)
+	}
+	defer conn.Close()
+
+	values, err := redis.Int64s(takeScript.DoContext(
+		ctx,
+		conn,
+		keyPrefix+key,
+		limit.Requests,
+		limit.Period.Milliseconds(),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("take token: %w", err)
+	}
+
+	if len(values) != 2 {
+		return nil, fmt.Errorf("take token: unexpected reply %v", values)
+	}
+
+	return &model.RateLimitResult{
+		Allowed:    values[0] == 1,
+		RetryAfter: time.Duration(values[1]) * time.Millisecond,
+	}, nil
+}
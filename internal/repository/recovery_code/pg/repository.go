@@ -0,0 +1,123 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/db"
+	"github.com/google/uuid"
+)
+
+const (
+	recoveryCodeTable = "recovery_codes"
+
+	idColumn        = "id"
+	userIDColumn    = "user_id"
+	codeHashColumn  = "code_hash"
+	usedAtColumn    = "used_at"
+	createdAtColumn = "created_at"
+)
+
+// Repository represents recovery code repository.
+type Repository struct {
+	db db.DB
+}
+
+// NewRepository creates a new instance of repository.RecoveryCodesRepository.
+func NewRepository(pg db.DB) *Repository {
+	return &Repository{db: pg}
+}
+
+// Replace removes every recovery code of the user and stores the new ones.
+func (r *Repository) Replace(ctx context.Context, userID int64, codeHashes []string) error {
+	err := r.DeleteByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if len(codeHashes) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	queryBuilder := sq.Insert(recoveryCodeTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(idColumn, userIDColumn, codeHashColumn, createdAtColumn)
+
+	for _, codeHash := range codeHashes {
+		queryBuilder = queryBuilder.Values(uuid.New(), userID, codeHash, now)
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "recovery_code_repository.Replace",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	return nil
+}
+
+// MarkUsed marks the unused recovery code of the user as used.
+func (r *Repository) MarkUsed(ctx context.Context, userID int64, codeHash string, usedAt time.Time) error {
+	queryBuilder := sq.Update(recoveryCodeTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(usedAtColumn, usedAt).
+		Where(sq.Eq{userIDColumn: userID, codeHashColumn: codeHash, usedAtColumn: nil})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "recovery_code_repository.MarkUsed",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("mark recovery code used: %w", errs.ErrRecoveryCodeNotFound)
+	}
+
+	return nil
+}
+
+// DeleteByUserID removes every recovery code of the user.
+func (r *Repository) DeleteByUserID(ctx context.Context, userID int64) error {
+	queryBuilder := sq.Delete(recoveryCodeTable).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{userIDColumn: userID})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "recovery_code_repository.DeleteByUserID",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package mapper
+
+import (
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	modelRepo "github.com/Paul1k96/microservices_course_auth/internal/repository/refresh_token/pg/model"
+)
+
+// ToRefreshTokenFromRepo converts refresh token from repository model to service model.
+func ToRefreshTokenFromRepo(token *modelRepo.RefreshToken) *model.RefreshToken {
+	var serviceToken model.RefreshToken
+
+	serviceToken.ID = token.ID
+	serviceToken.FamilyID = token.FamilyID
+	serviceToken.UserID = token.UserID
+	serviceToken.TokenHash = token.TokenHash
+	serviceToken.ExpiresAt = token.ExpiresAt
+	serviceToken.CreatedAt = token.CreatedAt
+	if token.RotatedAt.Valid {
+		serviceToken.RotatedAt = &token.RotatedAt.Time
+	}
+	if token.RevokedAt.Valid {
+		serviceToken.RevokedAt = &token.RevokedAt.Time
+	}
+	serviceToken.AccessTokenID = token.AccessTokenID.String
+	serviceToken.AccessTokenExpiresAt = token.AccessTokenExpiresAt.Time
+
+	return &serviceToken
+}
+
+// ToRefreshTokensFromRepo converts refresh tokens from repository model to service model.
+func ToRefreshTokensFromRepo(tokens []*modelRepo.RefreshToken) []*model.RefreshToken {
+	res := make([]*model.RefreshToken, 0, len(tokens))
+	for _, token := range tokens {
+		res = append(res, ToRefreshTokenFromRepo(token))
+	}
+
+	return res
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RefreshToken represents repository refresh token model.
+type RefreshToken struct {
+	ID                   uuid.UUID      `db:"id"`
+	FamilyID             uuid.UUID      `db:"family_id"`
+	UserID               int64          `db:"user_id"`
+	TokenHash            string         `db:"token_hash"`
+	ExpiresAt            time.Time      `db:"expires_at"`
+	RotatedAt            sql.NullTime   `db:"rotated_at"`
+	RevokedAt            sql.NullTime   `db:"revoked_at"`
+	CreatedAt            time.Time      `db:"created_at"`
+	AccessTokenID        sql.NullString `db:"access_token_id"`
+	AccessTokenExpiresAt sql.NullTime   `db:"access_token_expires_at"`
+}
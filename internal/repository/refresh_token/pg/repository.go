@@ -0,0 +1,283 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/refresh_token/pg/mapper"
+	modelRepo "github.com/Paul1k96/microservices_course_auth/internal/repository/refresh_token/pg/model"
+	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+)
+
+const (
+	refreshTokenTable = "refresh_tokens"
+
+	idColumn        = "id"
+	familyIDColumn  = "family_id"
+	userIDColumn    = "user_id"
+	tokenHashColumn = "token_hash"
+	expiresAtColumn = "expires_at"
+	rotatedAtColumn = "rotated_at"
+	revokedAtColumn = "revoked_at"
+	createdAtColumn = "created_at"
+
+	accessTokenIDColumn        = "access_token_id"
+	accessTokenExpiresAtColumn = "access_token_expires_at"
+)
+
+// Repository represents refresh token repository.
+type Repository struct {
+	db db.DB
+}
+
+// NewRepository creates a new instance of repository.RefreshTokensRepository.
+func NewRepository(pg db.DB) *Repository {
+	return &Repository{db: pg}
+}
+
+// Create refresh token.
+func (r *Repository) Create(ctx context.Context, token *model.RefreshToken) error {
+	queryBuilder := sq.Insert(refreshTokenTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(
+			idColumn,
+			familyIDColumn,
+			userIDColumn,
+			tokenHashColumn,
+			expiresAtColumn,
+			createdAtColumn,
+			accessTokenIDColumn,
+			accessTokenExpiresAtColumn,
+		).
+		Values(
+			token.ID,
+			token.FamilyID,
+			token.UserID,
+			token.TokenHash,
+			token.ExpiresAt,
+			token.CreatedAt,
+			token.AccessTokenID,
+			token.AccessTokenExpiresAt,
+		)
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "refresh_token_repository.Create",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	return nil
+}
+
+// GetByHashForUpdate returns refresh token by its hash and locks the row
+// until the end of the current transaction.
+func (r *Repository) GetByHashForUpdate(ctx context.Context, tokenHash string) (*model.RefreshToken, error) {
+	queryBuilder := sq.Select("*").
+		PlaceholderFormat(sq.Dollar).
+		From(refreshTokenTable).
+		Where(sq.Eq{tokenHashColumn: tokenHash}).
+		Suffix("FOR UPDATE")
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "refresh_token_repository.GetByHashForUpdate",
+		QueryRaw: query,
+	}
+
+	var token modelRepo.RefreshToken
+	err = r.db.ScanOneContext(ctx, &token, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("get refresh token: %w", errs.ErrRefreshTokenNotFound)
+		}
+
+		return nil, fmt.Errorf("get refresh token: %w", err)
+	}
+
+	return mapper.ToRefreshTokenFromRepo(&token), nil
+}
+
+// GetByFamilyID returns refresh tokens of the family whose access tokens expire after accessExpiresAfter.
+func (r *Repository) GetByFamilyID(
+	ctx context.Context,
+	familyID uuid.UUID,
+	accessExpiresAfter time.Time,
+) ([]*model.RefreshToken, error) {
+	return r.getWithLiveAccessToken(
+		ctx,
+		"refresh_token_repository.GetByFamilyID",
+		sq.Eq{familyIDColumn: familyID},
+		accessExpiresAfter,
+	)
+}
+
+// GetByUserID returns refresh tokens of the user whose access tokens expire after accessExpiresAfter.
+func (r *Repository) GetByUserID(
+	ctx context.Context,
+	userID int64,
+	accessExpiresAfter time.Time,
+) ([]*model.RefreshToken, error) {
+	return r.getWithLiveAccessToken(
+		ctx,
+		"refresh_token_repository.GetByUserID",
+		sq.Eq{userIDColumn: userID},
+		accessExpiresAfter,
+	)
+}
+
+func (r *Repository) getWithLiveAccessToken(
+	ctx context.Context,
+	name string,
+	where sq.Eq,
+	accessExpiresAfter time.Time,
+) ([]*model.RefreshToken, error) {
+	queryBuilder := sq.Select("*").
+		PlaceholderFormat(sq.Dollar).
+		From(refreshTokenTable).
+		Where(where).
+		Where(sq.Gt{accessTokenExpiresAtColumn: accessExpiresAfter})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     name,
+		QueryRaw: query,
+	}
+
+	var tokens []*modelRepo.RefreshToken
+	err = r.db.ScanAllContext(ctx, &tokens, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("get refresh tokens: %w", err)
+	}
+
+	return mapper.ToRefreshTokensFromRepo(tokens), nil
+}
+
+// MarkRotated marks refresh token as exchanged for a new one.
+func (r *Repository) MarkRotated(ctx context.Context, id uuid.UUID, rotatedAt time.Time) error {
+	queryBuilder := sq.Update(refreshTokenTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(rotatedAtColumn, rotatedAt).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "refresh_token_repository.MarkRotated",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeFamily revokes all not yet revoked refresh tokens of the family.
+func (r *Repository) RevokeFamily(ctx context.Context, familyID uuid.UUID, revokedAt time.Time) error {
+	queryBuilder := sq.Update(refreshTokenTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(revokedAtColumn, revokedAt).
+		Where(sq.Eq{familyIDColumn: familyID, revokedAtColumn: nil})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "refresh_token_repository.RevokeFamily",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeByUserID revokes all not yet revoked refresh tokens of the user.
+func (r *Repository) RevokeByUserID(ctx context.Context, userID int64, revokedAt time.Time) error {
+	queryBuilder := sq.Update(refreshTokenTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(revokedAtColumn, revokedAt).
+		Where(sq.Eq{userIDColumn: userID, revokedAtColumn: nil})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "refresh_token_repository.RevokeByUserID",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeByUserIDExceptFamily revokes every active refresh token of the user except tokens of the family.
+func (r *Repository) RevokeByUserIDExceptFamily(
+	ctx context.Context,
+	userID int64,
+	familyID uuid.UUID,
+	revokedAt time.Time,
+) error {
+	queryBuilder := sq.Update(refreshTokenTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(revokedAtColumn, revokedAt).
+		Where(sq.Eq{userIDColumn: userID, revokedAtColumn: nil}).
+		Where(sq.NotEq{familyIDColumn: familyID})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "refresh_token_repository.RevokeByUserIDExceptFamily",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	return nil
+}
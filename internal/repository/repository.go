@@ -2,8 +2,10 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/google/uuid"
 )
 
 //go:generate ../../bin/mockgen -source $GOFILE -destination "mocks/repository.go" -package "mocks"
@@ -12,15 +14,22 @@ import (
 type UsersRepository interface {
 	Create(ctx context.Context, user *model.User) (int64, error)
 	GetByID(ctx context.Context, id int64) (*model.User, error)
-	GetByIDs(ctx context.Context, ids []int64) ([]*model.User, error)
+	GetByIDInOrg(ctx context.Context, orgID, id int64) (*model.User, error)
+	GetByIDsInOrg(ctx context.Context, orgID int64, ids []int64) ([]*model.User, error)
+	Search(ctx context.Context, filter *model.UserFilter) ([]*model.User, error)
+	GetByEmail(ctx context.Context, orgID int64, email string) (*model.User, error)
 	Update(ctx context.Context, user *model.User) error
+	UpdatePassword(ctx context.Context, id int64, passwordHash string) error
+	MarkEmailVerified(ctx context.Context, id int64, email string, verifiedAt time.Time) error
+	UpdateTOTP(ctx context.Context, user *model.User) error
 	Delete(ctx context.Context, id int64) error
+	AddMember(ctx context.Context, orgID, userID int64) error
 }
 
 // UsersCache represents user cache repository.
 type UsersCache interface {
-	Set(ctx context.Context, user *model.User) error
-	Get(ctx context.Context, id int64) (*model.User, error)
+	Set(ctx context.Context, orgID int64, user *model.User) error
+	Get(ctx context.Context, orgID, id int64) (*model.User, error)
 	Delete(ctx context.Context, id int64) error
 }
 
@@ -28,3 +37,129 @@ type UsersCache interface {
 type UserEventsRepository interface {
 	Save(ctx context.Context, event *model.UserEvent) error
 }
+
+// RefreshTokensRepository represents refresh tokens repository.
+type RefreshTokensRepository interface {
+	Create(ctx context.Context, token *model.RefreshToken) error
+	GetByHashForUpdate(ctx context.Context, tokenHash string) (*model.RefreshToken, error)
+	GetByFamilyID(ctx context.Context, familyID uuid.UUID, accessExpiresAfter time.Time) ([]*model.RefreshToken, error)
+	GetByUserID(ctx context.Context, userID int64, accessExpiresAfter time.Time) ([]*model.RefreshToken, error)
+	MarkRotated(ctx context.Context, id uuid.UUID, rotatedAt time.Time) error
+	RevokeFamily(ctx context.Context, familyID uuid.UUID, revokedAt time.Time) error
+	RevokeByUserID(ctx context.Context, userID int64, revokedAt time.Time) error
+	RevokeByUserIDExceptFamily(ctx context.Context, userID int64, familyID uuid.UUID, revokedAt time.Time) error
+}
+
+// SessionsRepository represents user sessions repository, one session per refresh token family.
+type SessionsRepository interface {
+	Save(ctx context.Context, session *model.Session) error
+	GetByID(ctx context.Context, id uuid.UUID) (*model.Session, error)
+	GetActiveByUserID(ctx context.Context, userID int64, now time.Time) ([]*model.Session, error)
+	Revoke(ctx context.Context, id uuid.UUID, revokedAt time.Time) error
+	RevokeByUserID(ctx context.Context, userID int64, revokedAt time.Time) error
+	RevokeByUserIDExcept(ctx context.Context, userID int64, id uuid.UUID, revokedAt time.Time) error
+}
+
+// RolesRepository represents roles and permissions repository.
+type RolesRepository interface {
+	HasPermission(ctx context.Context, userID, orgID int64, permission string) (bool, error)
+	ListPermissions(ctx context.Context, userID, orgID int64) ([]string, error)
+	Create(ctx context.Context, role *model.Role) (int64, error)
+	GetByID(ctx context.Context, orgID, id int64) (*model.Role, error)
+	List(ctx context.Context, orgID int64) ([]*model.Role, error)
+	Update(ctx context.Context, role *model.Role) error
+	Delete(ctx context.Context, orgID, id int64) error
+	Assign(ctx context.Context, orgID, userID, roleID int64) error
+	Unassign(ctx context.Context, orgID, userID, roleID int64) error
+	SetMemberRole(ctx context.Context, orgID, userID, roleID int64) error
+	CreatePermissions(ctx context.Context, permissions []string) error
+	GrantByName(ctx context.Context, roleName string, permissions []string) error
+}
+
+// PasswordResetTokensRepository represents password reset tokens repository.
+type PasswordResetTokensRepository interface {
+	Create(ctx context.Context, token *model.PasswordResetToken) error
+	GetByHashForUpdate(ctx context.Context, tokenHash string) (*model.PasswordResetToken, error)
+	MarkUsedByUserID(ctx context.Context, userID int64, usedAt time.Time) error
+}
+
+// EmailVerificationTokensRepository represents email verification tokens repository.
+type EmailVerificationTokensRepository interface {
+	Create(ctx context.Context, token *model.EmailVerificationToken) error
+	GetByHashForUpdate(ctx context.Context, tokenHash string) (*model.EmailVerificationToken, error)
+	MarkUsedByUserID(ctx context.Context, userID int64, usedAt time.Time) error
+}
+
+// RecoveryCodesRepository represents MFA recovery codes repository.
+type RecoveryCodesRepository interface {
+	Replace(ctx context.Context, userID int64, codeHashes []string) error
+	MarkUsed(ctx context.Context, userID int64, codeHash string, usedAt time.Time) error
+	DeleteByUserID(ctx context.Context, userID int64) error
+}
+
+// MFAChallengesRepository represents pending second login step repository.
+type MFAChallengesRepository interface {
+	Set(ctx context.Context, tokenHash string, challenge *model.MFAChallenge, ttl time.Duration) error
+	TakeAttempt(ctx context.Context, tokenHash string, maxAttempts int) (*model.MFAChallenge, error)
+	Delete(ctx context.Context, tokenHash string) error
+}
+
+// APIKeysRepository represents personal API keys repository.
+type APIKeysRepository interface {
+	Create(ctx context.Context, key *model.APIKey) error
+	GetByPrefix(ctx context.Context, prefix string) (*model.APIKey, error)
+	GetByUserID(ctx context.Context, userID int64) ([]*model.APIKey, error)
+	Revoke(ctx context.Context, userID int64, id uuid.UUID, revokedAt time.Time) error
+	Touch(ctx context.Context, id uuid.UUID, usedAt time.Time, ip string) error
+}
+
+// OAuthClientsRepository represents registered OAuth clients repository.
+type OAuthClientsRepository interface {
+	Create(ctx context.Context, client *model.OAuthClient) error
+	GetByID(ctx context.Context, id string) (*model.OAuthClient, error)
+	Revoke(ctx context.Context, id string, revokedAt time.Time) error
+}
+
+// AuthorizationCodesRepository represents pending OpenID Connect authorizations repository.
+type AuthorizationCodesRepository interface {
+	Set(ctx context.Context, codeHash string, code *model.AuthorizationCode, ttl time.Duration) error
+	Redeem(ctx context.Context, codeHash string) (*model.AuthorizationCode, error)
+}
+
+// AccessTokenDenylist represents revoked access tokens repository.
+type AccessTokenDenylist interface {
+	Add(ctx context.Context, tokenID string, ttl time.Duration) error
+	Contains(ctx context.Context, tokenID string) (bool, error)
+}
+
+// RateLimitRepository represents rate limit token buckets repository.
+type RateLimitRepository interface {
+	Take(ctx context.Context, key string, limit model.RateLimit) (*model.RateLimitResult, error)
+}
+
+// LoginAttemptsRepository represents failed login attempts repository.
+type LoginAttemptsRepository interface {
+	Get(ctx context.Context, key string) (*model.LoginAttempts, error)
+	AddFailure(
+		ctx context.Context,
+		key string,
+		threshold int,
+		now time.Time,
+		ttl time.Duration,
+	) (*model.LoginAttempts, bool, error)
+	Lock(ctx context.Context, key string, lockedUntil time.Time, ttl time.Duration) error
+	Delete(ctx context.Context, key string) error
+}
+
+// AccessPolicyCache represents access policy cache repository.
+type AccessPolicyCache interface {
+	Set(ctx context.Context, policy model.AccessPolicy) error
+	Get(ctx context.Context) (model.AccessPolicy, error)
+}
+
+// SigningKeysRepository represents token signing keys repository.
+type SigningKeysRepository interface {
+	Create(ctx context.Context, key *model.SigningKey) error
+	GetActive(ctx context.Context, now time.Time) ([]*model.SigningKey, error)
+	DeleteExpired(ctx context.Context, now time.Time) error
+}
@@ -0,0 +1,34 @@
+package mapper
+
+import (
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	modelRepo "github.com/Paul1k96/microservices_course_auth/internal/repository/role/pg/model"
+)
+
+// ToRoleFromRepo converts role from repository model to service model.
+func ToRoleFromRepo(role *modelRepo.Role) *model.Role {
+	res := &model.Role{
+		ID:          role.ID,
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+		BuiltIn:     role.BuiltIn,
+		CreatedAt:   role.CreatedAt,
+	}
+
+	if role.OrgID != nil {
+		res.OrgID = *role.OrgID
+	}
+
+	return res
+}
+
+// ToRolesFromRepo converts roles from repository model to service model.
+func ToRolesFromRepo(roles []*modelRepo.Role) []*model.Role {
+	res := make([]*model.Role, 0, len(roles))
+	for _, role := range roles {
+		res = append(res, ToRoleFromRepo(role))
+	}
+
+	return res
+}
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// Role represents repository role model.
+type Role struct {
+	ID          int64     `db:"id"`
+	OrgID       *int64    `db:"org_id"`
+	Name        string    `db:"name"`
+	Description string    `db:"description"`
+	BuiltIn     bool      `db:"built_in"`
+	CreatedAt   time.Time `db:"created_at"`
+	Permissions []string  `db:"permissions"`
+}
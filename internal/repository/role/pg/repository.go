@@ -0,0 +1,496 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/role/pg/mapper"
+	modelRepo "github.com/Paul1k96/microservices_course_auth/internal/repository/role/pg/model"
+	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/db"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+)
+
+const (
+	roleTable = "roles"
+
+	idColumn          = "id"
+	nameColumn        = "name"
+	descriptionColumn = "description"
+	builtInColumn     = "built_in"
+	createdAtColumn   = "created_at"
+
+	// Columns of the user_roles, role_permissions and org_members tables referencing other tables.
+	orgIDColumn        = "org_id"
+	userIDColumn       = "user_id"
+	roleIDColumn       = "role_id"
+	permissionIDColumn = "permission_id"
+
+	userRoleTable        = "user_roles"
+	userRoleOrgIDColumn  = "user_roles.org_id"
+	userRoleUserIDColumn = "user_roles.user_id"
+	userRoleRoleIDColumn = "user_roles.role_id"
+
+	orgMemberTable = "org_members"
+
+	// userRolesQuery selects ids of the roles granted to the user in the organization and of their role in it.
+	// Granted roles apply only while the user is a member of the organization.
+	userRolesQuery = "SELECT role_id FROM user_roles WHERE user_id = ? AND org_id = ? " +
+		"AND EXISTS (SELECT 1 FROM org_members WHERE user_id = ? AND org_id = ?) " +
+		"UNION SELECT role_id FROM org_members WHERE user_id = ? AND org_id = ? AND role_id IS NOT NULL"
+
+	rolePermissionTable              = "role_permissions"
+	rolePermissionRoleIDColumn       = "role_permissions.role_id"
+	rolePermissionPermissionIDColumn = "role_permissions.permission_id"
+
+	permissionTable         = "permissions"
+	permissionTableIDColumn = "permissions.id"
+	permissionNameColumn    = "permissions.name"
+
+	// visibleInOrgCondition filters roles of the organization and built-in roles shared by all organizations.
+	visibleInOrgCondition = "(org_id = ? OR org_id IS NULL)"
+
+	// permissionsColumn aggregates names of the role permissions into the permissions column.
+	permissionsColumn = "ARRAY(SELECT permissions.name FROM role_permissions " +
+		"JOIN permissions ON permissions.id = role_permissions.permission_id " +
+		"WHERE role_permissions.role_id = roles.id ORDER BY permissions.name) AS permissions"
+)
+
+// Repository represents roles repository.
+type Repository struct {
+	db db.DB
+}
+
+// NewRepository creates a new instance of repository.RolesRepository.
+func NewRepository(pg db.DB) *Repository {
+	return &Repository{db: pg}
+}
+
+// HasPermission reports whether any role granted to the user in the organization, or their role in it,
+// grants permission.
+func (r *Repository) HasPermission(ctx context.Context, userID, orgID int64, permission string) (bool, error) {
+	queryBuilder := sq.Select("1").
+		PlaceholderFormat(sq.Dollar).
+		From(rolePermissionTable).
+		Join(fmt.Sprintf("%s ON %s = %s", permissionTable, permissionTableIDColumn, rolePermissionPermissionIDColumn)).
+		Where(sq.Eq{permissionNameColumn: permission}).
+		Where(fmt.Sprintf("%s IN (%s)", rolePermissionRoleIDColumn, userRolesQuery), userID, orgID, userID, orgID, userID, orgID).
+		Prefix("SELECT EXISTS (").
+		Suffix(")")
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.HasPermission",
+		QueryRaw: query,
+	}
+
+	var granted bool
+	err = r.db.QueryRowContext(ctx, q, args...).Scan(&granted)
+	if err != nil {
+		return false, fmt.Errorf("check permission: %w", err)
+	}
+
+	return granted, nil
+}
+
+// ListPermissions returns names of the permissions granted to the user in the organization by any of their roles
+// there, or by their role in it, ordered by name.
+func (r *Repository) ListPermissions(ctx context.Context, userID, orgID int64) ([]string, error) {
+	queryBuilder := sq.Select(permissionNameColumn).
+		Distinct().
+		PlaceholderFormat(sq.Dollar).
+		From(rolePermissionTable).
+		Join(fmt.Sprintf("%s ON %s = %s", permissionTable, permissionTableIDColumn, rolePermissionPermissionIDColumn)).
+		Where(fmt.Sprintf("%s IN (%s)", rolePermissionRoleIDColumn, userRolesQuery), userID, orgID, userID, orgID, userID, orgID).
+		OrderBy(permissionNameColumn)
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.ListPermissions",
+		QueryRaw: query,
+	}
+
+	var permissions []string
+	err = r.db.ScanAllContext(ctx, &permissions, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("scan permissions: %w", err)
+	}
+
+	return permissions, nil
+}
+
+// Create custom role of role.OrgID and grant it role.Permissions, permissions which do not exist yet are created.
+// It should be called in a transaction.
+func (r *Repository) Create(ctx context.Context, role *model.Role) (int64, error) {
+	queryBuilder := sq.Insert(roleTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(orgIDColumn, nameColumn, descriptionColumn, createdAtColumn).
+		Values(role.OrgID, role.Name, role.Description, role.CreatedAt).
+		Suffix("ON CONFLICT (org_id, name) DO NOTHING RETURNING id")
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.Create",
+		QueryRaw: query,
+	}
+
+	var id int64
+	err = r.db.QueryRowContext(ctx, q, args...).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, fmt.Errorf("create role %s: %w", role.Name, errs.ErrRoleAlreadyExists)
+		}
+
+		return 0, fmt.Errorf("create role: %w", err)
+	}
+
+	err = r.grantPermissions(ctx, id, role.Permissions)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// GetByID returns role by id if it is a role of the organization or a built-in one.
+func (r *Repository) GetByID(ctx context.Context, orgID, id int64) (*model.Role, error) {
+	queryBuilder := sq.Select("*", permissionsColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(roleTable).
+		Where(sq.Eq{idColumn: id}).
+		Where(visibleInOrgCondition, orgID)
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.GetByID",
+		QueryRaw: query,
+	}
+
+	var role modelRepo.Role
+	err = r.db.ScanOneContext(ctx, &role, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("get role: %w", errs.ErrRoleNotFound)
+		}
+
+		return nil, fmt.Errorf("get role: %w", err)
+	}
+
+	return mapper.ToRoleFromRepo(&role), nil
+}
+
+// List returns roles of the organization and built-in roles ordered by id.
+func (r *Repository) List(ctx context.Context, orgID int64) ([]*model.Role, error) {
+	queryBuilder := sq.Select("*", permissionsColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(roleTable).
+		Where(visibleInOrgCondition, orgID).
+		OrderBy(idColumn)
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.List",
+		QueryRaw: query,
+	}
+
+	var roles []*modelRepo.Role
+	err = r.db.ScanAllContext(ctx, &roles, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("scan roles: %w", err)
+	}
+
+	return mapper.ToRolesFromRepo(roles), nil
+}
+
+// Update sets description of the custom role of role.OrgID and replaces its permissions with role.Permissions.
+// It should be called in a transaction.
+func (r *Repository) Update(ctx context.Context, role *model.Role) error {
+	queryBuilder := sq.Update(roleTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(descriptionColumn, role.Description).
+		Where(sq.Eq{idColumn: role.ID, orgIDColumn: role.OrgID})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.Update",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("update role: %w", errs.ErrRoleNotFound)
+	}
+
+	err = r.revokePermissions(ctx, role.ID)
+	if err != nil {
+		return err
+	}
+
+	return r.grantPermissions(ctx, role.ID, role.Permissions)
+}
+
+// Delete deletes the custom role of the organization, it is unassigned from all users.
+func (r *Repository) Delete(ctx context.Context, orgID, id int64) error {
+	queryBuilder := sq.Delete(roleTable).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: id, orgIDColumn: orgID, builtInColumn: false})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.Delete",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("delete role: %w", errs.ErrRoleNotFound)
+	}
+
+	return nil
+}
+
+// Assign assigns the role to the user in the organization, assigning an already held role does nothing.
+func (r *Repository) Assign(ctx context.Context, orgID, userID, roleID int64) error {
+	queryBuilder := sq.Insert(userRoleTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(orgIDColumn, userIDColumn, roleIDColumn).
+		Values(orgID, userID, roleID).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.Assign",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	return nil
+}
+
+// Unassign unassigns the role from the user in the organization.
+func (r *Repository) Unassign(ctx context.Context, orgID, userID, roleID int64) error {
+	queryBuilder := sq.Delete(userRoleTable).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{userRoleOrgIDColumn: orgID, userRoleUserIDColumn: userID, userRoleRoleIDColumn: roleID})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.Unassign",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("unassign role %d from user %d in organization %d: %w", roleID, userID, orgID, errs.ErrRoleNotFound)
+	}
+
+	return nil
+}
+
+// SetMemberRole sets the role the user holds in the organization they are a member of.
+func (r *Repository) SetMemberRole(ctx context.Context, orgID, userID, roleID int64) error {
+	queryBuilder := sq.Update(orgMemberTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(roleIDColumn, roleID).
+		Where(sq.Eq{orgIDColumn: orgID, userIDColumn: userID})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.SetMemberRole",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("set role of user %d in organization %d: %w", userID, orgID, errs.ErrUserNotFound)
+	}
+
+	return nil
+}
+
+// CreatePermissions creates permissions which do not exist yet.
+func (r *Repository) CreatePermissions(ctx context.Context, permissions []string) error {
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	insertBuilder := sq.Insert(permissionTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(nameColumn).
+		Suffix("ON CONFLICT (name) DO NOTHING")
+	for _, permission := range permissions {
+		insertBuilder = insertBuilder.Values(permission)
+	}
+
+	query, args, err := insertBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.CreatePermissions",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("create permissions: %w", err)
+	}
+
+	return nil
+}
+
+// GrantByName grants the built-in role named roleName permissions, permissions which do not exist yet are created.
+// It should be called in a transaction.
+func (r *Repository) GrantByName(ctx context.Context, roleName string, permissions []string) error {
+	queryBuilder := sq.Select(idColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(roleTable).
+		Where(sq.Eq{nameColumn: roleName, orgIDColumn: nil})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.GetIDByName",
+		QueryRaw: query,
+	}
+
+	var id int64
+	err = r.db.QueryRowContext(ctx, q, args...).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("get role %s: %w", roleName, errs.ErrRoleNotFound)
+		}
+
+		return fmt.Errorf("get role: %w", err)
+	}
+
+	return r.grantPermissions(ctx, id, permissions)
+}
+
+func (r *Repository) grantPermissions(ctx context.Context, roleID int64, permissions []string) error {
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	err := r.CreatePermissions(ctx, permissions)
+	if err != nil {
+		return err
+	}
+
+	grantBuilder := sq.Insert(rolePermissionTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(roleIDColumn, permissionIDColumn).
+		Select(sq.Select().
+			Column("?::int", roleID).
+			Column(permissionTableIDColumn).
+			From(permissionTable).
+			Where(sq.Eq{permissionNameColumn: permissions})).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	query, args, err := grantBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.GrantPermissions",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("grant permissions: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) revokePermissions(ctx context.Context, roleID int64) error {
+	queryBuilder := sq.Delete(rolePermissionTable).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{rolePermissionRoleIDColumn: roleID})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.RevokePermissions",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("revoke permissions: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package mapper
+
+import (
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	modelRepo "github.com/Paul1k96/microservices_course_auth/internal/repository/session/pg/model"
+)
+
+// ToSessionFromRepo converts session from repository model to service model.
+func ToSessionFromRepo(session *modelRepo.Session) *model.Session {
+	var serviceSession model.Session
+
+	serviceSession.ID = session.ID
+	serviceSession.UserID = session.UserID
+	serviceSession.Device = session.Device
+	serviceSession.UserAgent = session.UserAgent
+	serviceSession.IP = session.IP
+	serviceSession.ExpiresAt = session.ExpiresAt
+	serviceSession.LastSeenAt = session.LastSeenAt
+	serviceSession.CreatedAt = session.CreatedAt
+	serviceSession.MFA = session.MFA
+	if session.RevokedAt.Valid {
+		serviceSession.RevokedAt = &session.RevokedAt.Time
+	}
+
+	return &serviceSession
+}
+
+// ToSessionsFromRepo converts sessions from repository model to service model.
+func ToSessionsFromRepo(sessions []*modelRepo.Session) []*model.Session {
+	res := make([]*model.Session, 0, len(sessions))
+	for _, session := range sessions {
+		res = append(res, ToSessionFromRepo(session))
+	}
+
+	return res
+}
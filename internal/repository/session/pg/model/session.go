@@ -0,0 +1,22 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session represents repository session model.
+type Session struct {
+	ID         uuid.UUID    `db:"id"`
+	UserID     int64        `db:"user_id"`
+	Device     string       `db:"device"`
+	UserAgent  string       `db:"user_agent"`
+	IP         string       `db:"ip"`
+	ExpiresAt  time.Time    `db:"expires_at"`
+	LastSeenAt time.Time    `db:"last_seen_at"`
+	RevokedAt  sql.NullTime `db:"revoked_at"`
+	CreatedAt  time.Time    `db:"created_at"`
+	MFA        bool         `db:"mfa"`
+}
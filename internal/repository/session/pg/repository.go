@@ -0,0 +1,195 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/session/pg/mapper"
+	modelRepo "github.com/Paul1k96/microservices_course_auth/internal/repository/session/pg/model"
+	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+)
+
+const (
+	sessionTable = "sessions"
+
+	idColumn         = "id"
+	userIDColumn     = "user_id"
+	deviceColumn     = "device"
+	userAgentColumn  = "user_agent"
+	ipColumn         = "ip"
+	expiresAtColumn  = "expires_at"
+	lastSeenAtColumn = "last_seen_at"
+	revokedAtColumn  = "revoked_at"
+	createdAtColumn  = "created_at"
+	mfaColumn        = "mfa"
+)
+
+// Repository represents session repository.
+type Repository struct {
+	db db.DB
+}
+
+// NewRepository creates a new instance of repository.SessionsRepository.
+func NewRepository(pg db.DB) *Repository {
+	return &Repository{db: pg}
+}
+
+// Save creates session or, if it already exists, records that it was seen again from the session IP.
+func (r *Repository) Save(ctx context.Context, session *model.Session) error {
+	queryBuilder := sq.Insert(sessionTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(
+			idColumn,
+			userIDColumn,
+			deviceColumn,
+			userAgentColumn,
+			ipColumn,
+			expiresAtColumn,
+			lastSeenAtColumn,
+			createdAtColumn,
+			mfaColumn,
+		).
+		Values(
+			session.ID,
+			session.UserID,
+			session.Device,
+			session.UserAgent,
+			session.IP,
+			session.ExpiresAt,
+			session.LastSeenAt,
+			session.CreatedAt,
+			session.MFA,
+		).
+		Suffix(fmt.Sprintf(
+			"ON CONFLICT (%[1]s) DO UPDATE SET %[2]s = EXCLUDED.%[2]s, %[3]s = EXCLUDED.%[3]s, %[4]s = EXCLUDED.%[4]s",
+			idColumn, ipColumn, expiresAtColumn, lastSeenAtColumn,
+		))
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "session_repository.Save",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID returns session by id.
+func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (*model.Session, error) {
+	queryBuilder := sq.Select("*").
+		PlaceholderFormat(sq.Dollar).
+		From(sessionTable).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "session_repository.GetByID",
+		QueryRaw: query,
+	}
+
+	var session modelRepo.Session
+	err = r.db.ScanOneContext(ctx, &session, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("get session: %w", errs.ErrSessionNotFound)
+		}
+
+		return nil, fmt.Errorf("get session: %w", err)
+	}
+
+	return mapper.ToSessionFromRepo(&session), nil
+}
+
+// GetActiveByUserID returns not revoked and not expired sessions of the user, the most recently seen first.
+func (r *Repository) GetActiveByUserID(ctx context.Context, userID int64, now time.Time) ([]*model.Session, error) {
+	queryBuilder := sq.Select("*").
+		PlaceholderFormat(sq.Dollar).
+		From(sessionTable).
+		Where(sq.Eq{userIDColumn: userID, revokedAtColumn: nil}).
+		Where(sq.Gt{expiresAtColumn: now}).
+		OrderBy(lastSeenAtColumn + " DESC")
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "session_repository.GetActiveByUserID",
+		QueryRaw: query,
+	}
+
+	var sessions []*modelRepo.Session
+	err = r.db.ScanAllContext(ctx, &sessions, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("get sessions: %w", err)
+	}
+
+	return mapper.ToSessionsFromRepo(sessions), nil
+}
+
+// Revoke revokes the session. Sessions of refresh token families created before
+// sessions were recorded do not exist, so revoking them is not an error.
+func (r *Repository) Revoke(ctx context.Context, id uuid.UUID, revokedAt time.Time) error {
+	return r.revoke(ctx, "session_repository.Revoke", sq.Eq{idColumn: id}, revokedAt)
+}
+
+// RevokeByUserID revokes every session of the user.
+func (r *Repository) RevokeByUserID(ctx context.Context, userID int64, revokedAt time.Time) error {
+	return r.revoke(ctx, "session_repository.RevokeByUserID", sq.Eq{userIDColumn: userID}, revokedAt)
+}
+
+// RevokeByUserIDExcept revokes every session of the user except the session with id.
+func (r *Repository) RevokeByUserIDExcept(ctx context.Context, userID int64, id uuid.UUID, revokedAt time.Time) error {
+	return r.revoke(
+		ctx,
+		"session_repository.RevokeByUserIDExcept",
+		sq.And{sq.Eq{userIDColumn: userID}, sq.NotEq{idColumn: id}},
+		revokedAt,
+	)
+}
+
+func (r *Repository) revoke(ctx context.Context, name string, where sq.Sqlizer, revokedAt time.Time) error {
+	queryBuilder := sq.Update(sessionTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(revokedAtColumn, revokedAt).
+		Where(where).
+		Where(sq.Eq{revokedAtColumn: nil})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     name,
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package mapper
+
+import (
+	"crypto"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	modelRepo "github.com/Paul1k96/microservices_course_auth/internal/repository/signing_key/pg/model"
+	"github.com/pkg/errors"
+)
+
+const (
+	privateKeyPEMType = "PRIVATE KEY"
+	// pemPrefix starts private keys stored before they were encrypted.
+	pemPrefix = "-----BEGIN "
+)
+
+// ToRepoFromSigningKey converts signing key from service model to repository model.
+// The private key is sealed with aead, the key ID is authenticated along, so a private key
+// cannot be moved to another key ID.
+func ToRepoFromSigningKey(key *model.SigningKey, aead cipher.AEAD) (*modelRepo.SigningKey, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("marshal private key: %w", err)
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+
+	sealed := aead.Seal(nonce, nonce, der, []byte(key.ID))
+
+	return &modelRepo.SigningKey{
+		ID:          key.ID,
+		Algorithm:   key.Algorithm,
+		PrivateKey:  base64.RawStdEncoding.EncodeToString(sealed),
+		CreatedAt:   key.CreatedAt,
+		ActivatesAt: key.ActivatesAt,
+		ExpiresAt:   key.ExpiresAt,
+	}, nil
+}
+
+// ToSigningKeyFromRepo converts signing key from repository model to service model.
+// Keys stored as plain PEM before encryption are still read until they expire.
+func ToSigningKeyFromRepo(key *modelRepo.SigningKey, aead cipher.AEAD) (*model.SigningKey, error) {
+	var (
+		der []byte
+		err error
+	)
+
+	if strings.HasPrefix(key.PrivateKey, pemPrefix) {
+		der, err = decodePEM(key.PrivateKey)
+	} else {
+		der, err = decrypt(key, aead)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("parse private key: %w", err)
+	}
+
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("private key is not a signer")
+	}
+
+	return &model.SigningKey{
+		ID:          key.ID,
+		Algorithm:   key.Algorithm,
+		PrivateKey:  signer,
+		CreatedAt:   key.CreatedAt,
+		ActivatesAt: key.ActivatesAt,
+		ExpiresAt:   key.ExpiresAt,
+	}, nil
+}
+
+// ToSigningKeysFromRepo converts signing keys from repository model to service model.
+func ToSigningKeysFromRepo(keys []*modelRepo.SigningKey, aead cipher.AEAD) ([]*model.SigningKey, error) {
+	res := make([]*model.SigningKey, 0, len(keys))
+
+	for _, key := range keys {
+		serviceKey, err := ToSigningKeyFromRepo(key, aead)
+		if err != nil {
+			return nil, fmt.Errorf("signing key %s: %w", key.ID, err)
+		}
+
+		res = append(res, serviceKey)
+	}
+
+	return res, nil
+}
+
+func decrypt(key *modelRepo.SigningKey, aead cipher.AEAD) ([]byte, error) {
+	sealed, err := base64.RawStdEncoding.DecodeString(key.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("decode private key: %w", err)
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("encrypted private key is too short")
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+
+	der, err := aead.Open(nil, nonce, ciphertext, []byte(key.ID))
+	if err != nil {
+		return nil, fmt.Errorf("decrypt private key: %w", err)
+	}
+
+	return der, nil
+}
+
+func decodePEM(privateKey string) ([]byte, error) {
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil || block.Type != privateKeyPEMType {
+		return nil, errors.New("invalid private key pem")
+	}
+
+	return block.Bytes, nil
+}
@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/signing_key/pg/mapper"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/stretchr/testify/require"
+)
+
+func newAEAD(t *testing.T, key string) cipher.AEAD {
+	t.Helper()
+
+	block, err := aes.NewCipher([]byte(key))
+	require.NoError(t, err)
+
+	aead, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+
+	return aead
+}
+
+func TestSigningKeyEncryption(t *testing.T) {
+	aead := newAEAD(t, strings.Repeat("k", 32))
+	key := tm.NewSigningKey()
+
+	repoKey, err := mapper.ToRepoFromSigningKey(key, aead)
+	require.NoError(t, err)
+	require.NotContains(t, repoKey.PrivateKey, "PRIVATE KEY")
+
+	t.Run("round trip", func(t *testing.T) {
+		res, err := mapper.ToSigningKeyFromRepo(repoKey, aead)
+		require.NoError(t, err)
+		require.Equal(t, key.PrivateKey, res.PrivateKey)
+		require.Equal(t, key.ActivatesAt, res.ActivatesAt)
+	})
+
+	t.Run("another secret key", func(t *testing.T) {
+		_, err := mapper.ToSigningKeyFromRepo(repoKey, newAEAD(t, strings.Repeat("x", 32)))
+		require.Error(t, err)
+	})
+
+	t.Run("moved to another key id", func(t *testing.T) {
+		moved := *repoKey
+		moved.ID = tm.NewSigningKey().ID
+
+		_, err := mapper.ToSigningKeyFromRepo(&moved, aead)
+		require.Error(t, err)
+	})
+
+	t.Run("plain pem stored before encryption", func(t *testing.T) {
+		der, err := x509.MarshalPKCS8PrivateKey(key.PrivateKey)
+		require.NoError(t, err)
+
+		plain := *repoKey
+		plain.PrivateKey = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+
+		res, err := mapper.ToSigningKeyFromRepo(&plain, aead)
+		require.NoError(t, err)
+		require.Equal(t, key.PrivateKey, res.PrivateKey)
+	})
+}
@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// SigningKey represents signing key repository model.
+type SigningKey struct {
+	ID          string    `db:"id"`
+	Algorithm   string    `db:"algorithm"`
+	PrivateKey  string    `db:"private_key"`
+	CreatedAt   time.Time `db:"created_at"`
+	ActivatesAt time.Time `db:"activates_at"`
+	ExpiresAt   time.Time `db:"expires_at"`
+}
@@ -0,0 +1,160 @@
+package pg
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/signing_key/pg/mapper"
+	modelRepo "github.com/Paul1k96/microservices_course_auth/internal/repository/signing_key/pg/model"
+	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/db"
+)
+
+const (
+	signingKeyTable = "signing_keys"
+
+	idColumn          = "id"
+	algorithmColumn   = "algorithm"
+	privateKeyColumn  = "private_key"
+	createdAtColumn   = "created_at"
+	activatesAtColumn = "activates_at"
+	expiresAtColumn   = "expires_at"
+)
+
+// Repository represents signing key repository.
+// Private keys are encrypted at rest with AES-GCM so a database leak does not allow forging tokens.
+type Repository struct {
+	db        db.DB
+	secretKey []byte
+}
+
+// NewRepository creates a new instance of repository.SigningKeysRepository.
+func NewRepository(pg db.DB, secretKey []byte) *Repository {
+	return &Repository{db: pg, secretKey: secretKey}
+}
+
+// Create signing key.
+func (r *Repository) Create(ctx context.Context, key *model.SigningKey) error {
+	aead, err := r.cipher()
+	if err != nil {
+		return err
+	}
+
+	repoKey, err := mapper.ToRepoFromSigningKey(key, aead)
+	if err != nil {
+		return fmt.Errorf("map signing key: %w", err)
+	}
+
+	queryBuilder := sq.Insert(signingKeyTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(idColumn, algorithmColumn, privateKeyColumn, createdAtColumn, activatesAtColumn, expiresAtColumn).
+		Values(
+			repoKey.ID,
+			repoKey.Algorithm,
+			repoKey.PrivateKey,
+			repoKey.CreatedAt,
+			repoKey.ActivatesAt,
+			repoKey.ExpiresAt,
+		)
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "signing_key_repository.Create",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	return nil
+}
+
+// GetActive returns signing keys not expired at now, the latest to start signing first.
+func (r *Repository) GetActive(ctx context.Context, now time.Time) ([]*model.SigningKey, error) {
+	queryBuilder := sq.Select(
+		idColumn,
+		algorithmColumn,
+		privateKeyColumn,
+		createdAtColumn,
+		activatesAtColumn,
+		expiresAtColumn,
+	).
+		PlaceholderFormat(sq.Dollar).
+		From(signingKeyTable).
+		Where(sq.Gt{expiresAtColumn: now}).
+		OrderBy(activatesAtColumn + " DESC")
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "signing_key_repository.GetActive",
+		QueryRaw: query,
+	}
+
+	var keys []*modelRepo.SigningKey
+	err = r.db.ScanAllContext(ctx, &keys, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("get signing keys: %w", err)
+	}
+
+	aead, err := r.cipher()
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToSigningKeysFromRepo(keys, aead)
+}
+
+// DeleteExpired deletes signing keys expired at now.
+func (r *Repository) DeleteExpired(ctx context.Context, now time.Time) error {
+	queryBuilder := sq.Delete(signingKeyTable).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.LtOrEq{expiresAtColumn: now})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "signing_key_repository.DeleteExpired",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) cipher() (cipher.AEAD, error) {
+	key := sha256.Sum256(r.secretKey)
+
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("create gcm: %w", err)
+	}
+
+	return aead, nil
+}
@@ -21,24 +21,23 @@ func ToUserFromRepo(user *modelRepo.User) *model.User {
 	var serviceUser model.User
 
 	serviceUser.ID = user.ID
+	serviceUser.OrgID = user.OrgID
 	serviceUser.Name = user.Name
 	serviceUser.Email = user.Email
 	serviceUser.Password = user.Password
-	serviceUser.Role = ToRoleFromRepo(user.Role)
+	serviceUser.Roles = user.Roles
 	serviceUser.CreatedAt = user.CreatedAt
 	if user.UpdatedAt.Valid {
 		serviceUser.UpdatedAt = &user.UpdatedAt.Time
 	}
-
-	return &serviceUser
-}
-
-// ToRoleFromRepo converts role from repository model to service model.
-func ToRoleFromRepo(role string) model.Role {
-	modelRole, err := model.RoleString(role)
-	if err != nil {
-		return model.RoleUnknown
+	if user.EmailVerifiedAt.Valid {
+		serviceUser.EmailVerifiedAt = &user.EmailVerifiedAt.Time
+	}
+	serviceUser.TOTPSecret = user.TOTPSecret.String
+	if user.TOTPEnabledAt.Valid {
+		serviceUser.TOTPEnabledAt = &user.TOTPEnabledAt.Time
 	}
+	serviceUser.TOTPCounter = user.TOTPCounter
 
-	return modelRole
+	return &serviceUser
 }
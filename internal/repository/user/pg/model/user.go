@@ -7,11 +7,16 @@ import (
 
 // User represents repository user model.
 type User struct {
-	ID        int64        `db:"id"`
-	Name      string       `db:"name"`
-	Email     string       `db:"email"`
-	Password  string       `db:"password"`
-	Role      string       `db:"role"`
-	CreatedAt time.Time    `db:"created_at"`
-	UpdatedAt sql.NullTime `db:"updated_at"`
+	ID              int64          `db:"id"`
+	OrgID           int64          `db:"org_id"`
+	Name            string         `db:"name"`
+	Email           string         `db:"email"`
+	Password        string         `db:"password"`
+	CreatedAt       time.Time      `db:"created_at"`
+	UpdatedAt       sql.NullTime   `db:"updated_at"`
+	EmailVerifiedAt sql.NullTime   `db:"email_verified_at"`
+	TOTPSecret      sql.NullString `db:"totp_secret"`
+	TOTPEnabledAt   sql.NullTime   `db:"totp_enabled_at"`
+	TOTPCounter     int64          `db:"totp_counter"`
+	Roles           []string       `db:"roles"`
 }
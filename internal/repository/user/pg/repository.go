@@ -3,6 +3,8 @@ package pg
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/Paul1k96/microservices_course_auth/internal/errs"
@@ -18,12 +20,50 @@ const (
 	userTable = "users"
 
 	idColumn        = "id"
+	orgIDColumn     = "org_id"
 	nameColumn      = "name"
 	emailColumn     = "email"
 	passwordColumn  = "password"
-	roleColumn      = "role"
 	createdAtColumn = "created_at"
 	updateAtColumn  = "updated_at"
+
+	emailVerifiedAtColumn = "email_verified_at"
+	totpSecretColumn      = "totp_secret"
+	totpEnabledAtColumn   = "totp_enabled_at"
+	totpCounterColumn     = "totp_counter"
+
+	userRoleTable        = "user_roles"
+	userRoleOrgIDColumn  = "org_id"
+	userRoleUserIDColumn = "user_id"
+	userRoleRoleIDColumn = "role_id"
+
+	orgMemberTable        = "org_members"
+	orgMemberOrgIDColumn  = "org_id"
+	orgMemberUserIDColumn = "user_id"
+
+	// memberOfOrgCondition filters users who are members of the organization.
+	memberOfOrgCondition = "EXISTS (SELECT 1 FROM org_members " +
+		"WHERE org_members.user_id = users.id AND org_members.org_id = ?)"
+
+	roleTable      = "roles"
+	roleIDColumn   = "id"
+	roleNameColumn = "name"
+
+	// hasRoleCondition filters users who hold the role in the organization.
+	hasRoleCondition = "EXISTS (SELECT 1 FROM user_roles JOIN roles ON roles.id = user_roles.role_id " +
+		"WHERE user_roles.user_id = users.id AND user_roles.org_id = ? AND roles.name = ?)"
+
+	// emailDomainExpr extracts the lowercased domain of the email, it matches the users_email_domain_idx index.
+	emailDomainExpr = "lower(split_part(email, '@', 2))"
+	// lastChangedAtExpr is the update time of the user, or the creation time if they were never updated.
+	lastChangedAtExpr = "COALESCE(updated_at, created_at)"
+
+	// rolesColumn aggregates names of the roles the user holds in their own organization into the roles column.
+	rolesColumn = "ARRAY(SELECT roles.name FROM user_roles JOIN roles ON roles.id = user_roles.role_id " +
+		"WHERE user_roles.user_id = users.id AND user_roles.org_id = users.org_id ORDER BY roles.name) AS roles"
+	// rolesInOrgColumn aggregates names of the roles the user holds in the organization into the roles column.
+	rolesInOrgColumn = "ARRAY(SELECT roles.name FROM user_roles JOIN roles ON roles.id = user_roles.role_id " +
+		"WHERE user_roles.user_id = users.id AND user_roles.org_id = ? ORDER BY roles.name) AS roles"
 )
 
 // Repository represents user repository.
@@ -36,12 +76,13 @@ func NewRepository(pg db.DB) *Repository {
 	return &Repository{db: pg}
 }
 
-// Create user.
+// Create user as a member of user.OrgID and assign them user.Roles in it, roles which do not exist are skipped.
+// It should be called in a transaction.
 func (r *Repository) Create(ctx context.Context, user *model.User) (int64, error) {
 	queryBuilder := sq.Insert(userTable).
 		PlaceholderFormat(sq.Dollar).
-		Columns(nameColumn, emailColumn, passwordColumn, roleColumn).
-		Values(user.Name, user.Email, user.Password, user.Role.String()).
+		Columns(orgIDColumn, nameColumn, emailColumn, passwordColumn).
+		Values(user.OrgID, user.Name, user.Email, user.Password).
 		Suffix("RETURNING id")
 
 	query, args, err := queryBuilder.ToSql()
@@ -60,12 +101,22 @@ func (r *Repository) Create(ctx context.Context, user *model.User) (int64, error
 		return 0, fmt.Errorf("exec query: %w", err)
 	}
 
+	err = r.AddMember(ctx, user.OrgID, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	err = r.assignRoles(ctx, userID, user.Roles)
+	if err != nil {
+		return 0, err
+	}
+
 	return userID, nil
 }
 
 // GetByID user by id.
 func (r *Repository) GetByID(ctx context.Context, id int64) (*model.User, error) {
-	queryBuilder := sq.Select("*").
+	queryBuilder := sq.Select("*", rolesColumn).
 		PlaceholderFormat(sq.Dollar).
 		From(userTable).
 		Where(sq.Eq{idColumn: id})
@@ -93,12 +144,46 @@ func (r *Repository) GetByID(ctx context.Context, id int64) (*model.User, error)
 	return mapper.ToUserFromRepo(&user), nil
 }
 
-// GetByIDs returns list of users by ids.
-func (r *Repository) GetByIDs(ctx context.Context, ids []int64) ([]*model.User, error) {
+// GetByIDInOrg returns user by id if they are a member of the organization.
+func (r *Repository) GetByIDInOrg(ctx context.Context, orgID, id int64) (*model.User, error) {
 	queryBuilder := sq.Select("*").
+		Column(rolesInOrgColumn, orgID).
 		PlaceholderFormat(sq.Dollar).
 		From(userTable).
-		Where(sq.Eq{idColumn: ids})
+		Where(sq.Eq{idColumn: id}).
+		Where(memberOfOrgCondition, orgID)
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "user_repository.GetInOrg",
+		QueryRaw: query,
+	}
+
+	var user modelRepo.User
+	err = r.db.ScanOneContext(ctx, &user, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("get user: %w", errs.ErrUserNotFound)
+		}
+
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	return mapper.ToUserFromRepo(&user), nil
+}
+
+// GetByIDsInOrg returns list of users by ids who are members of the organization.
+func (r *Repository) GetByIDsInOrg(ctx context.Context, orgID int64, ids []int64) ([]*model.User, error) {
+	queryBuilder := sq.Select("*").
+		Column(rolesInOrgColumn, orgID).
+		PlaceholderFormat(sq.Dollar).
+		From(userTable).
+		Where(sq.Eq{idColumn: ids}).
+		Where(memberOfOrgCondition, orgID)
 
 	query, args, err := queryBuilder.ToSql()
 	if err != nil {
@@ -119,8 +204,140 @@ func (r *Repository) GetByIDs(ctx context.Context, ids []int64) ([]*model.User,
 	return mapper.ToUsersFromRepo(users), nil
 }
 
+// Search returns users who are members of filter.OrgID and match the filter, sorted by filter.SortBy
+// and then by id. The search starts after filter.After and returns at most filter.Limit users.
+func (r *Repository) Search(ctx context.Context, filter *model.UserFilter) ([]*model.User, error) {
+	queryBuilder := sq.Select("*").
+		Column(rolesInOrgColumn, filter.OrgID).
+		PlaceholderFormat(sq.Dollar).
+		From(userTable).
+		Where(memberOfOrgCondition, filter.OrgID).
+		Limit(filter.Limit)
+
+	if filter.Role != "" {
+		queryBuilder = queryBuilder.Where(hasRoleCondition, filter.OrgID, filter.Role)
+	}
+	if filter.CreatedFrom != nil {
+		queryBuilder = queryBuilder.Where(sq.GtOrEq{createdAtColumn: *filter.CreatedFrom})
+	}
+	if filter.CreatedTo != nil {
+		queryBuilder = queryBuilder.Where(sq.Lt{createdAtColumn: *filter.CreatedTo})
+	}
+	if filter.UpdatedFrom != nil {
+		queryBuilder = queryBuilder.Where(sq.GtOrEq{updateAtColumn: *filter.UpdatedFrom})
+	}
+	if filter.UpdatedTo != nil {
+		queryBuilder = queryBuilder.Where(sq.Lt{updateAtColumn: *filter.UpdatedTo})
+	}
+	if filter.EmailDomain != "" {
+		queryBuilder = queryBuilder.Where(emailDomainExpr+" = ?", strings.ToLower(filter.EmailDomain))
+	}
+
+	queryBuilder = r.setUserSortForSearch(queryBuilder, filter)
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "user_repository.Search",
+		QueryRaw: query,
+	}
+
+	var users []*modelRepo.User
+	err = r.db.ScanAllContext(ctx, &users, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("scan users: %w", err)
+	}
+
+	return mapper.ToUsersFromRepo(users), nil
+}
+
+// setUserSortForSearch orders users by the sort field and id and skips users up to filter.After.
+func (r *Repository) setUserSortForSearch(queryBuilder sq.SelectBuilder, filter *model.UserFilter) sq.SelectBuilder {
+	direction, comparison := "ASC", ">"
+	if filter.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	var (
+		sortExpr  string
+		sortValue interface{}
+	)
+
+	switch filter.SortBy {
+	case model.UserSortByCreatedAt:
+		sortExpr = createdAtColumn
+		if filter.After != nil {
+			sortValue = filter.After.CreatedAt
+		}
+	case model.UserSortByUpdatedAt:
+		sortExpr = lastChangedAtExpr
+		if filter.After != nil {
+			sortValue = filter.After.UpdatedAt
+		}
+	case model.UserSortByEmail:
+		sortExpr = emailColumn
+		if filter.After != nil {
+			sortValue = filter.After.Email
+		}
+	}
+
+	if sortExpr == "" {
+		queryBuilder = queryBuilder.OrderBy(idColumn + " " + direction)
+		if filter.After != nil {
+			queryBuilder = queryBuilder.Where(fmt.Sprintf("%s %s ?", idColumn, comparison), filter.After.ID)
+		}
+
+		return queryBuilder
+	}
+
+	queryBuilder = queryBuilder.OrderBy(sortExpr+" "+direction, idColumn+" "+direction)
+	if filter.After != nil {
+		queryBuilder = queryBuilder.Where(
+			fmt.Sprintf("(%s, %s) %s (?, ?)", sortExpr, idColumn, comparison),
+			sortValue, filter.After.ID,
+		)
+	}
+
+	return queryBuilder
+}
+
+// GetByEmail returns user by email among accounts owned by the organization.
+func (r *Repository) GetByEmail(ctx context.Context, orgID int64, email string) (*model.User, error) {
+	queryBuilder := sq.Select("*", rolesColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(userTable).
+		Where(sq.Eq{orgIDColumn: orgID, emailColumn: email})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "user_repository.GetByEmail",
+		QueryRaw: query,
+	}
+
+	var user modelRepo.User
+	err = r.db.ScanOneContext(ctx, &user, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("get user: %w", errs.ErrUserNotFound)
+		}
+
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	return mapper.ToUserFromRepo(&user), nil
+}
+
 // Update user by id.
 // If user.Name or user.Email is empty, this field will not be updated.
+// Changing the email resets its verification. Non-empty user.Roles replace the built-in roles
+// the user holds in their own organization, other roles are kept. It should be called in a transaction.
 func (r *Repository) Update(ctx context.Context, user *model.User) error {
 	queryBuilder := r.setUserDataForUpdate(user)
 
@@ -139,13 +356,21 @@ func (r *Repository) Update(ctx context.Context, user *model.User) error {
 		return fmt.Errorf("exec query: %w", err)
 	}
 
-	return nil
+	if len(user.Roles) == 0 {
+		return nil
+	}
+
+	err = r.unassignBuiltInRoles(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	return r.assignRoles(ctx, user.ID, user.Roles)
 }
 
 func (r *Repository) setUserDataForUpdate(user *model.User) sq.UpdateBuilder {
 	queryBuilder := sq.Update(userTable).
 		PlaceholderFormat(sq.Dollar).
-		Set(roleColumn, user.Role).
 		Set(updateAtColumn, user.UpdatedAt).
 		Where(sq.Eq{idColumn: user.ID})
 
@@ -153,12 +378,195 @@ func (r *Repository) setUserDataForUpdate(user *model.User) sq.UpdateBuilder {
 		queryBuilder = queryBuilder.Set("Name", user.Name)
 	}
 	if user.Email != "" {
-		queryBuilder = queryBuilder.Set("Email", user.Email)
+		queryBuilder = queryBuilder.
+			Set(emailVerifiedAtColumn, sq.Expr(
+				fmt.Sprintf("CASE WHEN %s = ? THEN %s END", emailColumn, emailVerifiedAtColumn),
+				user.Email,
+			)).
+			Set("Email", user.Email)
 	}
 
 	return queryBuilder
 }
 
+// AddMember adds the user to the organization, adding an existing member does nothing.
+func (r *Repository) AddMember(ctx context.Context, orgID, userID int64) error {
+	queryBuilder := sq.Insert(orgMemberTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(orgMemberOrgIDColumn, orgMemberUserIDColumn).
+		Values(orgID, userID).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "user_repository.AddMember",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("add organization member: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) assignRoles(ctx context.Context, userID int64, roles []string) error {
+	if len(roles) == 0 {
+		return nil
+	}
+
+	queryBuilder := sq.Insert(userRoleTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(userRoleOrgIDColumn, userRoleUserIDColumn, userRoleRoleIDColumn).
+		Select(sq.Select(userTable+"."+orgIDColumn, userTable+"."+idColumn, roleTable+"."+roleIDColumn).
+			From(userTable).
+			CrossJoin(roleTable).
+			Where(sq.Eq{
+				userTable + "." + idColumn:       userID,
+				roleTable + "." + roleNameColumn: roles,
+				roleTable + "." + orgIDColumn:    nil,
+			})).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "user_repository.AssignRoles",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("assign roles: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) unassignBuiltInRoles(ctx context.Context, userID int64) error {
+	queryBuilder := sq.Delete(userRoleTable).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{userRoleUserIDColumn: userID}).
+		Where(fmt.Sprintf("%s = (SELECT %s FROM %s WHERE %s = ?)", userRoleOrgIDColumn, orgIDColumn, userTable, idColumn), userID).
+		Where(fmt.Sprintf("%s IN (SELECT %s FROM %s WHERE built_in)", userRoleRoleIDColumn, roleIDColumn, roleTable))
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "user_repository.UnassignBuiltInRoles",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("unassign built-in roles: %w", err)
+	}
+
+	return nil
+}
+
+// UpdatePassword sets a new password hash for user by id.
+func (r *Repository) UpdatePassword(ctx context.Context, id int64, passwordHash string) error {
+	queryBuilder := sq.Update(userTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(passwordColumn, passwordHash).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "user_repository.UpdatePassword",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	return nil
+}
+
+// MarkEmailVerified marks email of the user as verified if it is still the user's email.
+func (r *Repository) MarkEmailVerified(ctx context.Context, id int64, email string, verifiedAt time.Time) error {
+	queryBuilder := sq.Update(userTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(emailVerifiedAtColumn, verifiedAt).
+		Where(sq.Eq{idColumn: id, emailColumn: email})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "user_repository.MarkEmailVerified",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("mark email verified: %w", errs.ErrUserNotFound)
+	}
+
+	return nil
+}
+
+// UpdateTOTP stores TOTP secret, enablement time and the last accepted time step of the user.
+// An empty secret clears TOTP of the user.
+func (r *Repository) UpdateTOTP(ctx context.Context, user *model.User) error {
+	var secret *string
+	if user.TOTPSecret != "" {
+		secret = &user.TOTPSecret
+	}
+
+	queryBuilder := sq.Update(userTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(totpSecretColumn, secret).
+		Set(totpEnabledAtColumn, user.TOTPEnabledAt).
+		Set(totpCounterColumn, user.TOTPCounter).
+		Where(sq.Eq{idColumn: user.ID})
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "user_repository.UpdateTOTP",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("exec query: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("update totp: %w", errs.ErrUserNotFound)
+	}
+
+	return nil
+}
+
 // Delete user by id.
 func (r *Repository) Delete(ctx context.Context, id int64) error {
 	queryBuilder := sq.Delete(userTable).
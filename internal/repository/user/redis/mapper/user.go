@@ -1,35 +1,36 @@
 package mapper
 
 import (
+	"strings"
 	"time"
 
 	"github.com/Paul1k96/microservices_course_auth/internal/model"
 	modelRepo "github.com/Paul1k96/microservices_course_auth/internal/repository/user/redis/model"
 )
 
+// RolesSeparator separates role names in the roles field.
+const RolesSeparator = ","
+
 // ToUserFromRepo converts user from repository model to service model.
 func ToUserFromRepo(user *modelRepo.User) *model.User {
 	var serviceUser model.User
 
 	serviceUser.ID = user.ID
+	serviceUser.OrgID = user.OrgID
 	serviceUser.Name = user.Name
 	serviceUser.Email = user.Email
-	serviceUser.Role = ToRoleFromRepo(user.Role)
+	if user.Roles != "" {
+		serviceUser.Roles = strings.Split(user.Roles, RolesSeparator)
+	}
 	serviceUser.CreatedAt = time.Unix(0, user.CreatedAt)
 	if user.UpdatedAt != nil {
 		updateTime := time.Unix(0, *user.UpdatedAt)
 		serviceUser.UpdatedAt = &updateTime
 	}
-
-	return &serviceUser
-}
-
-// ToRoleFromRepo converts role from repository model to service model.
-func ToRoleFromRepo(role string) model.Role {
-	modelRole, err := model.RoleString(role)
-	if err != nil {
-		return model.RoleUnknown
+	if user.EmailVerifiedAt != nil {
+		verifyTime := time.Unix(0, *user.EmailVerifiedAt)
+		serviceUser.EmailVerifiedAt = &verifyTime
 	}
 
-	return modelRole
+	return &serviceUser
 }
@@ -2,11 +2,13 @@ package model
 
 // User represents repository user model.
 type User struct {
-	ID        int64  `redis:"id"`
-	Name      string `redis:"name"`
-	Email     string `redis:"email"`
-	Password  string `redis:"password"`
-	Role      string `redis:"role"`
-	CreatedAt int64  `redis:"created_at"`
-	UpdatedAt *int64 `redis:"updated_at"`
+	ID              int64  `redis:"id"`
+	OrgID           int64  `redis:"org_id"`
+	Name            string `redis:"name"`
+	Email           string `redis:"email"`
+	Password        string `redis:"password"`
+	Roles           string `redis:"roles"`
+	CreatedAt       int64  `redis:"created_at"`
+	UpdatedAt       *int64 `redis:"updated_at"`
+	EmailVerifiedAt *int64 `redis:"email_verified_at"`
 }
@@ -3,6 +3,7 @@ package pg
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Paul1k96/microservices_course_auth/internal/errs"
@@ -13,24 +14,59 @@ import (
 	"github.com/gomodule/redigo/redis"
 )
 
+// userKey is the key of the user cached for readers of the organization.
+func userKey(orgID, id int64) string {
+	return fmt.Sprintf("org:%d:user:%d", orgID, id)
+}
+
+// userOrgsKey is the key of the set of organizations the user is cached for.
+func userOrgsKey(id int64) string {
+	return fmt.Sprintf("user:%d:orgs", id)
+}
+
+// setScript replaces the user in KEYS[1] with fields in ARGV[3:] and records organization ARGV[2]
+// in the set KEYS[2], both are kept for ARGV[1] milliseconds.
+var setScript = redis.NewScript(2, `
+redis.call('DEL', KEYS[1])
+redis.call('HSET', KEYS[1], unpack(ARGV, 3))
+redis.call('PEXPIRE', KEYS[1], ARGV[1])
+redis.call('SADD', KEYS[2], ARGV[2])
+redis.call('PEXPIRE', KEYS[2], ARGV[1])
+
+return 1
+`)
+
+// deleteScript removes user ARGV[1] from the cache of every organization in the set KEYS[1].
+var deleteScript = redis.NewScript(1, `
+for _, org in ipairs(redis.call('SMEMBERS', KEYS[1])) do
+	redis.call('DEL', 'org:' .. org .. ':user:' .. ARGV[1])
+end
+redis.call('DEL', KEYS[1])
+
+return 1
+`)
+
 // Repository represents user repository.
+// cache.RedisClient cannot run scripts, so users are set and deleted through the pool it is built on.
 type Repository struct {
 	redisCache cache.RedisClient
+	pool       *redis.Pool
 	ttl        time.Duration
 }
 
-// NewRepository creates a new instance of repository.UsersRepository.
-func NewRepository(redisCache cache.RedisClient, ttl time.Duration) *Repository {
-	return &Repository{redisCache: redisCache, ttl: ttl}
+// NewRepository creates a new instance of repository.UsersCache.
+func NewRepository(redisCache cache.RedisClient, pool *redis.Pool, ttl time.Duration) *Repository {
+	return &Repository{redisCache: redisCache, pool: pool, ttl: ttl}
 }
 
-// Set user.
-func (r *Repository) Set(ctx context.Context, user *model.User) error {
+// Set user for readers of the organization the user was read in.
+func (r *Repository) Set(ctx context.Context, orgID int64, user *model.User) error {
 	userToCreate := modelRepo.User{
 		ID:        user.ID,
+		OrgID:     user.OrgID,
 		Name:      user.Name,
 		Email:     user.Email,
-		Role:      user.Role.String(),
+		Roles:     strings.Join(user.Roles, mapper.RolesSeparator),
 		CreatedAt: user.CreatedAt.UnixNano(),
 	}
 
@@ -39,22 +75,31 @@ func (r *Repository) Set(ctx context.Context, user *model.User) error {
 		userToCreate.UpdatedAt = &updateTime
 	}
 
-	err := r.redisCache.HSet(ctx, fmt.Sprintf("%d", user.ID), userToCreate)
+	if user.EmailVerifiedAt != nil {
+		verifyTime := user.EmailVerifiedAt.UnixNano()
+		userToCreate.EmailVerifiedAt = &verifyTime
+	}
+
+	conn, err := r.pool.GetContext(ctx)
 	if err != nil {
-		return fmt.Errorf("create user: %w", err)
+		return fmt.Errorf("get redis connection: %w", err)
 	}
+	defer conn.Close()
+
+	args := redis.Args{userKey(orgID, user.ID), userOrgsKey(user.ID), r.ttl.Milliseconds(), orgID}.
+		AddFlat(userToCreate)
 
-	err = r.redisCache.Expire(ctx, fmt.Sprintf("%d", user.ID), r.ttl)
+	_, err = setScript.DoContext(ctx, conn, args...)
 	if err != nil {
-		return fmt.Errorf("set ttl: %w", err)
+		return fmt.Errorf("create user: %w", err)
 	}
 
 	return nil
 }
 
-// Get user by id.
-func (r *Repository) Get(ctx context.Context, id int64) (*model.User, error) {
-	values, err := r.redisCache.HGetAll(ctx, fmt.Sprintf("%d", id))
+// Get user by id cached for readers of the organization.
+func (r *Repository) Get(ctx context.Context, orgID, id int64) (*model.User, error) {
+	values, err := r.redisCache.HGetAll(ctx, userKey(orgID, id))
 	if err != nil {
 		return nil, fmt.Errorf("get user: %w", err)
 	}
@@ -72,9 +117,15 @@ func (r *Repository) Get(ctx context.Context, id int64) (*model.User, error) {
 	return mapper.ToUserFromRepo(&user), nil
 }
 
-// Delete user by id.
+// Delete user by id from the cache of every organization.
 func (r *Repository) Delete(ctx context.Context, id int64) error {
-	err := r.redisCache.Delete(ctx, fmt.Sprintf("%d", id))
+	conn, err := r.pool.GetContext(ctx)
+	if err != nil {
+		return fmt.Errorf("get redis connection: %w", err)
+	}
+	defer conn.Close()
+
+	_, err = deleteScript.DoContext(ctx, conn, userOrgsKey(id), id)
 	if err != nil {
 		return fmt.Errorf("delete user: %w", err)
 	}
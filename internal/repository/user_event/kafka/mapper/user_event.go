@@ -17,6 +17,34 @@ func NewUserEvent(userEvent *model.UserEvent) (*modelKafka.UserEvent, error) {
 		data = NewUpdateUserEventData(val)
 	case *model.DeleteUserEventValue:
 		data = NewDeleteUserEventData(val)
+	case *model.RefreshTokenReusedEventValue:
+		data = NewRefreshTokenReusedEventData(val)
+	case *model.PasswordChangedEventValue:
+		data = NewPasswordChangedEventData(val)
+	case *model.LockedEventValue:
+		data = NewLockedEventData(val)
+	case *model.UnlockedEventValue:
+		data = NewUnlockedEventData(val)
+	case *model.MFAEnabledEventValue:
+		data = NewMFAEnabledEventData(val)
+	case *model.MFADisabledEventValue:
+		data = NewMFADisabledEventData(val)
+	case *model.RoleCreatedEventValue:
+		data = NewRoleCreatedEventData(val)
+	case *model.RoleUpdatedEventValue:
+		data = NewRoleUpdatedEventData(val)
+	case *model.RoleDeletedEventValue:
+		data = NewRoleDeletedEventData(val)
+	case *model.RoleAssignedEventValue:
+		data = NewRoleAssignedEventData(val)
+	case *model.RoleUnassignedEventValue:
+		data = NewRoleUnassignedEventData(val)
+	case *model.ImpersonationStartedEventValue:
+		data = NewImpersonationStartedEventData(val)
+	case *model.ImpersonatedCallEventValue:
+		data = NewImpersonatedCallEventData(val)
+	case *model.OrgMemberAddedEventValue:
+		data = NewOrgMemberAddedEventData(val)
 	default:
 		return nil, errors.New("invalid user event data")
 	}
@@ -24,6 +52,7 @@ func NewUserEvent(userEvent *model.UserEvent) (*modelKafka.UserEvent, error) {
 	return &modelKafka.UserEvent{
 		ID:       userEvent.ID.String(),
 		UserID:   userEvent.UserID,
+		OrgID:    userEvent.OrgID,
 		Type:     userEvent.Type,
 		EntityID: userEvent.EntityID,
 		Data:     data,
@@ -35,9 +64,11 @@ func NewCreateUserEventData(userVal *model.CreateUserEventValue) *modelKafka.Cre
 	return &modelKafka.CreateUserEventData{
 		User: &modelKafka.User{
 			ID:        userVal.User.ID,
+			OrgID:     userVal.User.OrgID,
 			Name:      userVal.User.Name,
 			Email:     userVal.User.Email,
-			Role:      userVal.User.Role.String(),
+			Role:      model.PrimaryRole(userVal.User.Roles),
+			Roles:     userVal.User.Roles,
 			CreatedAt: userVal.User.CreatedAt,
 			UpdatedAt: userVal.User.UpdatedAt,
 		},
@@ -49,9 +80,11 @@ func NewUpdateUserEventData(userVal *model.UpdateUserEventValue) *modelKafka.Upd
 	return &modelKafka.UpdateUserEventData{
 		User: &modelKafka.User{
 			ID:        userVal.User.ID,
+			OrgID:     userVal.User.OrgID,
 			Name:      userVal.User.Name,
 			Email:     userVal.User.Email,
-			Role:      userVal.User.Role.String(),
+			Role:      model.PrimaryRole(userVal.User.Roles),
+			Roles:     userVal.User.Roles,
 			CreatedAt: userVal.User.CreatedAt,
 			UpdatedAt: userVal.User.UpdatedAt,
 		},
@@ -62,3 +95,97 @@ func NewUpdateUserEventData(userVal *model.UpdateUserEventValue) *modelKafka.Upd
 func NewDeleteUserEventData(_ *model.DeleteUserEventValue) *modelKafka.DeleteUserEventData {
 	return &modelKafka.DeleteUserEventData{}
 }
+
+// NewRefreshTokenReusedEventData creates refresh token reused event data.
+func NewRefreshTokenReusedEventData(val *model.RefreshTokenReusedEventValue) *modelKafka.RefreshTokenReusedEventData {
+	return &modelKafka.RefreshTokenReusedEventData{
+		FamilyID: val.FamilyID.String(),
+	}
+}
+
+// NewPasswordChangedEventData creates password changed event data.
+func NewPasswordChangedEventData(_ *model.PasswordChangedEventValue) *modelKafka.PasswordChangedEventData {
+	return &modelKafka.PasswordChangedEventData{}
+}
+
+// NewLockedEventData creates account locked event data.
+func NewLockedEventData(val *model.LockedEventValue) *modelKafka.LockedEventData {
+	return &modelKafka.LockedEventData{
+		LockedUntil: val.LockedUntil,
+		Lockouts:    val.Lockouts,
+		IP:          val.IP,
+	}
+}
+
+// NewUnlockedEventData creates account unlocked event data.
+func NewUnlockedEventData(_ *model.UnlockedEventValue) *modelKafka.UnlockedEventData {
+	return &modelKafka.UnlockedEventData{}
+}
+
+// NewMFAEnabledEventData creates two-factor authentication enabled event data.
+func NewMFAEnabledEventData(_ *model.MFAEnabledEventValue) *modelKafka.MFAEnabledEventData {
+	return &modelKafka.MFAEnabledEventData{}
+}
+
+// NewMFADisabledEventData creates two-factor authentication disabled event data.
+func NewMFADisabledEventData(_ *model.MFADisabledEventValue) *modelKafka.MFADisabledEventData {
+	return &modelKafka.MFADisabledEventData{}
+}
+
+// NewRoleCreatedEventData creates role created event data.
+func NewRoleCreatedEventData(val *model.RoleCreatedEventValue) *modelKafka.RoleCreatedEventData {
+	return &modelKafka.RoleCreatedEventData{Role: NewRole(val.Role)}
+}
+
+// NewRoleUpdatedEventData creates role updated event data.
+func NewRoleUpdatedEventData(val *model.RoleUpdatedEventValue) *modelKafka.RoleUpdatedEventData {
+	return &modelKafka.RoleUpdatedEventData{Role: NewRole(val.Role)}
+}
+
+// NewRoleDeletedEventData creates role deleted event data.
+func NewRoleDeletedEventData(val *model.RoleDeletedEventValue) *modelKafka.RoleDeletedEventData {
+	return &modelKafka.RoleDeletedEventData{Name: val.Name}
+}
+
+// NewRoleAssignedEventData creates role assigned event data.
+func NewRoleAssignedEventData(val *model.RoleAssignedEventValue) *modelKafka.RoleAssignedEventData {
+	return &modelKafka.RoleAssignedEventData{RoleID: val.RoleID, Name: val.Name}
+}
+
+// NewRoleUnassignedEventData creates role unassigned event data.
+func NewRoleUnassignedEventData(val *model.RoleUnassignedEventValue) *modelKafka.RoleUnassignedEventData {
+	return &modelKafka.RoleUnassignedEventData{RoleID: val.RoleID, Name: val.Name}
+}
+
+// NewRole creates role.
+func NewRole(role *model.Role) *modelKafka.Role {
+	return &modelKafka.Role{
+		ID:          role.ID,
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+		BuiltIn:     role.BuiltIn,
+		CreatedAt:   role.CreatedAt,
+	}
+}
+
+// NewImpersonationStartedEventData creates impersonation started event data.
+func NewImpersonationStartedEventData(
+	val *model.ImpersonationStartedEventValue,
+) *modelKafka.ImpersonationStartedEventData {
+	return &modelKafka.ImpersonationStartedEventData{
+		TokenID:   val.TokenID,
+		Reason:    val.Reason,
+		ExpiresAt: val.ExpiresAt,
+	}
+}
+
+// NewImpersonatedCallEventData creates impersonated call event data.
+func NewImpersonatedCallEventData(val *model.ImpersonatedCallEventValue) *modelKafka.ImpersonatedCallEventData {
+	return &modelKafka.ImpersonatedCallEventData{TokenID: val.TokenID, Endpoint: val.Endpoint}
+}
+
+// NewOrgMemberAddedEventData creates user added to the organization event data.
+func NewOrgMemberAddedEventData(_ *model.OrgMemberAddedEventValue) *modelKafka.OrgMemberAddedEventData {
+	return &modelKafka.OrgMemberAddedEventData{}
+}
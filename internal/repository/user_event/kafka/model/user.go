@@ -5,9 +5,11 @@ import "time"
 // User represents user model.
 type User struct {
 	ID        int64      `json:"id"`
+	OrgID     int64      `json:"org_id,omitempty"`
 	Name      string     `json:"name"`
 	Email     string     `json:"email"`
 	Role      string     `json:"role"`
+	Roles     []string   `json:"roles"`
 	CreatedAt time.Time  `json:"created_at"`
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
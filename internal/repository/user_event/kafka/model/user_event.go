@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/Paul1k96/microservices_course_auth/internal/model"
 )
 
@@ -13,6 +15,7 @@ type Data interface {
 type UserEvent struct {
 	ID        string              `json:"id"`
 	UserID    int64               `json:"user_id"`
+	OrgID     int64               `json:"org_id,omitempty"`
 	Type      model.UserEventType `json:"type"`
 	Data      Data                `json:"data"`
 	EntityID  int64               `json:"entity_id"`
@@ -38,3 +41,103 @@ type DeleteUserEventData struct {
 }
 
 func (DeleteUserEventData) isEventData() {}
+
+// RefreshTokenReusedEventData represents refresh token reused event data.
+type RefreshTokenReusedEventData struct {
+	FamilyID string `json:"family_id"`
+}
+
+func (RefreshTokenReusedEventData) isEventData() {}
+
+// PasswordChangedEventData represents password changed event data.
+type PasswordChangedEventData struct {
+}
+
+func (PasswordChangedEventData) isEventData() {}
+
+// LockedEventData represents account locked event data.
+type LockedEventData struct {
+	LockedUntil time.Time `json:"locked_until"`
+	Lockouts    int       `json:"lockouts"`
+	IP          string    `json:"ip"`
+}
+
+func (LockedEventData) isEventData() {}
+
+// UnlockedEventData represents account unlocked event data.
+type UnlockedEventData struct {
+}
+
+func (UnlockedEventData) isEventData() {}
+
+// MFAEnabledEventData represents two-factor authentication enabled event data.
+type MFAEnabledEventData struct {
+}
+
+func (MFAEnabledEventData) isEventData() {}
+
+// MFADisabledEventData represents two-factor authentication disabled event data.
+type MFADisabledEventData struct {
+}
+
+func (MFADisabledEventData) isEventData() {}
+
+// RoleCreatedEventData represents role created event data.
+type RoleCreatedEventData struct {
+	Role *Role `json:"role"`
+}
+
+func (RoleCreatedEventData) isEventData() {}
+
+// RoleUpdatedEventData represents role updated event data.
+type RoleUpdatedEventData struct {
+	Role *Role `json:"role"`
+}
+
+func (RoleUpdatedEventData) isEventData() {}
+
+// RoleDeletedEventData represents role deleted event data.
+type RoleDeletedEventData struct {
+	Name string `json:"name"`
+}
+
+func (RoleDeletedEventData) isEventData() {}
+
+// RoleAssignedEventData represents role assigned to the user event data.
+type RoleAssignedEventData struct {
+	RoleID int64  `json:"role_id"`
+	Name   string `json:"name"`
+}
+
+func (RoleAssignedEventData) isEventData() {}
+
+// RoleUnassignedEventData represents role unassigned from the user event data.
+type RoleUnassignedEventData struct {
+	RoleID int64  `json:"role_id"`
+	Name   string `json:"name"`
+}
+
+func (RoleUnassignedEventData) isEventData() {}
+
+// ImpersonationStartedEventData represents impersonation token issued to the admin event data.
+type ImpersonationStartedEventData struct {
+	TokenID   string    `json:"token_id"`
+	Reason    string    `json:"reason"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (ImpersonationStartedEventData) isEventData() {}
+
+// ImpersonatedCallEventData represents call made with an impersonation token event data.
+type ImpersonatedCallEventData struct {
+	TokenID  string `json:"token_id"`
+	Endpoint string `json:"endpoint"`
+}
+
+func (ImpersonatedCallEventData) isEventData() {}
+
+// OrgMemberAddedEventData represents user added to the organization event data.
+type OrgMemberAddedEventData struct {
+}
+
+func (OrgMemberAddedEventData) isEventData() {}
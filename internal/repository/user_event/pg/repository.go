@@ -2,6 +2,7 @@ package pg
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 
 	sq "github.com/Masterminds/squirrel"
@@ -15,6 +16,7 @@ const (
 
 	idColumn     = "id"
 	userIDColumn = "user_id"
+	orgIDColumn  = "org_id"
 	eventType    = "event_type"
 	eventValue   = "value"
 	entityID     = "entity_id"
@@ -39,10 +41,12 @@ func (r *Repository) Save(ctx context.Context, event *model.UserEvent) error {
 		return fmt.Errorf("set raw value: %w", err)
 	}
 
+	orgID := sql.NullInt64{Int64: event.OrgID, Valid: event.OrgID != 0}
+
 	queryBuilder := sq.Insert(userEventTable).
 		PlaceholderFormat(sq.Dollar).
-		Columns(idColumn, userIDColumn, eventType, eventValue, entityID, createdAt).
-		Values(event.ID, event.UserID, event.Type, rawValue, event.EntityID, event.CreatedAt)
+		Columns(idColumn, userIDColumn, orgIDColumn, eventType, eventValue, entityID, createdAt).
+		Values(event.ID, event.UserID, orgID, event.Type, rawValue, event.EntityID, event.CreatedAt)
 
 	query, args, err := queryBuilder.ToSql()
 	if err != nil {
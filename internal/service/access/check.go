@@ -0,0 +1,80 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/endpoint"
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/pkg/errors"
+)
+
+// Check checks whether the owner of accessToken may call endpointAddress.
+// The user must hold a role granting any of the permissions the policy requires for the endpoint.
+// Endpoints missing from the policy are denied, as are users with unverified
+// email if configured so and admins whose session was opened without the second factor.
+// API keys and OAuth clients are also limited to their scopes. OAuth clients act
+// on their own behalf, so their scopes replace the policy.
+func (s *service) Check(ctx context.Context, accessToken, endpointAddress string) error {
+	claims, err := s.sessionService.Authenticate(endpoint.NewContext(ctx, endpointAddress), accessToken)
+	if err != nil {
+		return fmt.Errorf("verify access token: %w", err)
+	}
+
+	if claims.ClientID != "" {
+		if !claims.Allows(endpointAddress) {
+			return fmt.Errorf("check scopes of client %s for %s: %w", claims.ClientID, endpointAddress, errs.ErrAccessDenied)
+		}
+
+		return nil
+	}
+
+	if s.emailVerificationConfig.IsRequiredForAccess() && !claims.EmailVerified {
+		return fmt.Errorf("check email of user %d: %w", claims.UserID, errs.ErrEmailNotVerified)
+	}
+
+	if claims.HasRole(model.RoleAdmin) && !claims.MFA {
+		return fmt.Errorf("check mfa of admin %d: %w", claims.UserID, errs.ErrMFARequired)
+	}
+
+	if !claims.Allows(endpointAddress) {
+		return fmt.Errorf("check scopes of api key %s for %s: %w", claims.APIKeyID, endpointAddress, errs.ErrAccessDenied)
+	}
+
+	policy := s.getPolicy(ctx)
+
+	for _, permission := range policy[endpointAddress] {
+		granted, err := s.roleService.HasPermission(ctx, claims.UserID, claims.OrgID, permission)
+		if err != nil {
+			return fmt.Errorf("check permission for %s: %w", endpointAddress, err)
+		}
+
+		if granted {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("check permissions of user %d for %s: %w", claims.UserID, endpointAddress, errs.ErrAccessDenied)
+}
+
+func (s *service) getPolicy(ctx context.Context) model.AccessPolicy {
+	policy, err := s.policyCache.Get(ctx)
+	if err == nil {
+		return policy
+	}
+
+	if !errors.Is(err, errs.ErrAccessPolicyNotFound) {
+		s.logger.Error("failed to get access policy from cache:", slog.String("error", err.Error()))
+	}
+
+	policy = s.accessConfig.GetPolicy()
+
+	err = s.policyCache.Set(ctx, policy)
+	if err != nil {
+		s.logger.Error("failed to set access policy to cache:", slog.String("error", err.Error()))
+	}
+
+	return policy
+}
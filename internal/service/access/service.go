@@ -0,0 +1,41 @@
+package access
+
+import (
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository"
+	svc "github.com/Paul1k96/microservices_course_auth/internal/service"
+)
+
+type service struct {
+	logger *slog.Logger
+
+	policyCache repository.AccessPolicyCache
+
+	sessionService svc.SessionService
+	roleService    svc.RoleService
+
+	accessConfig            config.AccessConfig
+	emailVerificationConfig config.EmailVerificationConfig
+}
+
+// NewService creates a new access service.
+func NewService(
+	logger *slog.Logger,
+	policyCache repository.AccessPolicyCache,
+	sessionService svc.SessionService,
+	roleService svc.RoleService,
+	accessConfig config.AccessConfig,
+	emailVerificationConfig config.EmailVerificationConfig,
+) svc.AccessService {
+	return &service{
+		logger:         logger,
+		policyCache:    policyCache,
+		sessionService: sessionService,
+		roleService:    roleService,
+		accessConfig:   accessConfig,
+
+		emailVerificationConfig: emailVerificationConfig,
+	}
+}
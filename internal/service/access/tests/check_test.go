@@ -0,0 +1,266 @@
+package tests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	"github.com/Paul1k96/microservices_course_auth/internal/service/access"
+	svcMocks "github.com/Paul1k96/microservices_course_auth/internal/service/mocks"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	adminEndpoint = "/chat_v1.Chat/Delete"
+	userEndpoint  = "/chat_v1.Chat/SendMessage"
+
+	deletePermission = "chat.delete"
+	sendPermission   = "chat.send_message"
+)
+
+func TestCheckSuite(t *testing.T) {
+	suite.Run(t, new(CheckSuite))
+}
+
+type CheckSuite struct {
+	suite.Suite
+	*require.Assertions
+	ctrl *gomock.Controller
+
+	policyCache  *mocks.MockAccessPolicyCache
+	sessions     *svcMocks.MockSessionService
+	roles        *svcMocks.MockRoleService
+	accessConfig *accessConfig
+	emailConfig  *emailVerificationConfig
+
+	service service.AccessService
+}
+
+func (t *CheckSuite) SetupTest() {
+	t.Assertions = require.New(t.T())
+	t.ctrl = gomock.NewController(t.T())
+
+	t.policyCache = mocks.NewMockAccessPolicyCache(t.ctrl)
+	t.sessions = svcMocks.NewMockSessionService(t.ctrl)
+	t.roles = svcMocks.NewMockRoleService(t.ctrl)
+	t.accessConfig = &accessConfig{
+		policy: map[string][]string{
+			adminEndpoint: {deletePermission},
+			userEndpoint:  {sendPermission},
+		},
+	}
+
+	t.emailConfig = &emailVerificationConfig{}
+
+	t.service = access.NewService(slog.Default(), t.policyCache, t.sessions, t.roles, t.accessConfig, t.emailConfig)
+}
+
+func (t *CheckSuite) TearDownTest() {
+	t.ctrl.Finish()
+}
+
+func (t *CheckSuite) accessToken(ctx context.Context, role string) (string, int64) {
+	accessToken := gofakeit.LetterN(64)
+	userID := gofakeit.Int64()
+
+	t.sessions.EXPECT().Authenticate(gomock.Any(), accessToken).Return(&model.UserClaims{
+		ID:     gofakeit.UUID(),
+		UserID: userID,
+		OrgID:  model.DefaultOrgID,
+		Roles:  []string{role},
+		MFA:    true,
+	}, nil)
+
+	return accessToken, userID
+}
+
+func (t *CheckSuite) cachedPolicy() model.AccessPolicy {
+	return model.AccessPolicy{
+		adminEndpoint: {deletePermission},
+		userEndpoint:  {sendPermission},
+	}
+}
+
+func (t *CheckSuite) TestCheck_Allowed() {
+	ctx := context.Background()
+	accessToken, userID := t.accessToken(ctx, model.RoleUser)
+
+	t.policyCache.EXPECT().Get(ctx).Return(t.cachedPolicy(), nil)
+	t.roles.EXPECT().HasPermission(ctx, userID, model.DefaultOrgID, sendPermission).Return(true, nil)
+
+	err := t.service.Check(ctx, accessToken, userEndpoint)
+	t.Require().NoError(err)
+}
+
+func (t *CheckSuite) TestCheck_AnyPermission() {
+	ctx := context.Background()
+	accessToken, userID := t.accessToken(ctx, model.RoleUser)
+	policy := model.AccessPolicy{userEndpoint: {deletePermission, sendPermission}}
+
+	t.policyCache.EXPECT().Get(ctx).Return(policy, nil)
+	t.roles.EXPECT().HasPermission(ctx, userID, model.DefaultOrgID, deletePermission).Return(false, nil)
+	t.roles.EXPECT().HasPermission(ctx, userID, model.DefaultOrgID, sendPermission).Return(true, nil)
+
+	err := t.service.Check(ctx, accessToken, userEndpoint)
+	t.Require().NoError(err)
+}
+
+func (t *CheckSuite) TestCheck_Denied() {
+	ctx := context.Background()
+	accessToken, userID := t.accessToken(ctx, model.RoleUser)
+
+	t.policyCache.EXPECT().Get(ctx).Return(t.cachedPolicy(), nil)
+	t.roles.EXPECT().HasPermission(ctx, userID, model.DefaultOrgID, deletePermission).Return(false, nil)
+
+	err := t.service.Check(ctx, accessToken, adminEndpoint)
+	t.Require().ErrorIs(err, errs.ErrAccessDenied)
+}
+
+func (t *CheckSuite) TestCheck_PermissionError() {
+	ctx := context.Background()
+	accessToken, userID := t.accessToken(ctx, model.RoleUser)
+
+	t.policyCache.EXPECT().Get(ctx).Return(t.cachedPolicy(), nil)
+	t.roles.EXPECT().HasPermission(ctx, userID, model.DefaultOrgID, sendPermission).
+		Return(false, errors.New("connection refused"))
+
+	err := t.service.Check(ctx, accessToken, userEndpoint)
+	t.Require().Error(err)
+	t.Require().NotErrorIs(err, errs.ErrAccessDenied)
+}
+
+func (t *CheckSuite) TestCheck_UnknownEndpoint() {
+	ctx := context.Background()
+	accessToken, _ := t.accessToken(ctx, model.RoleAdmin)
+
+	t.policyCache.EXPECT().Get(ctx).Return(t.cachedPolicy(), nil)
+
+	err := t.service.Check(ctx, accessToken, "/chat_v1.Chat/Unknown")
+	t.Require().ErrorIs(err, errs.ErrAccessDenied)
+}
+
+func (t *CheckSuite) TestCheck_PolicyNotCached() {
+	ctx := context.Background()
+	accessToken, userID := t.accessToken(ctx, model.RoleAdmin)
+
+	t.policyCache.EXPECT().Get(ctx).Return(nil, errs.ErrAccessPolicyNotFound)
+	t.policyCache.EXPECT().Set(ctx, t.cachedPolicy()).Return(nil)
+	t.roles.EXPECT().HasPermission(ctx, userID, model.DefaultOrgID, deletePermission).Return(true, nil)
+
+	err := t.service.Check(ctx, accessToken, adminEndpoint)
+	t.Require().NoError(err)
+}
+
+func (t *CheckSuite) TestCheck_CacheError() {
+	ctx := context.Background()
+	accessToken, userID := t.accessToken(ctx, model.RoleAdmin)
+
+	t.policyCache.EXPECT().Get(ctx).Return(nil, errors.New("connection refused"))
+	t.policyCache.EXPECT().Set(ctx, gomock.Any()).Return(errors.New("connection refused"))
+	t.roles.EXPECT().HasPermission(ctx, userID, model.DefaultOrgID, deletePermission).Return(true, nil)
+
+	err := t.service.Check(ctx, accessToken, adminEndpoint)
+	t.Require().NoError(err)
+}
+
+func (t *CheckSuite) TestCheck_InvalidToken() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+
+	t.sessions.EXPECT().Authenticate(gomock.Any(), accessToken).Return(nil, errs.ErrInvalidAccessToken)
+
+	err := t.service.Check(ctx, accessToken, userEndpoint)
+	t.Require().ErrorIs(err, errs.ErrInvalidAccessToken)
+}
+
+func (t *CheckSuite) TestCheck_EmailNotVerified() {
+	ctx := context.Background()
+	accessToken, _ := t.accessToken(ctx, model.RoleAdmin)
+	t.emailConfig.requiredForAccess = true
+
+	err := t.service.Check(ctx, accessToken, adminEndpoint)
+	t.Require().ErrorIs(err, errs.ErrEmailNotVerified)
+}
+
+func (t *CheckSuite) TestCheck_EmailVerified() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	t.emailConfig.requiredForAccess = true
+
+	claims := &model.UserClaims{
+		ID:            gofakeit.UUID(),
+		UserID:        gofakeit.Int64(),
+		Roles:         []string{model.RoleAdmin},
+		EmailVerified: true,
+		MFA:           true,
+	}
+
+	t.sessions.EXPECT().Authenticate(gomock.Any(), accessToken).Return(claims, nil)
+	t.policyCache.EXPECT().Get(ctx).Return(t.cachedPolicy(), nil)
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, deletePermission).Return(true, nil)
+
+	err := t.service.Check(ctx, accessToken, adminEndpoint)
+	t.Require().NoError(err)
+}
+
+func (t *CheckSuite) TestCheck_AdminWithoutMFA() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+
+	t.sessions.EXPECT().Authenticate(gomock.Any(), accessToken).Return(&model.UserClaims{
+		ID:     gofakeit.UUID(),
+		UserID: gofakeit.Int64(),
+		Roles:  []string{model.RoleAdmin},
+	}, nil)
+
+	err := t.service.Check(ctx, accessToken, userEndpoint)
+	t.Require().ErrorIs(err, errs.ErrMFARequired)
+}
+
+func (t *CheckSuite) TestCheck_APIKeyScopes() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+
+	claims := &model.UserClaims{
+		ID:       gofakeit.UUID(),
+		UserID:   gofakeit.Int64(),
+		Roles:    []string{model.RoleUser},
+		APIKeyID: gofakeit.UUID(),
+		Scopes:   []string{"/chat_v1.Chat/Delete"},
+	}
+
+	t.sessions.EXPECT().Authenticate(gomock.Any(), accessToken).Return(claims, nil).Times(2)
+	t.policyCache.EXPECT().Get(ctx).Return(t.cachedPolicy(), nil)
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, deletePermission).Return(false, nil)
+
+	err := t.service.Check(ctx, accessToken, userEndpoint)
+	t.Require().ErrorIs(err, errs.ErrAccessDenied)
+
+	err = t.service.Check(ctx, accessToken, adminEndpoint)
+	t.Require().ErrorIs(err, errs.ErrAccessDenied)
+}
+
+func (t *CheckSuite) TestCheck_OAuthClient() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+
+	t.sessions.EXPECT().Authenticate(gomock.Any(), accessToken).Return(&model.UserClaims{
+		ID:       gofakeit.UUID(),
+		ClientID: gofakeit.UUID(),
+		Scopes:   []string{"/chat_v1.Chat/*"},
+	}, nil).Times(2)
+
+	err := t.service.Check(ctx, accessToken, adminEndpoint)
+	t.Require().NoError(err)
+
+	err = t.service.Check(ctx, accessToken, "/user_v1.User/Get")
+	t.Require().ErrorIs(err, errs.ErrAccessDenied)
+}
@@ -0,0 +1,22 @@
+package tests
+
+import (
+	"time"
+)
+
+type accessConfig struct {
+	policy map[string][]string
+}
+
+func (c *accessConfig) GetPolicy() map[string][]string          { return c.policy }
+func (c *accessConfig) GetPolicyCacheTTL() time.Duration        { return time.Minute }
+func (c *accessConfig) GetRolePermissions() map[string][]string { return nil }
+
+type emailVerificationConfig struct {
+	requiredForAccess bool
+}
+
+func (c *emailVerificationConfig) GetTokenTTL() time.Duration { return time.Hour }
+func (c *emailVerificationConfig) GetURL() string             { return "https://auth.example.com/email/verify" }
+func (c *emailVerificationConfig) IsRequiredForLogin() bool   { return false }
+func (c *emailVerificationConfig) IsRequiredForAccess() bool  { return c.requiredForAccess }
@@ -0,0 +1,96 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/google/uuid"
+)
+
+// Create issues a new API key of the access token owner limited to scopes.
+// The key itself is returned only here. API keys, tokens of OAuth clients and impersonation tokens
+// cannot be used to create keys.
+func (s *service) Create(
+	ctx context.Context,
+	accessToken, name string,
+	scopes []string,
+	expiresAt *time.Time,
+) (*model.CreatedAPIKey, error) {
+	claims, err := s.sessionService.VerifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, fmt.Errorf("verify access token: %w", err)
+	}
+
+	if claims.APIKeyID != "" {
+		return nil, fmt.Errorf("create api key with api key %s: %w", claims.APIKeyID, errs.ErrAccessDenied)
+	}
+
+	if claims.ClientID != "" {
+		return nil, fmt.Errorf("create api key with token of oauth client %s: %w", claims.ClientID, errs.ErrAccessDenied)
+	}
+
+	if claims.IsImpersonated() {
+		return nil, fmt.Errorf("create api key as admin %d: %w", claims.ActorID, errs.ErrImpersonatedSession)
+	}
+
+	if claims.HasRole(model.RoleAdmin) && !claims.MFA {
+		return nil, fmt.Errorf("create api key without mfa: %w", errs.ErrMFARequired)
+	}
+
+	now := time.Now()
+
+	violations := validate(scopes, expiresAt, now)
+	if len(violations) > 0 {
+		return nil, errs.NewValidationError(errs.ErrInvalidAPIKey, violations...)
+	}
+
+	key, prefix, err := token.GenerateAPIKey()
+	if err != nil {
+		return nil, fmt.Errorf("generate api key: %w", err)
+	}
+
+	apiKey := &model.APIKey{
+		ID:        uuid.New(),
+		UserID:    claims.UserID,
+		Name:      name,
+		Prefix:    prefix,
+		KeyHash:   token.Hash(key),
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}
+
+	err = s.apiKeys.Create(ctx, apiKey)
+	if err != nil {
+		return nil, fmt.Errorf("create api key: %w", err)
+	}
+
+	return &model.CreatedAPIKey{Key: key, APIKey: apiKey}, nil
+}
+
+func validate(scopes []string, expiresAt *time.Time, now time.Time) []errs.FieldViolation {
+	var violations []errs.FieldViolation
+
+	if len(scopes) == 0 {
+		violations = append(violations, errs.FieldViolation{Field: "scopes", Description: "must not be empty"})
+	}
+
+	for _, scope := range scopes {
+		if !model.IsValidScope(scope) {
+			violations = append(violations, errs.FieldViolation{
+				Field:       "scopes",
+				Description: fmt.Sprintf("%q must be a method like /pkg.Service/Method or /pkg.Service/*", scope),
+			})
+		}
+	}
+
+	if expiresAt != nil && !expiresAt.After(now) {
+		violations = append(violations, errs.FieldViolation{Field: "expires_at", Description: "must be in the future"})
+	}
+
+	return violations
+}
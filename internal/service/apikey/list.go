@@ -0,0 +1,23 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// List returns not revoked API keys of the access token owner.
+func (s *service) List(ctx context.Context, accessToken string) ([]*model.APIKey, error) {
+	claims, err := s.sessionService.VerifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, fmt.Errorf("verify access token: %w", err)
+	}
+
+	keys, err := s.apiKeys.GetByUserID(ctx, claims.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("get api keys by user id: %w", err)
+	}
+
+	return keys, nil
+}
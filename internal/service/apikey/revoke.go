@@ -0,0 +1,25 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Revoke revokes the API key of the access token owner. Keys are checked against
+// the database on every use, so the key stops working immediately.
+func (s *service) Revoke(ctx context.Context, accessToken string, id uuid.UUID) error {
+	claims, err := s.sessionService.VerifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return fmt.Errorf("verify access token: %w", err)
+	}
+
+	err = s.apiKeys.Revoke(ctx, claims.UserID, id, time.Now())
+	if err != nil {
+		return fmt.Errorf("revoke api key: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package apikey
+
+import (
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/repository"
+	svc "github.com/Paul1k96/microservices_course_auth/internal/service"
+)
+
+type service struct {
+	logger *slog.Logger
+
+	apiKeys repository.APIKeysRepository
+
+	sessionService svc.SessionService
+}
+
+// NewService creates a new API key service.
+func NewService(
+	logger *slog.Logger,
+	apiKeys repository.APIKeysRepository,
+	sessionService svc.SessionService,
+) svc.APIKeyService {
+	return &service{
+		logger:         logger,
+		apiKeys:        apiKeys,
+		sessionService: sessionService,
+	}
+}
@@ -0,0 +1,122 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateSuite(t *testing.T) {
+	suite.Run(t, new(CreateSuite))
+}
+
+type CreateSuite struct {
+	apiKeySuite
+}
+
+func (t *CreateSuite) TestCreate_Ok() {
+	ctx := context.Background()
+	claims := &model.UserClaims{ID: gofakeit.UUID(), UserID: gofakeit.Int64(), Roles: []string{model.RoleUser}}
+	accessToken := t.accessToken(ctx, claims)
+	scopes := []string{"/chat_v1.Chat/*", "/user_v1.User/Get"}
+	expiresAt := time.Now().Add(24 * time.Hour)
+
+	var stored *model.APIKey
+	t.apiKeys.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, key *model.APIKey) error {
+		stored = key
+		return nil
+	})
+
+	created, err := t.service.Create(ctx, accessToken, "ci", scopes, &expiresAt)
+	t.Require().NoError(err)
+	t.Require().True(token.IsAPIKey(created.Key))
+	t.Require().Equal(stored, created.APIKey)
+	t.Require().Equal(claims.UserID, stored.UserID)
+	t.Require().Equal(scopes, stored.Scopes)
+	t.Require().Equal(token.Hash(created.Key), stored.KeyHash)
+	t.Require().NotContains(stored.KeyHash, created.Key)
+
+	prefix, ok := token.APIKeyPrefix(created.Key)
+	t.Require().True(ok)
+	t.Require().Equal(prefix, stored.Prefix)
+}
+
+func (t *CreateSuite) TestCreate_InvalidRequest() {
+	ctx := context.Background()
+	claims := &model.UserClaims{ID: gofakeit.UUID(), UserID: gofakeit.Int64(), Roles: []string{model.RoleUser}}
+	accessToken := t.accessToken(ctx, claims)
+	expiresAt := time.Now().Add(-time.Hour)
+
+	created, err := t.service.Create(ctx, accessToken, "ci", []string{"chat", "/chat_v1.Chat/"}, &expiresAt)
+	t.Require().Nil(created)
+	t.Require().ErrorIs(err, errs.ErrInvalidAPIKey)
+
+	var validationErr *errs.ValidationError
+	t.Require().True(errors.As(err, &validationErr))
+	t.Require().Len(validationErr.Violations, 3)
+}
+
+func (t *CreateSuite) TestCreate_WithAPIKey() {
+	ctx := context.Background()
+	claims := &model.UserClaims{
+		ID:       uuid.NewString(),
+		UserID:   gofakeit.Int64(),
+		Roles:    []string{model.RoleUser},
+		APIKeyID: uuid.NewString(),
+	}
+	accessToken := t.accessToken(ctx, claims)
+
+	created, err := t.service.Create(ctx, accessToken, "ci", []string{"/chat_v1.Chat/*"}, nil)
+	t.Require().Nil(created)
+	t.Require().ErrorIs(err, errs.ErrAccessDenied)
+}
+
+func (t *CreateSuite) TestCreate_WithOAuthClientToken() {
+	ctx := context.Background()
+	claims := &model.UserClaims{
+		ID:       uuid.NewString(),
+		UserID:   gofakeit.Int64(),
+		Roles:    []string{model.RoleUser},
+		ClientID: uuid.NewString(),
+		Scopes:   []string{model.ScopeOpenID},
+	}
+	accessToken := t.accessToken(ctx, claims)
+
+	created, err := t.service.Create(ctx, accessToken, "ci", []string{"/user_v1.User/*"}, nil)
+	t.Require().Nil(created)
+	t.Require().ErrorIs(err, errs.ErrAccessDenied)
+}
+
+func (t *CreateSuite) TestCreate_Impersonated() {
+	ctx := context.Background()
+	claims := &model.UserClaims{
+		ID:      gofakeit.UUID(),
+		UserID:  gofakeit.Int64(),
+		Roles:   []string{model.RoleUser},
+		ActorID: gofakeit.Int64(),
+	}
+	accessToken := t.accessToken(ctx, claims)
+
+	created, err := t.service.Create(ctx, accessToken, "ci", []string{"/chat_v1.Chat/*"}, nil)
+	t.Require().Nil(created)
+	t.Require().ErrorIs(err, errs.ErrImpersonatedSession)
+}
+
+func (t *CreateSuite) TestCreate_AdminWithoutMFA() {
+	ctx := context.Background()
+	claims := &model.UserClaims{ID: gofakeit.UUID(), UserID: gofakeit.Int64(), Roles: []string{model.RoleAdmin}}
+	accessToken := t.accessToken(ctx, claims)
+
+	created, err := t.service.Create(ctx, accessToken, "ci", []string{"/chat_v1.Chat/*"}, nil)
+	t.Require().Nil(created)
+	t.Require().ErrorIs(err, errs.ErrMFARequired)
+}
@@ -0,0 +1,45 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRevokeSuite(t *testing.T) {
+	suite.Run(t, new(RevokeSuite))
+}
+
+type RevokeSuite struct {
+	apiKeySuite
+}
+
+func (t *RevokeSuite) TestRevoke_Ok() {
+	ctx := context.Background()
+	claims := &model.UserClaims{ID: gofakeit.UUID(), UserID: gofakeit.Int64(), Roles: []string{model.RoleUser}}
+	accessToken := t.accessToken(ctx, claims)
+	id := uuid.New()
+
+	t.apiKeys.EXPECT().Revoke(ctx, claims.UserID, id, gomock.Any()).Return(nil)
+
+	err := t.service.Revoke(ctx, accessToken, id)
+	t.Require().NoError(err)
+}
+
+func (t *RevokeSuite) TestRevoke_NotFound() {
+	ctx := context.Background()
+	claims := &model.UserClaims{ID: gofakeit.UUID(), UserID: gofakeit.Int64(), Roles: []string{model.RoleUser}}
+	accessToken := t.accessToken(ctx, claims)
+	id := uuid.New()
+
+	t.apiKeys.EXPECT().Revoke(ctx, claims.UserID, id, gomock.Any()).Return(errs.ErrAPIKeyNotFound)
+
+	err := t.service.Revoke(ctx, accessToken, id)
+	t.Require().ErrorIs(err, errs.ErrAPIKeyNotFound)
+}
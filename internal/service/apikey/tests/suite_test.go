@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	"github.com/Paul1k96/microservices_course_auth/internal/service/apikey"
+	svcMocks "github.com/Paul1k96/microservices_course_auth/internal/service/mocks"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type apiKeySuite struct {
+	suite.Suite
+	*require.Assertions
+	ctrl *gomock.Controller
+
+	apiKeys  *mocks.MockAPIKeysRepository
+	sessions *svcMocks.MockSessionService
+
+	service service.APIKeyService
+}
+
+func (t *apiKeySuite) SetupTest() {
+	t.Assertions = require.New(t.T())
+	t.ctrl = gomock.NewController(t.T())
+
+	t.apiKeys = mocks.NewMockAPIKeysRepository(t.ctrl)
+	t.sessions = svcMocks.NewMockSessionService(t.ctrl)
+
+	t.service = apikey.NewService(slog.Default(), t.apiKeys, t.sessions)
+}
+
+func (t *apiKeySuite) TearDownTest() {
+	t.ctrl.Finish()
+}
+
+func (t *apiKeySuite) accessToken(ctx context.Context, claims *model.UserClaims) string {
+	accessToken := gofakeit.LetterN(64)
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+
+	return accessToken
+}
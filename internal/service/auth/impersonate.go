@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// Impersonate issues a short-lived token letting the admin act as the user of their organization.
+// The caller must be allowed to impersonate users and may not be impersonating someone already
+// nor act through an API key or OAuth client. Users granted permissions the admin lacks cannot be
+// impersonated, so the token never lets the admin do more than they can themselves.
+// The reason is recorded with the event of the admin, as is every call made with the token.
+func (s *service) Impersonate(
+	ctx context.Context,
+	accessToken string,
+	userID int64,
+	reason string,
+) (*model.ImpersonationToken, error) {
+	claims, err := s.sessionService.VerifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, fmt.Errorf("verify access token: %w", err)
+	}
+
+	if claims.IsImpersonated() {
+		return nil, fmt.Errorf("impersonate user as admin %d: %w", claims.ActorID, errs.ErrImpersonatedSession)
+	}
+
+	if claims.APIKeyID != "" || claims.ClientID != "" {
+		return nil, fmt.Errorf("impersonate user as user %d: %w", claims.UserID, errs.ErrAccessDenied)
+	}
+
+	granted, err := s.roleService.HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionImpersonateUsers)
+	if err != nil {
+		return nil, fmt.Errorf("check permission: %w", err)
+	}
+
+	if !granted {
+		return nil, fmt.Errorf("impersonate user as user %d: %w", claims.UserID, errs.ErrAccessDenied)
+	}
+
+	if !claims.MFA {
+		return nil, fmt.Errorf("impersonate user without mfa: %w", errs.ErrMFARequired)
+	}
+
+	user, err := s.userService.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get user by id: %w", err)
+	}
+
+	if user.OrgID != claims.OrgID {
+		return nil, fmt.Errorf("impersonate user of organization %d: %w", user.OrgID, errs.ErrUserNotFound)
+	}
+
+	err = s.checkPermissionsSubset(ctx, claims, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	impersonation, err := s.sessionService.IssueImpersonation(ctx, claims.UserID, user)
+	if err != nil {
+		return nil, fmt.Errorf("issue impersonation token: %w", err)
+	}
+
+	event := model.NewImpersonationStartedEvent(
+		claims.UserID,
+		user.ID,
+		impersonation.ID,
+		reason,
+		impersonation.ExpiresAt,
+	)
+
+	err = s.events.Save(ctx, event.WithOrgID(user.OrgID))
+	if err != nil {
+		s.logger.Error("failed to save user event:", slog.String("error", err.Error()))
+	}
+
+	return impersonation, nil
+}
+
+// checkPermissionsSubset makes sure the user holds no permission in the organization of the admin
+// the admin does not hold.
+func (s *service) checkPermissionsSubset(ctx context.Context, claims *model.UserClaims, userID int64) error {
+	actorPermissions, err := s.roleService.Permissions(ctx, claims.UserID, claims.OrgID)
+	if err != nil {
+		return fmt.Errorf("get permissions of admin: %w", err)
+	}
+
+	userPermissions, err := s.roleService.Permissions(ctx, userID, claims.OrgID)
+	if err != nil {
+		return fmt.Errorf("get permissions of user: %w", err)
+	}
+
+	for _, permission := range userPermissions {
+		if !slices.Contains(actorPermissions, permission) {
+			return fmt.Errorf("impersonate user %d granted %s: %w", userID, permission, errs.ErrAccessDenied)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// ListSessions returns active sessions of the user. Users list their own sessions,
+// holders of the manage sessions permission list anyone's.
+func (s *service) ListSessions(ctx context.Context, accessToken string, userID int64) ([]*model.Session, error) {
+	claims, err := s.sessionService.VerifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, fmt.Errorf("verify access token: %w", err)
+	}
+
+	if claims.UserID != userID {
+		granted, err := s.roleService.HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionManageSessions)
+		if err != nil {
+			return nil, fmt.Errorf("check permission: %w", err)
+		}
+
+		if !granted {
+			return nil, fmt.Errorf("list sessions of user %d as user %d: %w", userID, claims.UserID, errs.ErrAccessDenied)
+		}
+
+		if !claims.MFA {
+			return nil, fmt.Errorf("list sessions without mfa: %w", errs.ErrMFARequired)
+		}
+	}
+
+	sessions, err := s.sessions.GetActiveByUserID(ctx, userID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("get sessions of user %d: %w", userID, err)
+	}
+
+	return sessions, nil
+}
@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/google/uuid"
+)
+
+// Login checks credentials of the user in the organization and issues a new pair of tokens.
+// Failed attempts are throttled per account and client address.
+// Users with unverified email are refused if configured so.
+// Users with two-factor authentication get an MFA token to complete the login with VerifyMFA instead.
+func (s *service) Login(ctx context.Context, orgID int64, email, password, ip string) (*model.LoginResult, error) {
+	err := s.lockoutService.Check(ctx, orgID, email, ip)
+	if err != nil {
+		if errors.Is(err, errs.ErrAccountLocked) || errors.Is(err, errs.ErrTooManyLoginAttempts) {
+			return nil, err
+		}
+
+		s.logger.Error("failed to check login lockout:", slog.String("error", err.Error()))
+	}
+
+	user, err := s.userService.VerifyCredentials(ctx, orgID, email, password)
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidCredentials) {
+			if lockoutErr := s.lockoutService.RegisterFailure(ctx, orgID, email, ip); lockoutErr != nil {
+				s.logger.Error("failed to register login failure:", slog.String("error", lockoutErr.Error()))
+			}
+		}
+
+		return nil, fmt.Errorf("verify credentials: %w", err)
+	}
+
+	err = s.lockoutService.Reset(ctx, orgID, email)
+	if err != nil {
+		s.logger.Error("failed to reset login attempts:", slog.String("error", err.Error()))
+	}
+
+	if s.emailVerificationConfig.IsRequiredForLogin() && user.EmailVerifiedAt == nil {
+		return nil, errs.ErrEmailNotVerified
+	}
+
+	if user.IsMFAEnabled() {
+		mfaToken, err := s.mfaService.Challenge(ctx, user)
+		if err != nil {
+			return nil, fmt.Errorf("challenge mfa: %w", err)
+		}
+
+		return &model.LoginResult{MFAToken: mfaToken}, nil
+	}
+
+	tokens, err := s.sessionService.Issue(ctx, user, uuid.New(), false)
+	if err != nil {
+		return nil, fmt.Errorf("issue tokens: %w", err)
+	}
+
+	return &model.LoginResult{Tokens: tokens}, nil
+}
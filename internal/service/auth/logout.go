@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/pkg/errors"
+)
+
+// Logout revokes the caller's access token and the refresh token family it was issued with.
+func (s *service) Logout(ctx context.Context, accessToken, refreshToken string) error {
+	claims, err := s.sessionService.VerifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return fmt.Errorf("verify access token: %w", err)
+	}
+
+	_, err = token.Verify(refreshToken, token.TypeRefresh, s.tokenConfig.GetRefreshTokenSecretKey())
+	if err != nil {
+		return fmt.Errorf("verify refresh token: %w", errs.ErrInvalidRefreshToken)
+	}
+
+	if txErr := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		stored, err := s.refreshTokens.GetByHashForUpdate(ctx, token.Hash(refreshToken))
+		if err != nil {
+			return fmt.Errorf("get refresh token: %w", err)
+		}
+
+		if stored.UserID != claims.UserID {
+			return fmt.Errorf("refresh token of another user: %w", errs.ErrInvalidRefreshToken)
+		}
+
+		err = s.sessionService.RevokeFamily(ctx, stored.FamilyID)
+		if err != nil {
+			return fmt.Errorf("revoke refresh token family: %w", err)
+		}
+
+		return nil
+	}); txErr != nil {
+		if errors.Is(txErr, errs.ErrRefreshTokenNotFound) {
+			return fmt.Errorf("logout: %w", errs.ErrInvalidRefreshToken)
+		}
+
+		return txErr
+	}
+
+	err = s.sessionService.RevokeAccessToken(ctx, claims)
+	if err != nil {
+		return fmt.Errorf("revoke access token: %w", err)
+	}
+
+	return nil
+}
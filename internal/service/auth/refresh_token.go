@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// RefreshToken exchanges refresh token for a new pair of tokens.
+// Presenting an already rotated refresh token revokes its whole family. The tokens carry the second factor
+// only if the session of the family was opened with it.
+func (s *service) RefreshToken(ctx context.Context, refreshToken string) (*model.TokenPair, error) {
+	_, err := token.Verify(refreshToken, token.TypeRefresh, s.tokenConfig.GetRefreshTokenSecretKey())
+	if err != nil {
+		return nil, fmt.Errorf("verify refresh token: %w", errs.ErrInvalidRefreshToken)
+	}
+
+	var (
+		tokens  *model.TokenPair
+		reused  *model.RefreshToken
+		invalid bool
+	)
+
+	if txErr := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		stored, err := s.refreshTokens.GetByHashForUpdate(ctx, token.Hash(refreshToken))
+		if err != nil {
+			return fmt.Errorf("get refresh token: %w", err)
+		}
+
+		now := time.Now()
+
+		switch {
+		case stored.RevokedAt != nil, !stored.ExpiresAt.After(now):
+			invalid = true
+			return nil
+		case stored.RotatedAt != nil:
+			err = s.sessionService.RevokeFamily(ctx, stored.FamilyID)
+			if err != nil {
+				return fmt.Errorf("revoke refresh token family: %w", err)
+			}
+
+			reused = stored
+			return nil
+		}
+
+		err = s.refreshTokens.MarkRotated(ctx, stored.ID, now)
+		if err != nil {
+			return fmt.Errorf("mark refresh token rotated: %w", err)
+		}
+
+		user, err := s.userService.GetByID(ctx, stored.UserID)
+		if err != nil {
+			return fmt.Errorf("get user by id: %w", err)
+		}
+
+		mfa, err := s.sessionMFA(ctx, stored.FamilyID)
+		if err != nil {
+			return err
+		}
+
+		tokens, err = s.sessionService.Issue(ctx, user, stored.FamilyID, mfa)
+		if err != nil {
+			return fmt.Errorf("issue tokens: %w", err)
+		}
+
+		return nil
+	}); txErr != nil {
+		if errors.Is(txErr, errs.ErrRefreshTokenNotFound) {
+			return nil, fmt.Errorf("refresh token: %w", errs.ErrInvalidRefreshToken)
+		}
+
+		return nil, txErr
+	}
+
+	if reused != nil {
+		s.logger.Warn("refresh token reuse detected, token family revoked",
+			slog.Int64("user_id", reused.UserID),
+			slog.String("family_id", reused.FamilyID.String()),
+		)
+
+		event := model.NewRefreshTokenReusedEvent(reused.UserID, reused.FamilyID)
+		if user, err := s.userService.GetByID(ctx, reused.UserID); err == nil {
+			event.WithOrgID(user.OrgID)
+		}
+
+		err = s.events.Save(ctx, event)
+		if err != nil {
+			s.logger.Error("failed to save user event:", slog.String("error", err.Error()))
+		}
+
+		return nil, fmt.Errorf("refresh token reused: %w", errs.ErrInvalidRefreshToken)
+	}
+
+	if invalid {
+		return nil, fmt.Errorf("refresh token revoked or expired: %w", errs.ErrInvalidRefreshToken)
+	}
+
+	return tokens, nil
+}
+
+// sessionMFA reports whether the session of the refresh token family was opened with the second factor.
+// Families issued before sessions were recorded have no session and are treated as opened without it.
+func (s *service) sessionMFA(ctx context.Context, familyID uuid.UUID) (bool, error) {
+	session, err := s.sessions.GetByID(ctx, familyID)
+	if err != nil {
+		if errors.Is(err, errs.ErrSessionNotFound) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("get session: %w", err)
+	}
+
+	return session.MFA, nil
+}
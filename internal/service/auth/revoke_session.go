@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/google/uuid"
+)
+
+// RevokeSession signs the session out by revoking its refresh token family.
+// Users revoke their own sessions, holders of the manage sessions permission revoke anyone's.
+// Sessions of other users look like missing ones.
+func (s *service) RevokeSession(ctx context.Context, accessToken string, sessionID uuid.UUID) error {
+	claims, err := s.sessionService.VerifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return fmt.Errorf("verify access token: %w", err)
+	}
+
+	session, err := s.sessions.GetByID(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("get session %s: %w", sessionID, err)
+	}
+
+	if session.UserID != claims.UserID {
+		granted, err := s.roleService.HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionManageSessions)
+		if err != nil {
+			return fmt.Errorf("check permission: %w", err)
+		}
+
+		if !granted {
+			return fmt.Errorf("revoke session %s as user %d: %w", sessionID, claims.UserID, errs.ErrSessionNotFound)
+		}
+
+		if !claims.MFA {
+			return fmt.Errorf("revoke session without mfa: %w", errs.ErrMFARequired)
+		}
+	}
+
+	if session.RevokedAt != nil {
+		return fmt.Errorf("revoke session %s: %w", sessionID, errs.ErrSessionNotFound)
+	}
+
+	err = s.sessionService.RevokeFamily(ctx, session.ID)
+	if err != nil {
+		return fmt.Errorf("revoke session %s: %w", sessionID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// RevokeUserTokens revokes every session of the user, the caller must be allowed to revoke tokens.
+func (s *service) RevokeUserTokens(ctx context.Context, accessToken string, userID int64) error {
+	claims, err := s.sessionService.VerifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return fmt.Errorf("verify access token: %w", err)
+	}
+
+	granted, err := s.roleService.HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionRevokeTokens)
+	if err != nil {
+		return fmt.Errorf("check permission: %w", err)
+	}
+
+	if !granted {
+		return fmt.Errorf("revoke user tokens as user %d: %w", claims.UserID, errs.ErrAccessDenied)
+	}
+
+	if !claims.MFA {
+		return fmt.Errorf("revoke user tokens without mfa: %w", errs.ErrMFARequired)
+	}
+
+	err = s.sessionService.RevokeUserTokens(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("revoke user tokens: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository"
+	svc "github.com/Paul1k96/microservices_course_auth/internal/service"
+	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/db"
+)
+
+type service struct {
+	logger    *slog.Logger
+	txManager db.TxManager
+
+	refreshTokens repository.RefreshTokensRepository
+	sessions      repository.SessionsRepository
+	events        repository.UserEventsRepository
+
+	userService    svc.UserService
+	sessionService svc.SessionService
+	roleService    svc.RoleService
+	lockoutService svc.LockoutService
+	mfaService     svc.MFAService
+	tokenConfig    config.TokenConfig
+
+	emailVerificationConfig config.EmailVerificationConfig
+}
+
+// NewService creates a new auth service.
+func NewService(
+	logger *slog.Logger,
+	txManager db.TxManager,
+	refreshTokens repository.RefreshTokensRepository,
+	sessions repository.SessionsRepository,
+	events repository.UserEventsRepository,
+	userService svc.UserService,
+	sessionService svc.SessionService,
+	roleService svc.RoleService,
+	lockoutService svc.LockoutService,
+	mfaService svc.MFAService,
+	tokenConfig config.TokenConfig,
+	emailVerificationConfig config.EmailVerificationConfig,
+) svc.AuthService {
+	return &service{
+		logger:         logger,
+		txManager:      txManager,
+		refreshTokens:  refreshTokens,
+		sessions:       sessions,
+		events:         events,
+		userService:    userService,
+		sessionService: sessionService,
+		roleService:    roleService,
+		lockoutService: lockoutService,
+		mfaService:     mfaService,
+		tokenConfig:    tokenConfig,
+
+		emailVerificationConfig: emailVerificationConfig,
+	}
+}
@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestImpersonateSuite(t *testing.T) {
+	suite.Run(t, new(ImpersonateSuite))
+}
+
+type ImpersonateSuite struct {
+	authSuite
+}
+
+func (t *ImpersonateSuite) newAdminClaims() *model.UserClaims {
+	return &model.UserClaims{
+		UserID: gofakeit.Int64(),
+		OrgID:  model.DefaultOrgID,
+		Roles:  []string{model.RoleAdmin},
+		MFA:    true,
+	}
+}
+
+func (t *ImpersonateSuite) TestImpersonate_Ok() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	reason := gofakeit.Sentence(5)
+	claims := t.newAdminClaims()
+	usr := tm.NewUser()
+	impersonation := &model.ImpersonationToken{
+		ID:          uuid.NewString(),
+		AccessToken: gofakeit.LetterN(64),
+		ExpiresAt:   time.Now().Add(t.tokenConfig.GetImpersonationTokenTTL()),
+	}
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.roles.EXPECT().
+		HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionImpersonateUsers).
+		Return(true, nil)
+	t.userService.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+	t.roles.EXPECT().Permissions(ctx, claims.UserID, claims.OrgID).
+		Return([]string{model.PermissionImpersonateUsers, model.PermissionManageUsers}, nil)
+	t.roles.EXPECT().Permissions(ctx, usr.ID, claims.OrgID).Return([]string{model.PermissionManageUsers}, nil)
+	t.sessions.EXPECT().IssueImpersonation(ctx, claims.UserID, usr).Return(impersonation, nil)
+	t.eventRepo.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, event *model.UserEvent) error {
+			t.Require().Equal(claims.UserID, event.UserID)
+			t.Require().Equal(usr.ID, event.EntityID)
+			t.Require().Equal(usr.OrgID, event.OrgID)
+			t.Require().Equal(model.UserEventTypeImpersonationStarted, event.Type)
+			t.Require().Equal(&model.ImpersonationStartedEventValue{
+				TokenID:   impersonation.ID,
+				Reason:    reason,
+				ExpiresAt: impersonation.ExpiresAt,
+			}, event.Value.Value())
+			return nil
+		},
+	)
+
+	res, err := t.service.Impersonate(ctx, accessToken, usr.ID, reason)
+	t.Require().NoError(err)
+	t.Require().Equal(impersonation, res)
+}
+
+func (t *ImpersonateSuite) TestImpersonate_UserWithMorePermissions() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	claims := t.newAdminClaims()
+	usr := tm.NewUser()
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.roles.EXPECT().
+		HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionImpersonateUsers).
+		Return(true, nil)
+	t.userService.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+	t.roles.EXPECT().Permissions(ctx, claims.UserID, claims.OrgID).
+		Return([]string{model.PermissionImpersonateUsers}, nil)
+	t.roles.EXPECT().Permissions(ctx, usr.ID, claims.OrgID).
+		Return([]string{model.PermissionImpersonateUsers, model.PermissionManageRoles}, nil)
+
+	res, err := t.service.Impersonate(ctx, accessToken, usr.ID, gofakeit.Sentence(5))
+	t.Require().Nil(res)
+	t.Require().ErrorIs(err, errs.ErrAccessDenied)
+}
+
+func (t *ImpersonateSuite) TestImpersonate_NotAdmin() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	claims := &model.UserClaims{UserID: gofakeit.Int64(), Roles: []string{model.RoleUser}, MFA: true}
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.roles.EXPECT().
+		HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionImpersonateUsers).
+		Return(false, nil)
+
+	res, err := t.service.Impersonate(ctx, accessToken, gofakeit.Int64(), gofakeit.Sentence(5))
+	t.Require().Nil(res)
+	t.Require().ErrorIs(err, errs.ErrAccessDenied)
+}
+
+func (t *ImpersonateSuite) TestImpersonate_AdminWithoutMFA() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	claims := t.newAdminClaims()
+	claims.MFA = false
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.roles.EXPECT().
+		HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionImpersonateUsers).
+		Return(true, nil)
+
+	res, err := t.service.Impersonate(ctx, accessToken, gofakeit.Int64(), gofakeit.Sentence(5))
+	t.Require().Nil(res)
+	t.Require().ErrorIs(err, errs.ErrMFARequired)
+}
+
+func (t *ImpersonateSuite) TestImpersonate_AlreadyImpersonating() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	claims := t.newAdminClaims()
+	claims.ActorID = gofakeit.Int64()
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+
+	res, err := t.service.Impersonate(ctx, accessToken, gofakeit.Int64(), gofakeit.Sentence(5))
+	t.Require().Nil(res)
+	t.Require().ErrorIs(err, errs.ErrImpersonatedSession)
+}
+
+func (t *ImpersonateSuite) TestImpersonate_WithOAuthClientToken() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	claims := t.newAdminClaims()
+	claims.ClientID = uuid.NewString()
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+
+	res, err := t.service.Impersonate(ctx, accessToken, gofakeit.Int64(), gofakeit.Sentence(5))
+	t.Require().Nil(res)
+	t.Require().ErrorIs(err, errs.ErrAccessDenied)
+}
+
+func (t *ImpersonateSuite) TestImpersonate_UserOfOtherOrganization() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	claims := t.newAdminClaims()
+	usr := tm.NewUser()
+	usr.OrgID = claims.OrgID + 1
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.roles.EXPECT().
+		HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionImpersonateUsers).
+		Return(true, nil)
+	t.userService.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+
+	res, err := t.service.Impersonate(ctx, accessToken, usr.ID, gofakeit.Sentence(5))
+	t.Require().Nil(res)
+	t.Require().ErrorIs(err, errs.ErrUserNotFound)
+}
@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListSessionsSuite(t *testing.T) {
+	suite.Run(t, new(ListSessionsSuite))
+}
+
+type ListSessionsSuite struct {
+	authSuite
+}
+
+func (t *ListSessionsSuite) TestListSessions_Own() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	userID := gofakeit.Int64()
+	sessions := []*model.Session{{ID: uuid.New(), UserID: userID}}
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(&model.UserClaims{UserID: userID}, nil)
+	t.sessionRepo.EXPECT().GetActiveByUserID(ctx, userID, gomock.Any()).Return(sessions, nil)
+
+	result, err := t.service.ListSessions(ctx, accessToken, userID)
+	t.Require().NoError(err)
+	t.Require().Equal(sessions, result)
+}
+
+func (t *ListSessionsSuite) TestListSessions_Admin() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	userID := gofakeit.Int64()
+	claims := &model.UserClaims{UserID: userID + 1, Roles: []string{model.RoleAdmin}, MFA: true}
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionManageSessions).Return(true, nil)
+	t.sessionRepo.EXPECT().GetActiveByUserID(ctx, userID, gomock.Any()).Return(nil, nil)
+
+	_, err := t.service.ListSessions(ctx, accessToken, userID)
+	t.Require().NoError(err)
+}
+
+func (t *ListSessionsSuite) TestListSessions_OtherUser() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	userID := gofakeit.Int64()
+	claims := &model.UserClaims{UserID: userID + 1, Roles: []string{model.RoleUser}}
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionManageSessions).Return(false, nil)
+
+	_, err := t.service.ListSessions(ctx, accessToken, userID)
+	t.Require().ErrorIs(err, errs.ErrAccessDenied)
+}
+
+func (t *ListSessionsSuite) TestListSessions_AdminWithoutMFA() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	userID := gofakeit.Int64()
+	claims := &model.UserClaims{UserID: userID + 1, Roles: []string{model.RoleAdmin}}
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionManageSessions).Return(true, nil)
+
+	_, err := t.service.ListSessions(ctx, accessToken, userID)
+	t.Require().ErrorIs(err, errs.ErrMFARequired)
+}
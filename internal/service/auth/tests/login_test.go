@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLoginSuite(t *testing.T) {
+	suite.Run(t, new(LoginSuite))
+}
+
+type LoginSuite struct {
+	authSuite
+}
+
+func (t *LoginSuite) TestLogin_Ok() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	pass := gofakeit.Password(true, true, true, true, false, 12)
+	ip := gofakeit.IPv4Address()
+	pair := t.newTokenPair()
+
+	t.lockout.EXPECT().Check(ctx, model.DefaultOrgID, usr.Email, ip).Return(nil)
+	t.userService.EXPECT().VerifyCredentials(ctx, model.DefaultOrgID, usr.Email, pass).Return(usr, nil)
+	t.lockout.EXPECT().Reset(ctx, model.DefaultOrgID, usr.Email).Return(nil)
+	t.sessions.EXPECT().Issue(ctx, usr, gomock.Not(uuid.Nil), false).Return(pair, nil)
+
+	result, err := t.service.Login(ctx, model.DefaultOrgID, usr.Email, pass, ip)
+	t.Require().NoError(err)
+	t.Require().Equal(&model.LoginResult{Tokens: pair}, result)
+}
+
+func (t *LoginSuite) TestLogin_InvalidCredentials() {
+	ctx := context.Background()
+	email := gofakeit.Email()
+	pass := gofakeit.Password(true, true, true, true, false, 12)
+	ip := gofakeit.IPv4Address()
+
+	t.lockout.EXPECT().Check(ctx, model.DefaultOrgID, email, ip).Return(nil)
+	t.userService.EXPECT().VerifyCredentials(ctx, model.DefaultOrgID, email, pass).Return(nil, errs.ErrInvalidCredentials)
+	t.lockout.EXPECT().RegisterFailure(ctx, model.DefaultOrgID, email, ip).Return(nil)
+
+	result, err := t.service.Login(ctx, model.DefaultOrgID, email, pass, ip)
+	t.Require().Nil(result)
+	t.Require().ErrorIs(err, errs.ErrInvalidCredentials)
+}
+
+func (t *LoginSuite) TestLogin_Locked() {
+	ctx := context.Background()
+	email := gofakeit.Email()
+	pass := gofakeit.Password(true, true, true, true, false, 12)
+	ip := gofakeit.IPv4Address()
+
+	for _, lockErr := range []error{errs.ErrAccountLocked, errs.ErrTooManyLoginAttempts} {
+		t.lockout.EXPECT().Check(ctx, model.DefaultOrgID, email, ip).Return(lockErr)
+
+		result, err := t.service.Login(ctx, model.DefaultOrgID, email, pass, ip)
+		t.Require().Nil(result)
+		t.Require().ErrorIs(err, lockErr)
+	}
+}
+
+func (t *LoginSuite) TestLogin_LockoutUnavailable() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	pass := gofakeit.Password(true, true, true, true, false, 12)
+	ip := gofakeit.IPv4Address()
+	pair := t.newTokenPair()
+
+	t.lockout.EXPECT().Check(ctx, model.DefaultOrgID, usr.Email, ip).Return(errors.New("connection refused"))
+	t.userService.EXPECT().VerifyCredentials(ctx, model.DefaultOrgID, usr.Email, pass).Return(usr, nil)
+	t.lockout.EXPECT().Reset(ctx, model.DefaultOrgID, usr.Email).Return(errors.New("connection refused"))
+	t.sessions.EXPECT().Issue(ctx, usr, gomock.Not(uuid.Nil), false).Return(pair, nil)
+
+	result, err := t.service.Login(ctx, model.DefaultOrgID, usr.Email, pass, ip)
+	t.Require().NoError(err)
+	t.Require().Equal(&model.LoginResult{Tokens: pair}, result)
+}
+
+func (t *LoginSuite) TestLogin_EmailNotVerified() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	usr.EmailVerifiedAt = nil
+	pass := gofakeit.Password(true, true, true, true, false, 12)
+	ip := gofakeit.IPv4Address()
+	t.emailConfig.requiredForLogin = true
+
+	t.lockout.EXPECT().Check(ctx, model.DefaultOrgID, usr.Email, ip).Return(nil)
+	t.userService.EXPECT().VerifyCredentials(ctx, model.DefaultOrgID, usr.Email, pass).Return(usr, nil)
+	t.lockout.EXPECT().Reset(ctx, model.DefaultOrgID, usr.Email).Return(nil)
+
+	result, err := t.service.Login(ctx, model.DefaultOrgID, usr.Email, pass, ip)
+	t.Require().Nil(result)
+	t.Require().ErrorIs(err, errs.ErrEmailNotVerified)
+}
+
+func (t *LoginSuite) TestLogin_MFARequired() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	enabledAt := gofakeit.Date()
+	usr.TOTPEnabledAt = &enabledAt
+	pass := gofakeit.Password(true, true, true, true, false, 12)
+	ip := gofakeit.IPv4Address()
+	mfaToken := gofakeit.LetterN(43)
+
+	t.lockout.EXPECT().Check(ctx, model.DefaultOrgID, usr.Email, ip).Return(nil)
+	t.userService.EXPECT().VerifyCredentials(ctx, model.DefaultOrgID, usr.Email, pass).Return(usr, nil)
+	t.lockout.EXPECT().Reset(ctx, model.DefaultOrgID, usr.Email).Return(nil)
+	t.mfa.EXPECT().Challenge(ctx, usr).Return(mfaToken, nil)
+
+	result, err := t.service.Login(ctx, model.DefaultOrgID, usr.Email, pass, ip)
+	t.Require().NoError(err)
+	t.Require().Nil(result.Tokens)
+	t.Require().Equal(mfaToken, result.MFAToken)
+}
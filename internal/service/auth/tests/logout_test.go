@@ -0,0 +1,73 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestLogoutSuite(t *testing.T) {
+	suite.Run(t, new(LogoutSuite))
+}
+
+type LogoutSuite struct {
+	authSuite
+}
+
+func (t *LogoutSuite) claims(usr *model.User) *model.UserClaims {
+	return &model.UserClaims{
+		ID:        gofakeit.UUID(),
+		UserID:    usr.ID,
+		Roles:     usr.Roles,
+		ExpiresAt: time.Now().Add(t.tokenConfig.GetAccessTokenTTL()),
+	}
+}
+
+func (t *LogoutSuite) TestLogout_Ok() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	accessToken := gofakeit.LetterN(64)
+	claims := t.claims(usr)
+	refreshToken, stored := t.newRefreshToken(usr)
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.refreshTokens.EXPECT().GetByHashForUpdate(ctx, stored.TokenHash).Return(stored, nil)
+	t.sessions.EXPECT().RevokeFamily(ctx, stored.FamilyID).Return(nil)
+	t.sessions.EXPECT().RevokeAccessToken(ctx, claims).Return(nil)
+
+	err := t.service.Logout(ctx, accessToken, refreshToken)
+	t.Require().NoError(err)
+}
+
+func (t *LogoutSuite) TestLogout_RefreshTokenOfAnotherUser() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	accessToken := gofakeit.LetterN(64)
+	claims := t.claims(usr)
+	claims.UserID = usr.ID + 1
+	refreshToken, stored := t.newRefreshToken(usr)
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.refreshTokens.EXPECT().GetByHashForUpdate(ctx, stored.TokenHash).Return(stored, nil)
+
+	err := t.service.Logout(ctx, accessToken, refreshToken)
+	t.Require().ErrorIs(err, errs.ErrInvalidRefreshToken)
+}
+
+func (t *LogoutSuite) TestLogout_InvalidAccessToken() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	accessToken := gofakeit.LetterN(64)
+	refreshToken, _ := t.newRefreshToken(usr)
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(nil, errs.ErrInvalidAccessToken)
+
+	err := t.service.Logout(ctx, accessToken, refreshToken)
+	t.Require().ErrorIs(err, errs.ErrInvalidAccessToken)
+}
@@ -0,0 +1,127 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRefreshTokenSuite(t *testing.T) {
+	suite.Run(t, new(RefreshTokenSuite))
+}
+
+type RefreshTokenSuite struct {
+	authSuite
+}
+
+func (t *RefreshTokenSuite) TestRefreshToken_Ok() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	refreshToken, stored := t.newRefreshToken(usr)
+	pair := t.newTokenPair()
+
+	t.refreshTokens.EXPECT().GetByHashForUpdate(ctx, stored.TokenHash).Return(stored, nil)
+	t.refreshTokens.EXPECT().MarkRotated(ctx, stored.ID, gomock.Any()).Return(nil)
+	t.userService.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+	t.sessionRepo.EXPECT().GetByID(ctx, stored.FamilyID).Return(&model.Session{ID: stored.FamilyID, MFA: true}, nil)
+	t.sessions.EXPECT().Issue(ctx, usr, stored.FamilyID, true).Return(pair, nil)
+
+	tokens, err := t.service.RefreshToken(ctx, refreshToken)
+	t.Require().NoError(err)
+	t.Require().Equal(pair, tokens)
+}
+
+func (t *RefreshTokenSuite) TestRefreshToken_SessionWithoutMFA() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	enabledAt := time.Now()
+	usr.TOTPEnabledAt = &enabledAt
+	refreshToken, stored := t.newRefreshToken(usr)
+	pair := t.newTokenPair()
+
+	t.refreshTokens.EXPECT().GetByHashForUpdate(ctx, stored.TokenHash).Return(stored, nil)
+	t.refreshTokens.EXPECT().MarkRotated(ctx, stored.ID, gomock.Any()).Return(nil)
+	t.userService.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+	t.sessionRepo.EXPECT().GetByID(ctx, stored.FamilyID).Return(nil, errs.ErrSessionNotFound)
+	t.sessions.EXPECT().Issue(ctx, usr, stored.FamilyID, false).Return(pair, nil)
+
+	tokens, err := t.service.RefreshToken(ctx, refreshToken)
+	t.Require().NoError(err)
+	t.Require().Equal(pair, tokens)
+}
+
+func (t *RefreshTokenSuite) TestRefreshToken_Reused() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	refreshToken, stored := t.newRefreshToken(usr)
+	rotatedAt := time.Now().Add(-time.Minute)
+	stored.RotatedAt = &rotatedAt
+
+	t.refreshTokens.EXPECT().GetByHashForUpdate(ctx, stored.TokenHash).Return(stored, nil)
+	t.sessions.EXPECT().RevokeFamily(ctx, stored.FamilyID).Return(nil)
+	t.userService.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+	t.eventRepo.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, event *model.UserEvent) error {
+			t.Require().Equal(model.UserEventTypeRefreshTokenReused, event.Type)
+			t.Require().Equal(usr.ID, event.UserID)
+			t.Require().Equal(usr.OrgID, event.OrgID)
+			t.Require().Equal(&model.RefreshTokenReusedEventValue{FamilyID: stored.FamilyID}, event.Value)
+			return nil
+		},
+	)
+
+	tokens, err := t.service.RefreshToken(ctx, refreshToken)
+	t.Require().Nil(tokens)
+	t.Require().ErrorIs(err, errs.ErrInvalidRefreshToken)
+}
+
+func (t *RefreshTokenSuite) TestRefreshToken_Revoked() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	refreshToken, stored := t.newRefreshToken(usr)
+	revokedAt := time.Now().Add(-time.Minute)
+	stored.RevokedAt = &revokedAt
+
+	t.refreshTokens.EXPECT().GetByHashForUpdate(ctx, stored.TokenHash).Return(stored, nil)
+
+	tokens, err := t.service.RefreshToken(ctx, refreshToken)
+	t.Require().Nil(tokens)
+	t.Require().ErrorIs(err, errs.ErrInvalidRefreshToken)
+}
+
+func (t *RefreshTokenSuite) TestRefreshToken_NotFound() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	refreshToken, stored := t.newRefreshToken(usr)
+
+	t.refreshTokens.EXPECT().GetByHashForUpdate(ctx, stored.TokenHash).Return(nil, errs.ErrRefreshTokenNotFound)
+
+	tokens, err := t.service.RefreshToken(ctx, refreshToken)
+	t.Require().Nil(tokens)
+	t.Require().ErrorIs(err, errs.ErrInvalidRefreshToken)
+}
+
+func (t *RefreshTokenSuite) TestRefreshToken_InvalidSignature() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+
+	forged, err := token.Generate(
+		usr,
+		token.TypeRefresh,
+		[]byte(gofakeit.LetterN(32)),
+		t.tokenConfig.GetRefreshTokenTTL(),
+	)
+	t.Require().NoError(err)
+
+	tokens, err := t.service.RefreshToken(ctx, forged)
+	t.Require().Nil(tokens)
+	t.Require().ErrorIs(err, errs.ErrInvalidRefreshToken)
+}
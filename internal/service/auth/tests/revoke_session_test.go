@@ -0,0 +1,76 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRevokeSessionSuite(t *testing.T) {
+	suite.Run(t, new(RevokeSessionSuite))
+}
+
+type RevokeSessionSuite struct {
+	authSuite
+}
+
+func (t *RevokeSessionSuite) TestRevokeSession_Own() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	session := &model.Session{ID: uuid.New(), UserID: gofakeit.Int64()}
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(&model.UserClaims{UserID: session.UserID}, nil)
+	t.sessionRepo.EXPECT().GetByID(ctx, session.ID).Return(session, nil)
+	t.sessions.EXPECT().RevokeFamily(ctx, session.ID).Return(nil)
+
+	err := t.service.RevokeSession(ctx, accessToken, session.ID)
+	t.Require().NoError(err)
+}
+
+func (t *RevokeSessionSuite) TestRevokeSession_Admin() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	session := &model.Session{ID: uuid.New(), UserID: gofakeit.Int64()}
+	claims := &model.UserClaims{UserID: session.UserID + 1, Roles: []string{model.RoleAdmin}, MFA: true}
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.sessionRepo.EXPECT().GetByID(ctx, session.ID).Return(session, nil)
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionManageSessions).Return(true, nil)
+	t.sessions.EXPECT().RevokeFamily(ctx, session.ID).Return(nil)
+
+	err := t.service.RevokeSession(ctx, accessToken, session.ID)
+	t.Require().NoError(err)
+}
+
+func (t *RevokeSessionSuite) TestRevokeSession_OtherUser() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	session := &model.Session{ID: uuid.New(), UserID: gofakeit.Int64()}
+	claims := &model.UserClaims{UserID: session.UserID + 1, Roles: []string{model.RoleUser}}
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.sessionRepo.EXPECT().GetByID(ctx, session.ID).Return(session, nil)
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionManageSessions).Return(false, nil)
+
+	err := t.service.RevokeSession(ctx, accessToken, session.ID)
+	t.Require().ErrorIs(err, errs.ErrSessionNotFound)
+}
+
+func (t *RevokeSessionSuite) TestRevokeSession_AlreadyRevoked() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	revokedAt := time.Now()
+	session := &model.Session{ID: uuid.New(), UserID: gofakeit.Int64(), RevokedAt: &revokedAt}
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(&model.UserClaims{UserID: session.UserID}, nil)
+	t.sessionRepo.EXPECT().GetByID(ctx, session.ID).Return(session, nil)
+
+	err := t.service.RevokeSession(ctx, accessToken, session.ID)
+	t.Require().ErrorIs(err, errs.ErrSessionNotFound)
+}
@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRevokeUserTokensSuite(t *testing.T) {
+	suite.Run(t, new(RevokeUserTokensSuite))
+}
+
+type RevokeUserTokensSuite struct {
+	authSuite
+}
+
+func (t *RevokeUserTokensSuite) TestRevokeUserTokens_Ok() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	userID := gofakeit.Int64()
+	claims := &model.UserClaims{UserID: gofakeit.Int64(), Roles: []string{model.RoleAdmin}, MFA: true}
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionRevokeTokens).Return(true, nil)
+	t.sessions.EXPECT().RevokeUserTokens(ctx, userID).Return(nil)
+
+	err := t.service.RevokeUserTokens(ctx, accessToken, userID)
+	t.Require().NoError(err)
+}
+
+func (t *RevokeUserTokensSuite) TestRevokeUserTokens_NotAdmin() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	claims := &model.UserClaims{UserID: gofakeit.Int64(), Roles: []string{model.RoleUser}}
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionRevokeTokens).Return(false, nil)
+
+	err := t.service.RevokeUserTokens(ctx, accessToken, gofakeit.Int64())
+	t.Require().ErrorIs(err, errs.ErrAccessDenied)
+}
+
+func (t *RevokeUserTokensSuite) TestRevokeUserTokens_AdminWithoutMFA() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	claims := &model.UserClaims{UserID: gofakeit.Int64(), Roles: []string{model.RoleAdmin}}
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionRevokeTokens).Return(true, nil)
+
+	err := t.service.RevokeUserTokens(ctx, accessToken, gofakeit.Int64())
+	t.Require().ErrorIs(err, errs.ErrMFARequired)
+}
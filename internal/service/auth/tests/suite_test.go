@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	"github.com/Paul1k96/microservices_course_auth/internal/service/auth"
+	svcMocks "github.com/Paul1k96/microservices_course_auth/internal/service/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	infraMocks "github.com/Paul1k96/microservices_course_platform_common/pkg/client/db/transaction"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type authSuite struct {
+	suite.Suite
+	*require.Assertions
+	ctrl *gomock.Controller
+
+	refreshTokens *mocks.MockRefreshTokensRepository
+	sessionRepo   *mocks.MockSessionsRepository
+	eventRepo     *mocks.MockUserEventsRepository
+	userService   *svcMocks.MockUserService
+	sessions      *svcMocks.MockSessionService
+	roles         *svcMocks.MockRoleService
+	lockout       *svcMocks.MockLockoutService
+	mfa           *svcMocks.MockMFAService
+	tokenConfig   *tokenConfig
+	emailConfig   *emailVerificationConfig
+
+	service service.AuthService
+}
+
+func (t *authSuite) SetupTest() {
+	t.Assertions = require.New(t.T())
+	t.ctrl = gomock.NewController(t.T())
+
+	t.refreshTokens = mocks.NewMockRefreshTokensRepository(t.ctrl)
+	t.sessionRepo = mocks.NewMockSessionsRepository(t.ctrl)
+	t.eventRepo = mocks.NewMockUserEventsRepository(t.ctrl)
+	t.userService = svcMocks.NewMockUserService(t.ctrl)
+	t.sessions = svcMocks.NewMockSessionService(t.ctrl)
+	t.roles = svcMocks.NewMockRoleService(t.ctrl)
+	t.lockout = svcMocks.NewMockLockoutService(t.ctrl)
+	t.mfa = svcMocks.NewMockMFAService(t.ctrl)
+	t.tokenConfig = newTokenConfig()
+	t.emailConfig = &emailVerificationConfig{}
+
+	t.service = auth.NewService(
+		slog.Default(),
+		infraMocks.NewNopTxManager(),
+		t.refreshTokens,
+		t.sessionRepo,
+		t.eventRepo,
+		t.userService,
+		t.sessions,
+		t.roles,
+		t.lockout,
+		t.mfa,
+		t.tokenConfig,
+		t.emailConfig,
+	)
+}
+
+func (t *authSuite) TearDownTest() {
+	t.ctrl.Finish()
+}
+
+func (t *authSuite) newTokenPair() *model.TokenPair {
+	return &model.TokenPair{
+		AccessToken:  gofakeit.LetterN(64),
+		RefreshToken: gofakeit.LetterN(64),
+	}
+}
+
+func (t *authSuite) newRefreshToken(usr *model.User) (string, *model.RefreshToken) {
+	refreshToken, err := token.Generate(
+		usr,
+		token.TypeRefresh,
+		t.tokenConfig.GetRefreshTokenSecretKey(),
+		t.tokenConfig.GetRefreshTokenTTL(),
+	)
+	t.Require().NoError(err)
+
+	return refreshToken, &model.RefreshToken{
+		ID:        uuid.New(),
+		FamilyID:  uuid.New(),
+		UserID:    usr.ID,
+		TokenHash: token.Hash(refreshToken),
+		ExpiresAt: time.Now().Add(t.tokenConfig.GetRefreshTokenTTL()),
+		CreatedAt: time.Now(),
+	}
+}
@@ -0,0 +1,48 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestVerifyMFASuite(t *testing.T) {
+	suite.Run(t, new(VerifyMFASuite))
+}
+
+type VerifyMFASuite struct {
+	authSuite
+}
+
+func (t *VerifyMFASuite) TestVerifyMFA_Ok() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	mfaToken := gofakeit.LetterN(43)
+	code := gofakeit.DigitN(6)
+	pair := t.newTokenPair()
+
+	t.mfa.EXPECT().VerifyChallenge(ctx, mfaToken, code).Return(usr, nil)
+	t.sessions.EXPECT().Issue(ctx, usr, gomock.Not(uuid.Nil), true).Return(pair, nil)
+
+	tokens, err := t.service.VerifyMFA(ctx, mfaToken, code)
+	t.Require().NoError(err)
+	t.Require().Equal(pair, tokens)
+}
+
+func (t *VerifyMFASuite) TestVerifyMFA_InvalidCode() {
+	ctx := context.Background()
+	mfaToken := gofakeit.LetterN(43)
+	code := gofakeit.DigitN(6)
+
+	t.mfa.EXPECT().VerifyChallenge(ctx, mfaToken, code).Return(nil, errs.ErrInvalidMFACode)
+
+	tokens, err := t.service.VerifyMFA(ctx, mfaToken, code)
+	t.Require().Nil(tokens)
+	t.Require().ErrorIs(err, errs.ErrInvalidMFACode)
+}
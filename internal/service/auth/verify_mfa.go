@@ -0,0 +1,25 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/google/uuid"
+)
+
+// VerifyMFA completes the login started by Login with a TOTP or recovery code and issues a new pair of tokens.
+// The session is opened with the second factor.
+func (s *service) VerifyMFA(ctx context.Context, mfaToken, code string) (*model.TokenPair, error) {
+	user, err := s.mfaService.VerifyChallenge(ctx, mfaToken, code)
+	if err != nil {
+		return nil, fmt.Errorf("verify mfa challenge: %w", err)
+	}
+
+	tokens, err := s.sessionService.Issue(ctx, user, uuid.New(), true)
+	if err != nil {
+		return nil, fmt.Errorf("issue tokens: %w", err)
+	}
+
+	return tokens, nil
+}
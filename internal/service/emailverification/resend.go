@@ -0,0 +1,49 @@
+package emailverification
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+)
+
+// Resend sends a new verification link to the email in the organization if it belongs to a user
+// who has not verified it yet. The user is looked up in the background and nothing is reported
+// to the caller, so that neither the result nor the latency reveals whether the email is registered.
+// Requests beyond the capacity of the worker pool are dropped.
+func (s *service) Resend(ctx context.Context, orgID int64, email string) error {
+	ctx = context.WithoutCancel(ctx)
+
+	err := s.workerPool.Submit(func() {
+		s.resend(ctx, orgID, email)
+	})
+	if err != nil {
+		s.logger.Error("failed to queue email verification resend:", slog.String("error", err.Error()))
+	}
+
+	return nil
+}
+
+func (s *service) resend(ctx context.Context, orgID int64, email string) {
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	user, err := s.users.GetByEmail(ctx, orgID, email)
+	if err != nil {
+		if !errors.Is(err, errs.ErrUserNotFound) {
+			s.logger.Error("failed to get user to resend email verification:", slog.String("error", err.Error()))
+		}
+
+		return
+	}
+
+	if user.EmailVerifiedAt != nil {
+		return
+	}
+
+	err = s.Send(ctx, user)
+	if err != nil {
+		s.logger.Error("failed to resend email verification:", slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,65 @@
+package emailverification
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/notifier"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/google/uuid"
+)
+
+const sendTimeout = 30 * time.Second
+
+// Send issues a verification token for the current email of user and sends it there.
+func (s *service) Send(ctx context.Context, user *model.User) error {
+	verificationToken, err := token.GenerateOpaque()
+	if err != nil {
+		return fmt.Errorf("generate email verification token: %w", err)
+	}
+
+	now := time.Now()
+
+	err = s.verificationTokens.Create(ctx, &model.EmailVerificationToken{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		Email:     user.Email,
+		TokenHash: token.Hash(verificationToken),
+		ExpiresAt: now.Add(s.config.GetTokenTTL()),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return fmt.Errorf("save email verification token: %w", err)
+	}
+
+	link, err := notifier.LinkWithToken(s.config.GetURL(), verificationToken)
+	if err != nil {
+		return fmt.Errorf("build email verification link: %w", err)
+	}
+
+	go s.send(context.WithoutCancel(ctx), &notifier.Message{
+		To:      user.Email,
+		Subject: "Email verification",
+		Body: fmt.Sprintf(
+			"Follow the link to confirm your email address: %s\n\n"+
+				"The link expires in %s.\n",
+			link,
+			s.config.GetTokenTTL(),
+		),
+	})
+
+	return nil
+}
+
+func (s *service) send(ctx context.Context, msg *notifier.Message) {
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	err := s.notifier.Send(ctx, msg)
+	if err != nil {
+		s.logger.Error("failed to send email verification message:", slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,49 @@
+package emailverification
+
+import (
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/Paul1k96/microservices_course_auth/internal/notifier"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository"
+	svc "github.com/Paul1k96/microservices_course_auth/internal/service"
+	"github.com/Paul1k96/microservices_course_auth/internal/worker"
+	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/db"
+)
+
+type service struct {
+	logger    *slog.Logger
+	txManager db.TxManager
+
+	users              repository.UsersRepository
+	usersCache         repository.UsersCache
+	verificationTokens repository.EmailVerificationTokensRepository
+
+	notifier   notifier.Notifier
+	workerPool *worker.Pool
+
+	config config.EmailVerificationConfig
+}
+
+// NewService creates a new email verification service.
+func NewService(
+	logger *slog.Logger,
+	txManager db.TxManager,
+	users repository.UsersRepository,
+	usersCache repository.UsersCache,
+	verificationTokens repository.EmailVerificationTokensRepository,
+	notifier notifier.Notifier,
+	workerPool *worker.Pool,
+	config config.EmailVerificationConfig,
+) svc.EmailVerificationService {
+	return &service{
+		logger:             logger,
+		txManager:          txManager,
+		users:              users,
+		usersCache:         usersCache,
+		verificationTokens: verificationTokens,
+		notifier:           notifier,
+		workerPool:         workerPool,
+		config:             config,
+	}
+}
@@ -0,0 +1,20 @@
+package tests
+
+import "time"
+
+type emailVerificationConfig struct {
+	tokenTTL time.Duration
+	url      string
+}
+
+func newEmailVerificationConfig() *emailVerificationConfig {
+	return &emailVerificationConfig{
+		tokenTTL: 24 * time.Hour,
+		url:      "https://auth.example.com/email/verify",
+	}
+}
+
+func (c *emailVerificationConfig) GetTokenTTL() time.Duration { return c.tokenTTL }
+func (c *emailVerificationConfig) GetURL() string             { return c.url }
+func (c *emailVerificationConfig) IsRequiredForLogin() bool   { return false }
+func (c *emailVerificationConfig) IsRequiredForAccess() bool  { return false }
@@ -0,0 +1,248 @@
+package tests
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/notifier"
+	notifierMocks "github.com/Paul1k96/microservices_course_auth/internal/notifier/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	"github.com/Paul1k96/microservices_course_auth/internal/service/emailverification"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/Paul1k96/microservices_course_auth/internal/worker"
+	infraMocks "github.com/Paul1k96/microservices_course_platform_common/pkg/client/db/transaction"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEmailVerificationSuite(t *testing.T) {
+	suite.Run(t, new(EmailVerificationSuite))
+}
+
+type EmailVerificationSuite struct {
+	suite.Suite
+	*require.Assertions
+	ctrl *gomock.Controller
+
+	userRepo           *mocks.MockUsersRepository
+	userCache          *mocks.MockUsersCache
+	verificationTokens *mocks.MockEmailVerificationTokensRepository
+	notifier           *notifierMocks.MockNotifier
+	config             *emailVerificationConfig
+	workerPool         *worker.Pool
+
+	service service.EmailVerificationService
+}
+
+var linkRegexp = regexp.MustCompile(`https://\S+`)
+
+func (t *EmailVerificationSuite) SetupTest() {
+	t.Assertions = require.New(t.T())
+	t.ctrl = gomock.NewController(t.T())
+
+	t.userRepo = mocks.NewMockUsersRepository(t.ctrl)
+	t.userCache = mocks.NewMockUsersCache(t.ctrl)
+	t.verificationTokens = mocks.NewMockEmailVerificationTokensRepository(t.ctrl)
+	t.notifier = notifierMocks.NewMockNotifier(t.ctrl)
+	t.config = newEmailVerificationConfig()
+	t.workerPool = worker.NewPool(slog.Default(), 1, 1)
+
+	t.service = emailverification.NewService(
+		slog.Default(),
+		infraMocks.NewNopTxManager(),
+		t.userRepo,
+		t.userCache,
+		t.verificationTokens,
+		t.notifier,
+		t.workerPool,
+		t.config,
+	)
+}
+
+func (t *EmailVerificationSuite) TearDownTest() {
+	t.workerPool.Stop()
+	t.ctrl.Finish()
+}
+
+func (t *EmailVerificationSuite) newVerificationToken() (string, *model.EmailVerificationToken) {
+	verificationToken, err := token.GenerateOpaque()
+	t.Require().NoError(err)
+
+	return verificationToken, &model.EmailVerificationToken{
+		ID:        uuid.New(),
+		UserID:    gofakeit.Int64(),
+		Email:     gofakeit.Email(),
+		TokenHash: token.Hash(verificationToken),
+		ExpiresAt: time.Now().Add(t.config.GetTokenTTL()),
+		CreatedAt: time.Now(),
+	}
+}
+
+func (t *EmailVerificationSuite) TestSend_Ok() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+
+	var stored *model.EmailVerificationToken
+	sent := make(chan *notifier.Message, 1)
+
+	t.verificationTokens.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, verificationToken *model.EmailVerificationToken) error {
+			stored = verificationToken
+			return nil
+		},
+	)
+	t.notifier.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, msg *notifier.Message) error {
+			sent <- msg
+			return nil
+		},
+	)
+
+	err := t.service.Send(ctx, usr)
+	t.Require().NoError(err)
+
+	var msg *notifier.Message
+	select {
+	case msg = <-sent:
+	case <-time.After(time.Second):
+		t.FailNow("email verification message was not sent")
+	}
+
+	t.Require().Equal(usr.Email, msg.To)
+
+	link, err := url.Parse(linkRegexp.FindString(msg.Body))
+	t.Require().NoError(err)
+
+	verificationToken := link.Query().Get("token")
+	t.Require().Equal(token.Hash(verificationToken), stored.TokenHash)
+	t.Require().Equal(usr.ID, stored.UserID)
+	t.Require().Equal(usr.Email, stored.Email)
+}
+
+func (t *EmailVerificationSuite) TestVerify_Ok() {
+	ctx := context.Background()
+	verificationToken, stored := t.newVerificationToken()
+
+	t.verificationTokens.EXPECT().GetByHashForUpdate(ctx, stored.TokenHash).Return(stored, nil)
+	t.verificationTokens.EXPECT().MarkUsedByUserID(ctx, stored.UserID, gomock.Any()).Return(nil)
+	t.userRepo.EXPECT().MarkEmailVerified(ctx, stored.UserID, stored.Email, gomock.Any()).Return(nil)
+	t.userRepo.EXPECT().GetByID(ctx, stored.UserID).Return(&model.User{ID: stored.UserID, OrgID: model.DefaultOrgID}, nil)
+	t.userCache.EXPECT().Delete(ctx, stored.UserID).Return(nil)
+
+	err := t.service.Verify(ctx, verificationToken)
+	t.Require().NoError(err)
+}
+
+func (t *EmailVerificationSuite) TestVerify_EmailChanged() {
+	ctx := context.Background()
+	verificationToken, stored := t.newVerificationToken()
+
+	t.verificationTokens.EXPECT().GetByHashForUpdate(ctx, stored.TokenHash).Return(stored, nil)
+	t.verificationTokens.EXPECT().MarkUsedByUserID(ctx, stored.UserID, gomock.Any()).Return(nil)
+	t.userRepo.EXPECT().MarkEmailVerified(ctx, stored.UserID, stored.Email, gomock.Any()).Return(errs.ErrUserNotFound)
+
+	err := t.service.Verify(ctx, verificationToken)
+	t.Require().ErrorIs(err, errs.ErrInvalidEmailVerificationToken)
+}
+
+func (t *EmailVerificationSuite) TestVerify_Used() {
+	ctx := context.Background()
+	verificationToken, stored := t.newVerificationToken()
+	usedAt := time.Now().Add(-time.Minute)
+	stored.UsedAt = &usedAt
+
+	t.verificationTokens.EXPECT().GetByHashForUpdate(ctx, stored.TokenHash).Return(stored, nil)
+
+	err := t.service.Verify(ctx, verificationToken)
+	t.Require().ErrorIs(err, errs.ErrInvalidEmailVerificationToken)
+}
+
+func (t *EmailVerificationSuite) TestVerify_NotFound() {
+	ctx := context.Background()
+	verificationToken, stored := t.newVerificationToken()
+
+	t.verificationTokens.EXPECT().GetByHashForUpdate(ctx, stored.TokenHash).
+		Return(nil, errs.ErrEmailVerificationTokenNotFound)
+
+	err := t.service.Verify(ctx, verificationToken)
+	t.Require().ErrorIs(err, errs.ErrInvalidEmailVerificationToken)
+}
+
+func (t *EmailVerificationSuite) TestResend_Ok() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	usr.EmailVerifiedAt = nil
+
+	sent := make(chan *notifier.Message, 1)
+
+	t.userRepo.EXPECT().GetByEmail(gomock.Any(), model.DefaultOrgID, usr.Email).Return(usr, nil)
+	t.verificationTokens.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, verificationToken *model.EmailVerificationToken) error {
+			t.Require().Equal(usr.ID, verificationToken.UserID)
+			t.Require().Equal(usr.Email, verificationToken.Email)
+			return nil
+		},
+	)
+	t.notifier.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, msg *notifier.Message) error {
+			sent <- msg
+			return nil
+		},
+	)
+
+	err := t.service.Resend(ctx, model.DefaultOrgID, usr.Email)
+	t.Require().NoError(err)
+
+	select {
+	case msg := <-sent:
+		t.Require().Equal(usr.Email, msg.To)
+	case <-time.After(time.Second):
+		t.FailNow("email verification message was not sent")
+	}
+}
+
+func (t *EmailVerificationSuite) TestResend_AlreadyVerified() {
+	usr := tm.NewUser()
+	verifiedAt := time.Now()
+	usr.EmailVerifiedAt = &verifiedAt
+
+	t.expectResendLookup(usr, nil)
+}
+
+func (t *EmailVerificationSuite) TestResend_UnknownEmail() {
+	t.expectResendLookup(nil, errs.ErrUserNotFound)
+}
+
+// expectResendLookup resends verification to an email resolving to user and waits until it is looked up.
+// Nothing must be sent afterwards.
+func (t *EmailVerificationSuite) expectResendLookup(usr *model.User, lookupErr error) {
+	email := gofakeit.Email()
+	looked := make(chan struct{})
+
+	t.userRepo.EXPECT().GetByEmail(gomock.Any(), model.DefaultOrgID, email).DoAndReturn(
+		func(context.Context, int64, string) (*model.User, error) {
+			defer close(looked)
+			return usr, lookupErr
+		},
+	)
+
+	err := t.service.Resend(context.Background(), model.DefaultOrgID, email)
+	t.Require().NoError(err)
+
+	select {
+	case <-looked:
+	case <-time.After(time.Second):
+		t.FailNow("user was not looked up")
+	}
+}
@@ -0,0 +1,62 @@
+package emailverification
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/pkg/errors"
+)
+
+// Verify marks the email the token was sent to as verified.
+// The token is refused if the user has changed their email since it was sent.
+func (s *service) Verify(ctx context.Context, verificationToken string) error {
+	var user *model.User
+
+	if txErr := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		stored, err := s.verificationTokens.GetByHashForUpdate(ctx, token.Hash(verificationToken))
+		if err != nil {
+			return fmt.Errorf("get email verification token: %w", err)
+		}
+
+		now := time.Now()
+
+		if stored.UsedAt != nil || now.After(stored.ExpiresAt) {
+			return errs.ErrInvalidEmailVerificationToken
+		}
+
+		err = s.verificationTokens.MarkUsedByUserID(ctx, stored.UserID, now)
+		if err != nil {
+			return fmt.Errorf("mark email verification tokens used: %w", err)
+		}
+
+		err = s.users.MarkEmailVerified(ctx, stored.UserID, stored.Email, now)
+		if err != nil {
+			return fmt.Errorf("mark email verified: %w", err)
+		}
+
+		user, err = s.users.GetByID(ctx, stored.UserID)
+		if err != nil {
+			return fmt.Errorf("get user by id: %w", err)
+		}
+
+		return nil
+	}); txErr != nil {
+		if errors.Is(txErr, errs.ErrEmailVerificationTokenNotFound) || errors.Is(txErr, errs.ErrUserNotFound) {
+			return fmt.Errorf("verify email: %w", errs.ErrInvalidEmailVerificationToken)
+		}
+
+		return txErr
+	}
+
+	err := s.usersCache.Delete(ctx, user.ID)
+	if err != nil {
+		s.logger.Error("failed to delete user from cache:", slog.String("error", err.Error()))
+	}
+
+	return nil
+}
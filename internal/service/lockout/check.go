@@ -0,0 +1,38 @@
+package lockout
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+)
+
+// Check returns an error if login attempts for the email in the organization or from the client address are locked.
+func (s *service) Check(ctx context.Context, orgID int64, email, ip string) error {
+	now := time.Now()
+
+	account, err := s.attempts.Get(ctx, accountKey(orgID, email))
+	if err != nil {
+		return fmt.Errorf("get account login attempts: %w", err)
+	}
+
+	if account.IsLocked(now) {
+		return errs.ErrAccountLocked
+	}
+
+	if ip == "" {
+		return nil
+	}
+
+	client, err := s.attempts.Get(ctx, ipKey(ip))
+	if err != nil {
+		return fmt.Errorf("get client login attempts: %w", err)
+	}
+
+	if client.IsLocked(now) {
+		return errs.ErrTooManyLoginAttempts
+	}
+
+	return nil
+}
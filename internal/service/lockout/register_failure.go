@@ -0,0 +1,95 @@
+package lockout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// RegisterFailure counts a failed login attempt for the email in the organization and from the client address.
+// Reaching the threshold locks further attempts, every lockout in a row lasts twice as
+// long as the previous one. Locking an existing account is recorded as a user event.
+func (s *service) RegisterFailure(ctx context.Context, orgID int64, email, ip string) error {
+	now := time.Now()
+
+	account, locked, err := s.registerFailure(ctx, accountKey(orgID, email), s.config.GetAccountThreshold(), now)
+	if err != nil {
+		return fmt.Errorf("register account failure: %w", err)
+	}
+
+	if locked {
+		s.saveLockedEvent(ctx, orgID, email, ip, account)
+	}
+
+	if ip == "" {
+		return nil
+	}
+
+	_, _, err = s.registerFailure(ctx, ipKey(ip), s.config.GetIPThreshold(), now)
+	if err != nil {
+		return fmt.Errorf("register client failure: %w", err)
+	}
+
+	return nil
+}
+
+// registerFailure counts a failure in attempts stored by key. Failures are counted atomically,
+// so concurrent failures are never lost and only the one reaching the threshold locks attempts.
+func (s *service) registerFailure(
+	ctx context.Context,
+	key string,
+	threshold int,
+	now time.Time,
+) (*model.LoginAttempts, bool, error) {
+	window := s.config.GetWindow()
+
+	attempts, reached, err := s.attempts.AddFailure(ctx, key, threshold, now, window)
+	if err != nil {
+		return nil, false, fmt.Errorf("add login failure: %w", err)
+	}
+
+	if !reached {
+		return attempts, false, nil
+	}
+
+	cooldown := s.cooldown(attempts.Lockouts)
+	lockedUntil := now.Add(cooldown)
+	attempts.LockedUntil = &lockedUntil
+
+	err = s.attempts.Lock(ctx, key, lockedUntil, window+cooldown)
+	if err != nil {
+		return nil, false, fmt.Errorf("lock login attempts: %w", err)
+	}
+
+	return attempts, true, nil
+}
+
+func (s *service) cooldown(lockouts int) time.Duration {
+	cooldown := s.config.GetCooldown()
+	for i := 1; i < lockouts && cooldown < s.config.GetMaxCooldown(); i++ {
+		cooldown *= 2
+	}
+
+	return min(cooldown, s.config.GetMaxCooldown())
+}
+
+func (s *service) saveLockedEvent(ctx context.Context, orgID int64, email, ip string, attempts *model.LoginAttempts) {
+	user, err := s.users.GetByEmail(ctx, orgID, email)
+	if err != nil {
+		if !errors.Is(err, errs.ErrUserNotFound) {
+			s.logger.Error("failed to get locked user:", slog.String("error", err.Error()))
+		}
+
+		return
+	}
+
+	err = s.events.Save(ctx, model.NewLockedEvent(user.ID, *attempts.LockedUntil, attempts.Lockouts, ip).WithOrgID(orgID))
+	if err != nil {
+		s.logger.Error("failed to save user event:", slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,18 @@
+package lockout
+
+import (
+	"context"
+	"fmt"
+)
+
+// Reset forgets failed login attempts for the email in the organization after a successful login.
+// Attempts from the client address are kept, so a valid account cannot be used
+// to keep the address under the threshold.
+func (s *service) Reset(ctx context.Context, orgID int64, email string) error {
+	err := s.attempts.Delete(ctx, accountKey(orgID, email))
+	if err != nil {
+		return fmt.Errorf("delete account login attempts: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package lockout
+
+import (
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository"
+	svc "github.com/Paul1k96/microservices_course_auth/internal/service"
+)
+
+const (
+	accountKeyPrefix = "account:"
+	ipKeyPrefix      = "ip:"
+)
+
+type service struct {
+	logger *slog.Logger
+
+	attempts repository.LoginAttemptsRepository
+	users    repository.UsersRepository
+	events   repository.UserEventsRepository
+
+	sessionService svc.SessionService
+	roleService    svc.RoleService
+	config         config.LockoutConfig
+}
+
+// NewService creates a new lockout service.
+func NewService(
+	logger *slog.Logger,
+	attempts repository.LoginAttemptsRepository,
+	users repository.UsersRepository,
+	events repository.UserEventsRepository,
+	sessionService svc.SessionService,
+	roleService svc.RoleService,
+	config config.LockoutConfig,
+) svc.LockoutService {
+	return &service{
+		logger:         logger,
+		attempts:       attempts,
+		users:          users,
+		events:         events,
+		sessionService: sessionService,
+		roleService:    roleService,
+		config:         config,
+	}
+}
+
+// accountKey counts attempts by email, so unknown accounts are locked the same
+// way as existing ones and lockout does not reveal which emails are registered.
+// Emails are unique within an organization, so the key is scoped to it.
+func accountKey(orgID int64, email string) string {
+	return accountKeyPrefix + strconv.FormatInt(orgID, 10) + ":" + strings.ToLower(strings.TrimSpace(email))
+}
+
+func ipKey(ip string) string {
+	return ipKeyPrefix + ip
+}
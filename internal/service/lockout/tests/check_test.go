@@ -0,0 +1,73 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestCheckSuite(t *testing.T) {
+	suite.Run(t, new(CheckSuite))
+}
+
+type CheckSuite struct {
+	lockoutSuite
+}
+
+func (t *CheckSuite) TestCheck_Ok() {
+	ctx := context.Background()
+	ip := gofakeit.IPv4Address()
+	expired := time.Now().Add(-time.Second)
+
+	t.attempts.EXPECT().Get(ctx, "account:1:user@example.com").Return(&model.LoginAttempts{
+		Failures:    1,
+		Lockouts:    1,
+		LockedUntil: &expired,
+	}, nil)
+	t.attempts.EXPECT().Get(ctx, "ip:"+ip).Return(&model.LoginAttempts{}, nil)
+
+	err := t.service.Check(ctx, model.DefaultOrgID, " User@Example.com", ip)
+	t.Require().NoError(err)
+}
+
+func (t *CheckSuite) TestCheck_AccountLocked() {
+	ctx := context.Background()
+	lockedUntil := time.Now().Add(time.Minute)
+
+	t.attempts.EXPECT().Get(ctx, "account:1:user@example.com").Return(&model.LoginAttempts{
+		Lockouts:    1,
+		LockedUntil: &lockedUntil,
+	}, nil)
+
+	err := t.service.Check(ctx, model.DefaultOrgID, "user@example.com", gofakeit.IPv4Address())
+	t.Require().ErrorIs(err, errs.ErrAccountLocked)
+}
+
+func (t *CheckSuite) TestCheck_IPLocked() {
+	ctx := context.Background()
+	ip := gofakeit.IPv4Address()
+	lockedUntil := time.Now().Add(time.Minute)
+
+	t.attempts.EXPECT().Get(ctx, "account:1:user@example.com").Return(&model.LoginAttempts{}, nil)
+	t.attempts.EXPECT().Get(ctx, "ip:"+ip).Return(&model.LoginAttempts{
+		Lockouts:    1,
+		LockedUntil: &lockedUntil,
+	}, nil)
+
+	err := t.service.Check(ctx, model.DefaultOrgID, "user@example.com", ip)
+	t.Require().ErrorIs(err, errs.ErrTooManyLoginAttempts)
+}
+
+func (t *CheckSuite) TestCheck_UnknownIP() {
+	ctx := context.Background()
+
+	t.attempts.EXPECT().Get(ctx, "account:1:user@example.com").Return(&model.LoginAttempts{}, nil)
+
+	err := t.service.Check(ctx, model.DefaultOrgID, "user@example.com", "")
+	t.Require().NoError(err)
+}
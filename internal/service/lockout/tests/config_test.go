@@ -0,0 +1,11 @@
+package tests
+
+import "time"
+
+type lockoutConfig struct{}
+
+func (c *lockoutConfig) GetAccountThreshold() int      { return 3 }
+func (c *lockoutConfig) GetIPThreshold() int           { return 10 }
+func (c *lockoutConfig) GetCooldown() time.Duration    { return time.Minute }
+func (c *lockoutConfig) GetMaxCooldown() time.Duration { return 5 * time.Minute }
+func (c *lockoutConfig) GetWindow() time.Duration      { return 15 * time.Minute }
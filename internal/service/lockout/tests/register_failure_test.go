@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRegisterFailureSuite(t *testing.T) {
+	suite.Run(t, new(RegisterFailureSuite))
+}
+
+type RegisterFailureSuite struct {
+	lockoutSuite
+}
+
+func (t *RegisterFailureSuite) TestRegisterFailure_BelowThreshold() {
+	ctx := context.Background()
+	ip := gofakeit.IPv4Address()
+
+	t.attempts.EXPECT().AddFailure(ctx, "account:1:user@example.com", 3, gomock.Any(), 15*time.Minute).
+		Return(&model.LoginAttempts{Failures: 2}, false, nil)
+	t.attempts.EXPECT().AddFailure(ctx, "ip:"+ip, 10, gomock.Any(), 15*time.Minute).
+		Return(&model.LoginAttempts{Failures: 1}, false, nil)
+
+	err := t.service.RegisterFailure(ctx, model.DefaultOrgID, "user@example.com", ip)
+	t.Require().NoError(err)
+}
+
+func (t *RegisterFailureSuite) TestRegisterFailure_LocksAccount() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	ip := gofakeit.IPv4Address()
+	key := "account:1:" + usr.Email
+
+	t.attempts.EXPECT().AddFailure(ctx, key, 3, gomock.Any(), 15*time.Minute).
+		Return(&model.LoginAttempts{Lockouts: 1}, true, nil)
+	t.attempts.EXPECT().Lock(ctx, key, gomock.Any(), 16*time.Minute).DoAndReturn(
+		func(_ context.Context, _ string, lockedUntil time.Time, _ time.Duration) error {
+			t.Require().WithinDuration(time.Now().Add(time.Minute), lockedUntil, time.Second)
+			return nil
+		},
+	)
+	t.attempts.EXPECT().AddFailure(ctx, "ip:"+ip, 10, gomock.Any(), 15*time.Minute).
+		Return(&model.LoginAttempts{Failures: 1}, false, nil)
+	t.userRepo.EXPECT().GetByEmail(ctx, model.DefaultOrgID, usr.Email).Return(usr, nil)
+	t.events.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, event *model.UserEvent) error {
+			t.Require().Equal(model.UserEventTypeLocked, event.Type)
+			t.Require().Equal(usr.ID, event.UserID)
+			t.Require().Equal(model.DefaultOrgID, event.OrgID)
+
+			value, ok := event.Value.(*model.LockedEventValue)
+			t.Require().True(ok)
+			t.Require().Equal(1, value.Lockouts)
+			t.Require().Equal(ip, value.IP)
+			return nil
+		},
+	)
+
+	err := t.service.RegisterFailure(ctx, model.DefaultOrgID, usr.Email, ip)
+	t.Require().NoError(err)
+}
+
+func (t *RegisterFailureSuite) TestRegisterFailure_ProgressiveCooldown() {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		lockouts int
+		cooldown time.Duration
+	}{
+		{name: "second lockout", lockouts: 1, cooldown: 2 * time.Minute},
+		{name: "third lockout", lockouts: 2, cooldown: 4 * time.Minute},
+		{name: "capped", lockouts: 5, cooldown: 5 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func() {
+			t.attempts.EXPECT().AddFailure(ctx, "account:1:unknown@example.com", 3, gomock.Any(), 15*time.Minute).
+				Return(&model.LoginAttempts{Lockouts: tt.lockouts + 1}, true, nil)
+			t.attempts.EXPECT().Lock(ctx, "account:1:unknown@example.com", gomock.Any(), tt.cooldown+15*time.Minute).
+				DoAndReturn(func(_ context.Context, _ string, lockedUntil time.Time, _ time.Duration) error {
+					t.Require().WithinDuration(time.Now().Add(tt.cooldown), lockedUntil, time.Second)
+					return nil
+				})
+			t.userRepo.EXPECT().GetByEmail(ctx, model.DefaultOrgID, "unknown@example.com").Return(nil, errs.ErrUserNotFound)
+
+			err := t.service.RegisterFailure(ctx, model.DefaultOrgID, "unknown@example.com", "")
+			t.Require().NoError(err)
+		})
+	}
+}
+
+func (t *RegisterFailureSuite) TestRegisterFailure_AlreadyLocked() {
+	ctx := context.Background()
+	lockedUntil := time.Now().Add(time.Minute)
+
+	t.attempts.EXPECT().AddFailure(ctx, "account:1:user@example.com", 3, gomock.Any(), 15*time.Minute).
+		Return(&model.LoginAttempts{Lockouts: 1, LockedUntil: &lockedUntil}, false, nil)
+
+	err := t.service.RegisterFailure(ctx, model.DefaultOrgID, "user@example.com", "")
+	t.Require().NoError(err)
+}
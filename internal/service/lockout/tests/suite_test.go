@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	"github.com/Paul1k96/microservices_course_auth/internal/service/lockout"
+	svcMocks "github.com/Paul1k96/microservices_course_auth/internal/service/mocks"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type lockoutSuite struct {
+	suite.Suite
+	*require.Assertions
+	ctrl *gomock.Controller
+
+	attempts *mocks.MockLoginAttemptsRepository
+	userRepo *mocks.MockUsersRepository
+	events   *mocks.MockUserEventsRepository
+	sessions *svcMocks.MockSessionService
+	roles    *svcMocks.MockRoleService
+	config   *lockoutConfig
+
+	service service.LockoutService
+}
+
+func (t *lockoutSuite) SetupTest() {
+	t.Assertions = require.New(t.T())
+	t.ctrl = gomock.NewController(t.T())
+
+	t.attempts = mocks.NewMockLoginAttemptsRepository(t.ctrl)
+	t.userRepo = mocks.NewMockUsersRepository(t.ctrl)
+	t.events = mocks.NewMockUserEventsRepository(t.ctrl)
+	t.sessions = svcMocks.NewMockSessionService(t.ctrl)
+	t.roles = svcMocks.NewMockRoleService(t.ctrl)
+	t.config = &lockoutConfig{}
+
+	t.service = lockout.NewService(
+		slog.Default(),
+		t.attempts,
+		t.userRepo,
+		t.events,
+		t.sessions,
+		t.roles,
+		t.config,
+	)
+}
+
+func (t *lockoutSuite) TearDownTest() {
+	t.ctrl.Finish()
+}
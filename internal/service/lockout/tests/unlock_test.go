@@ -0,0 +1,82 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnlockSuite(t *testing.T) {
+	suite.Run(t, new(UnlockSuite))
+}
+
+type UnlockSuite struct {
+	lockoutSuite
+}
+
+func (t *UnlockSuite) TestUnlock_Ok() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	accessToken := gofakeit.LetterN(64)
+	claims := &model.UserClaims{ID: gofakeit.UUID(), UserID: gofakeit.Int64(), Roles: []string{model.RoleAdmin}, MFA: true}
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionUnlockUsers).Return(true, nil)
+	t.userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+	t.attempts.EXPECT().Delete(ctx, "account:1:"+usr.Email).Return(nil)
+	t.events.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, event *model.UserEvent) error {
+			t.Require().Equal(model.UserEventTypeUnlocked, event.Type)
+			t.Require().Equal(claims.UserID, event.UserID)
+			t.Require().Equal(usr.ID, event.EntityID)
+			return nil
+		},
+	)
+
+	err := t.service.Unlock(ctx, accessToken, usr.ID)
+	t.Require().NoError(err)
+}
+
+func (t *UnlockSuite) TestUnlock_NotAdmin() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	claims := &model.UserClaims{ID: gofakeit.UUID(), UserID: gofakeit.Int64(), Roles: []string{model.RoleUser}}
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionUnlockUsers).Return(false, nil)
+
+	err := t.service.Unlock(ctx, accessToken, gofakeit.Int64())
+	t.Require().ErrorIs(err, errs.ErrAccessDenied)
+}
+
+func (t *UnlockSuite) TestUnlock_UserNotFound() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	userID := gofakeit.Int64()
+	claims := &model.UserClaims{ID: gofakeit.UUID(), UserID: gofakeit.Int64(), Roles: []string{model.RoleAdmin}, MFA: true}
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionUnlockUsers).Return(true, nil)
+	t.userRepo.EXPECT().GetByID(ctx, userID).Return(nil, errs.ErrUserNotFound)
+
+	err := t.service.Unlock(ctx, accessToken, userID)
+	t.Require().ErrorIs(err, errs.ErrUserNotFound)
+}
+
+func (t *UnlockSuite) TestUnlock_AdminWithoutMFA() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	claims := &model.UserClaims{ID: gofakeit.UUID(), UserID: gofakeit.Int64(), Roles: []string{model.RoleAdmin}}
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionUnlockUsers).Return(true, nil)
+
+	err := t.service.Unlock(ctx, accessToken, gofakeit.Int64())
+	t.Require().ErrorIs(err, errs.ErrMFARequired)
+}
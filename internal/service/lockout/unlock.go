@@ -0,0 +1,48 @@
+package lockout
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// Unlock clears lockout and failed login attempts of the user, the caller must be allowed to unlock users.
+func (s *service) Unlock(ctx context.Context, accessToken string, userID int64) error {
+	claims, err := s.sessionService.VerifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return fmt.Errorf("verify access token: %w", err)
+	}
+
+	granted, err := s.roleService.HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionUnlockUsers)
+	if err != nil {
+		return fmt.Errorf("check permission: %w", err)
+	}
+
+	if !granted {
+		return fmt.Errorf("unlock user as user %d: %w", claims.UserID, errs.ErrAccessDenied)
+	}
+
+	if !claims.MFA {
+		return fmt.Errorf("unlock user without mfa: %w", errs.ErrMFARequired)
+	}
+
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("get user by id: %w", err)
+	}
+
+	err = s.attempts.Delete(ctx, accountKey(user.OrgID, user.Email))
+	if err != nil {
+		return fmt.Errorf("delete account login attempts: %w", err)
+	}
+
+	err = s.events.Save(ctx, model.NewUnlockedEvent(claims.Actor(), user.ID).WithOrgID(user.OrgID))
+	if err != nil {
+		s.logger.Error("failed to save user event:", slog.String("error", err.Error()))
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package mfa
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+)
+
+// Challenge starts the second login step of the user, whose password was already checked,
+// and returns the token to complete it with.
+func (s *service) Challenge(ctx context.Context, user *model.User) (string, error) {
+	mfaToken, err := token.GenerateOpaque()
+	if err != nil {
+		return "", fmt.Errorf("generate mfa token: %w", err)
+	}
+
+	ttl := s.config.GetChallengeTTL()
+
+	err = s.challenges.Set(ctx, token.Hash(mfaToken), &model.MFAChallenge{
+		UserID:    user.ID,
+		ExpiresAt: time.Now().Add(ttl),
+	}, ttl)
+	if err != nil {
+		return "", fmt.Errorf("save mfa challenge: %w", err)
+	}
+
+	return mfaToken, nil
+}
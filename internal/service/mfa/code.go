@@ -0,0 +1,90 @@
+package mfa
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/Paul1k96/microservices_course_auth/internal/totp"
+)
+
+const (
+	recoveryCodesCount   = 10
+	recoveryCodeLength   = 10
+	recoveryCodeAlphabet = "abcdefghijklmnopqrstuvwxyz234567"
+)
+
+// verifyTOTP checks the TOTP code of the user and remembers its time step, so it cannot be used again.
+func (s *service) verifyTOTP(ctx context.Context, user *model.User, code string) error {
+	secret, err := s.decryptSecret(user.ID, user.TOTPSecret)
+	if err != nil {
+		return fmt.Errorf("decrypt totp secret: %w", err)
+	}
+
+	counter, ok := totp.Validate(secret, strings.TrimSpace(code), time.Now(), user.TOTPCounter)
+	if !ok {
+		return errs.ErrInvalidMFACode
+	}
+
+	user.TOTPCounter = counter
+
+	err = s.users.UpdateTOTP(ctx, user)
+	if err != nil {
+		return fmt.Errorf("update totp: %w", err)
+	}
+
+	return nil
+}
+
+// verifyCode checks the second factor of the user, which is either a TOTP code or an unused recovery code.
+func (s *service) verifyCode(ctx context.Context, user *model.User, code string) error {
+	if len(strings.TrimSpace(code)) == totp.Digits {
+		return s.verifyTOTP(ctx, user, code)
+	}
+
+	err := s.recoveryCodes.MarkUsed(ctx, user.ID, token.Hash(normalizeRecoveryCode(code)), time.Now())
+	if err != nil {
+		if errors.Is(err, errs.ErrRecoveryCodeNotFound) {
+			return errs.ErrInvalidMFACode
+		}
+
+		return fmt.Errorf("mark recovery code used: %w", err)
+	}
+
+	return nil
+}
+
+// generateRecoveryCodes returns codes formatted for the user and their hashes to store.
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodesCount)
+	hashes := make([]string, 0, recoveryCodesCount)
+
+	for range recoveryCodesCount {
+		raw := make([]byte, recoveryCodeLength)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, fmt.Errorf("generate recovery code: %w", err)
+		}
+
+		for i, b := range raw {
+			raw[i] = recoveryCodeAlphabet[int(b)%len(recoveryCodeAlphabet)]
+		}
+
+		code := string(raw)
+		codes = append(codes, code[:recoveryCodeLength/2]+"-"+code[recoveryCodeLength/2:])
+		hashes = append(hashes, token.Hash(code))
+	}
+
+	return codes, hashes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
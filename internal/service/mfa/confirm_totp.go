@@ -0,0 +1,73 @@
+package mfa
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// ConfirmTOTP enables the enrolled TOTP secret once the user proves their app generates
+// valid codes and returns single-use recovery codes, which are shown only once.
+// Other sessions of the user, opened without the second factor, are revoked.
+func (s *service) ConfirmTOTP(ctx context.Context, accessToken, code string) ([]string, error) {
+	claims, err := s.verifyOwner(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var recoveryCodes []string
+
+	if txErr := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		user, err := s.users.GetByID(ctx, claims.UserID)
+		if err != nil {
+			return fmt.Errorf("get user by id: %w", err)
+		}
+
+		if user.IsMFAEnabled() {
+			return errs.ErrMFAAlreadyEnabled
+		}
+
+		if user.TOTPSecret == "" {
+			return errs.ErrMFANotEnrolled
+		}
+
+		now := time.Now()
+		user.TOTPEnabledAt = &now
+
+		err = s.verifyTOTP(ctx, user, code)
+		if err != nil {
+			return fmt.Errorf("verify totp: %w", err)
+		}
+
+		var hashes []string
+		recoveryCodes, hashes, err = generateRecoveryCodes()
+		if err != nil {
+			return fmt.Errorf("generate recovery codes: %w", err)
+		}
+
+		err = s.recoveryCodes.Replace(ctx, user.ID, hashes)
+		if err != nil {
+			return fmt.Errorf("replace recovery codes: %w", err)
+		}
+
+		err = s.sessionService.RevokeOtherSessions(ctx, claims)
+		if err != nil {
+			return fmt.Errorf("revoke other sessions: %w", err)
+		}
+
+		return nil
+	}); txErr != nil {
+		return nil, fmt.Errorf("transaction error: %w", txErr)
+	}
+
+	err = s.events.Save(ctx, model.NewMFAEnabledEvent(claims.UserID).WithOrgID(claims.OrgID))
+	if err != nil {
+		s.logger.Error("failed to save user event:", slog.String("error", err.Error()))
+	}
+
+	return recoveryCodes, nil
+}
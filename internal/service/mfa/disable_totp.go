@@ -0,0 +1,64 @@
+package mfa
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// DisableTOTP removes TOTP and recovery codes of the access token owner after
+// checking a TOTP or recovery code. Admins must keep the second factor.
+func (s *service) DisableTOTP(ctx context.Context, accessToken, code string) error {
+	claims, err := s.verifyOwner(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+
+	if claims.HasRole(model.RoleAdmin) {
+		return fmt.Errorf("disable totp as %s: %w", model.RoleAdmin, errs.ErrMFARequired)
+	}
+
+	if txErr := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		user, err := s.users.GetByID(ctx, claims.UserID)
+		if err != nil {
+			return fmt.Errorf("get user by id: %w", err)
+		}
+
+		if !user.IsMFAEnabled() {
+			return errs.ErrMFANotEnrolled
+		}
+
+		err = s.verifyCode(ctx, user, code)
+		if err != nil {
+			return fmt.Errorf("verify code: %w", err)
+		}
+
+		user.TOTPSecret = ""
+		user.TOTPEnabledAt = nil
+		user.TOTPCounter = 0
+
+		err = s.users.UpdateTOTP(ctx, user)
+		if err != nil {
+			return fmt.Errorf("update totp: %w", err)
+		}
+
+		err = s.recoveryCodes.DeleteByUserID(ctx, user.ID)
+		if err != nil {
+			return fmt.Errorf("delete recovery codes: %w", err)
+		}
+
+		return nil
+	}); txErr != nil {
+		return fmt.Errorf("transaction error: %w", txErr)
+	}
+
+	err = s.events.Save(ctx, model.NewMFADisabledEvent(claims.UserID).WithOrgID(claims.OrgID))
+	if err != nil {
+		s.logger.Error("failed to save user event:", slog.String("error", err.Error()))
+	}
+
+	return nil
+}
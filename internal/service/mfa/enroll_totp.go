@@ -0,0 +1,59 @@
+package mfa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/totp"
+)
+
+// EnrollTOTP generates a new TOTP secret for the access token owner. It takes
+// effect only after ConfirmTOTP, enrolling again replaces an unconfirmed secret.
+func (s *service) EnrollTOTP(ctx context.Context, accessToken string) (*model.TOTPEnrollment, error) {
+	claims, err := s.verifyOwner(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var enrollment *model.TOTPEnrollment
+
+	if txErr := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		user, err := s.users.GetByID(ctx, claims.UserID)
+		if err != nil {
+			return fmt.Errorf("get user by id: %w", err)
+		}
+
+		if user.IsMFAEnabled() {
+			return errs.ErrMFAAlreadyEnabled
+		}
+
+		secret, err := totp.GenerateSecret()
+		if err != nil {
+			return fmt.Errorf("generate totp secret: %w", err)
+		}
+
+		user.TOTPSecret, err = s.encryptSecret(user.ID, secret)
+		if err != nil {
+			return fmt.Errorf("encrypt totp secret: %w", err)
+		}
+		user.TOTPCounter = 0
+
+		err = s.users.UpdateTOTP(ctx, user)
+		if err != nil {
+			return fmt.Errorf("update totp: %w", err)
+		}
+
+		enrollment = &model.TOTPEnrollment{
+			Secret: secret,
+			URI:    totp.URI(s.config.GetIssuer(), user.Email, secret),
+		}
+
+		return nil
+	}); txErr != nil {
+		return nil, fmt.Errorf("transaction error: %w", txErr)
+	}
+
+	return enrollment, nil
+}
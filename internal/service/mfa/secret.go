@@ -0,0 +1,73 @@
+package mfa
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// encryptSecret seals the TOTP secret with AES-GCM so a database leak does not
+// expose second factors. The user ID is authenticated along, so a secret cannot
+// be moved to another user.
+func (s *service) encryptSecret(userID int64, secret string) (string, error) {
+	aead, err := s.cipher()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("generate nonce: %w", err)
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(secret), []byte(strconv.FormatInt(userID, 10)))
+
+	return base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+func (s *service) decryptSecret(userID int64, encrypted string) (string, error) {
+	aead, err := s.cipher()
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := base64.RawStdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", fmt.Errorf("decode secret: %w", err)
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("encrypted secret is too short")
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+
+	secret, err := aead.Open(nil, nonce, ciphertext, []byte(strconv.FormatInt(userID, 10)))
+	if err != nil {
+		return "", fmt.Errorf("decrypt secret: %w", err)
+	}
+
+	return string(secret), nil
+}
+
+func (s *service) cipher() (cipher.AEAD, error) {
+	key := sha256.Sum256(s.config.GetSecretKey())
+
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("create gcm: %w", err)
+	}
+
+	return aead, nil
+}
@@ -0,0 +1,65 @@
+package mfa
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository"
+	svc "github.com/Paul1k96/microservices_course_auth/internal/service"
+	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/db"
+)
+
+type service struct {
+	logger    *slog.Logger
+	txManager db.TxManager
+
+	users         repository.UsersRepository
+	recoveryCodes repository.RecoveryCodesRepository
+	challenges    repository.MFAChallengesRepository
+	events        repository.UserEventsRepository
+
+	sessionService svc.SessionService
+	config         config.MFAConfig
+}
+
+// NewService creates a new two-factor authentication service.
+func NewService(
+	logger *slog.Logger,
+	txManager db.TxManager,
+	users repository.UsersRepository,
+	recoveryCodes repository.RecoveryCodesRepository,
+	challenges repository.MFAChallengesRepository,
+	events repository.UserEventsRepository,
+	sessionService svc.SessionService,
+	config config.MFAConfig,
+) svc.MFAService {
+	return &service{
+		logger:         logger,
+		txManager:      txManager,
+		users:          users,
+		recoveryCodes:  recoveryCodes,
+		challenges:     challenges,
+		events:         events,
+		sessionService: sessionService,
+		config:         config,
+	}
+}
+
+// verifyOwner returns claims of the access token owner. Second factor settings belong to
+// the owner alone, so impersonated sessions are refused.
+func (s *service) verifyOwner(ctx context.Context, accessToken string) (*model.UserClaims, error) {
+	claims, err := s.sessionService.VerifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, fmt.Errorf("verify access token: %w", err)
+	}
+
+	if claims.IsImpersonated() {
+		return nil, fmt.Errorf("change mfa as admin %d: %w", claims.ActorID, errs.ErrImpersonatedSession)
+	}
+
+	return claims, nil
+}
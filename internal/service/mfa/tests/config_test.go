@@ -0,0 +1,10 @@
+package tests
+
+import "time"
+
+type mfaConfig struct{}
+
+func (c *mfaConfig) GetIssuer() string              { return "auth" }
+func (c *mfaConfig) GetSecretKey() []byte           { return []byte("mfa_secret") }
+func (c *mfaConfig) GetChallengeTTL() time.Duration { return 5 * time.Minute }
+func (c *mfaConfig) GetChallengeMaxAttempts() int   { return 3 }
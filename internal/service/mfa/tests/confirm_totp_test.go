@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/Paul1k96/microservices_course_auth/internal/totp"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestConfirmTOTPSuite(t *testing.T) {
+	suite.Run(t, new(ConfirmTOTPSuite))
+}
+
+type ConfirmTOTPSuite struct {
+	mfaSuite
+}
+
+func (t *ConfirmTOTPSuite) TestConfirmTOTP_Ok() {
+	ctx := context.Background()
+	usr, secret := t.enrolledUser(ctx)
+	accessToken, claims := t.accessToken(ctx, usr)
+	counter := totp.Counter(time.Now())
+
+	code, err := totp.Code(secret, counter)
+	t.Require().NoError(err)
+
+	t.userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+	t.userRepo.EXPECT().UpdateTOTP(ctx, usr).Return(nil)
+	t.recoveryCodes.EXPECT().Replace(ctx, usr.ID, gomock.Len(10)).Return(nil)
+	t.sessions.EXPECT().RevokeOtherSessions(ctx, claims).Return(nil)
+	t.events.EXPECT().Save(ctx, gomock.AssignableToTypeOf(&model.UserEvent{})).Return(nil)
+
+	recoveryCodes, err := t.service.ConfirmTOTP(ctx, accessToken, code)
+	t.Require().NoError(err)
+	t.Require().Len(recoveryCodes, 10)
+	t.Require().NotNil(usr.TOTPEnabledAt)
+	t.Require().Equal(counter, usr.TOTPCounter)
+}
+
+func (t *ConfirmTOTPSuite) TestConfirmTOTP_InvalidCode() {
+	ctx := context.Background()
+	usr, secret := t.enrolledUser(ctx)
+	accessToken, _ := t.accessToken(ctx, usr)
+
+	code, err := totp.Code(secret, totp.Counter(time.Now())-5)
+	t.Require().NoError(err)
+
+	t.userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+
+	recoveryCodes, err := t.service.ConfirmTOTP(ctx, accessToken, code)
+	t.Require().Nil(recoveryCodes)
+	t.Require().ErrorIs(err, errs.ErrInvalidMFACode)
+}
+
+func (t *ConfirmTOTPSuite) TestConfirmTOTP_NotEnrolled() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	accessToken, _ := t.accessToken(ctx, usr)
+
+	t.userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+
+	recoveryCodes, err := t.service.ConfirmTOTP(ctx, accessToken, "123456")
+	t.Require().Nil(recoveryCodes)
+	t.Require().ErrorIs(err, errs.ErrMFANotEnrolled)
+}
@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDisableTOTPSuite(t *testing.T) {
+	suite.Run(t, new(DisableTOTPSuite))
+}
+
+type DisableTOTPSuite struct {
+	mfaSuite
+}
+
+func (t *DisableTOTPSuite) TestDisableTOTP_RecoveryCode() {
+	ctx := context.Background()
+	usr, _ := t.enrolledUser(ctx)
+	enabledAt := time.Now()
+	usr.TOTPEnabledAt = &enabledAt
+	accessToken, _ := t.accessToken(ctx, usr)
+
+	t.userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+	t.recoveryCodes.EXPECT().MarkUsed(ctx, usr.ID, token.Hash("abcdefghij"), gomock.Any()).Return(nil)
+	t.userRepo.EXPECT().UpdateTOTP(ctx, usr).Return(nil)
+	t.recoveryCodes.EXPECT().DeleteByUserID(ctx, usr.ID).Return(nil)
+	t.events.EXPECT().Save(ctx, gomock.AssignableToTypeOf(&model.UserEvent{})).Return(nil)
+
+	err := t.service.DisableTOTP(ctx, accessToken, "ABCDE-fghij")
+	t.Require().NoError(err)
+	t.Require().Empty(usr.TOTPSecret)
+	t.Require().Nil(usr.TOTPEnabledAt)
+}
+
+func (t *DisableTOTPSuite) TestDisableTOTP_UsedRecoveryCode() {
+	ctx := context.Background()
+	usr, _ := t.enrolledUser(ctx)
+	enabledAt := time.Now()
+	usr.TOTPEnabledAt = &enabledAt
+	accessToken, _ := t.accessToken(ctx, usr)
+
+	t.userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+	t.recoveryCodes.EXPECT().MarkUsed(ctx, usr.ID, token.Hash("abcdefghij"), gomock.Any()).
+		Return(errs.ErrRecoveryCodeNotFound)
+
+	err := t.service.DisableTOTP(ctx, accessToken, "abcde-fghij")
+	t.Require().ErrorIs(err, errs.ErrInvalidMFACode)
+}
+
+func (t *DisableTOTPSuite) TestDisableTOTP_Admin() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	usr.Roles = []string{model.RoleAdmin}
+	accessToken, _ := t.accessToken(ctx, usr)
+
+	err := t.service.DisableTOTP(ctx, accessToken, "123456")
+	t.Require().ErrorIs(err, errs.ErrMFARequired)
+}
+
+func (t *DisableTOTPSuite) TestDisableTOTP_ImpersonatedSession() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	accessToken, claims := t.accessToken(ctx, usr)
+	claims.ActorID = gofakeit.Int64()
+
+	err := t.service.DisableTOTP(ctx, accessToken, "123456")
+	t.Require().ErrorIs(err, errs.ErrImpersonatedSession)
+}
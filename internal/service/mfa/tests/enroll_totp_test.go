@@ -0,0 +1,60 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestEnrollTOTPSuite(t *testing.T) {
+	suite.Run(t, new(EnrollTOTPSuite))
+}
+
+type EnrollTOTPSuite struct {
+	mfaSuite
+}
+
+func (t *EnrollTOTPSuite) TestEnrollTOTP_Ok() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	accessToken, _ := t.accessToken(ctx, usr)
+
+	t.userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+	t.userRepo.EXPECT().UpdateTOTP(ctx, usr).Return(nil)
+
+	enrollment, err := t.service.EnrollTOTP(ctx, accessToken)
+	t.Require().NoError(err)
+	t.Require().NotEmpty(enrollment.Secret)
+	t.Require().Contains(enrollment.URI, "secret="+enrollment.Secret)
+	t.Require().NotEqual(enrollment.Secret, usr.TOTPSecret)
+	t.Require().Nil(usr.TOTPEnabledAt)
+}
+
+func (t *EnrollTOTPSuite) TestEnrollTOTP_AlreadyEnabled() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	enabledAt := gofakeit.Date()
+	usr.TOTPEnabledAt = &enabledAt
+	accessToken, _ := t.accessToken(ctx, usr)
+
+	t.userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+
+	enrollment, err := t.service.EnrollTOTP(ctx, accessToken)
+	t.Require().Nil(enrollment)
+	t.Require().ErrorIs(err, errs.ErrMFAAlreadyEnabled)
+}
+
+func (t *EnrollTOTPSuite) TestEnrollTOTP_ImpersonatedSession() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	accessToken, claims := t.accessToken(ctx, usr)
+	claims.ActorID = gofakeit.Int64()
+
+	enrollment, err := t.service.EnrollTOTP(ctx, accessToken)
+	t.Require().Nil(enrollment)
+	t.Require().ErrorIs(err, errs.ErrImpersonatedSession)
+}
@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	"github.com/Paul1k96/microservices_course_auth/internal/service/mfa"
+	svcMocks "github.com/Paul1k96/microservices_course_auth/internal/service/mocks"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	infraMocks "github.com/Paul1k96/microservices_course_platform_common/pkg/client/db/transaction"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type mfaSuite struct {
+	suite.Suite
+	*require.Assertions
+	ctrl *gomock.Controller
+
+	userRepo      *mocks.MockUsersRepository
+	recoveryCodes *mocks.MockRecoveryCodesRepository
+	challenges    *mocks.MockMFAChallengesRepository
+	events        *mocks.MockUserEventsRepository
+	sessions      *svcMocks.MockSessionService
+	config        *mfaConfig
+
+	service service.MFAService
+}
+
+func (t *mfaSuite) SetupTest() {
+	t.Assertions = require.New(t.T())
+	t.ctrl = gomock.NewController(t.T())
+
+	t.userRepo = mocks.NewMockUsersRepository(t.ctrl)
+	t.recoveryCodes = mocks.NewMockRecoveryCodesRepository(t.ctrl)
+	t.challenges = mocks.NewMockMFAChallengesRepository(t.ctrl)
+	t.events = mocks.NewMockUserEventsRepository(t.ctrl)
+	t.sessions = svcMocks.NewMockSessionService(t.ctrl)
+	t.config = &mfaConfig{}
+
+	t.service = mfa.NewService(
+		slog.Default(),
+		infraMocks.NewNopTxManager(),
+		t.userRepo,
+		t.recoveryCodes,
+		t.challenges,
+		t.events,
+		t.sessions,
+		t.config,
+	)
+}
+
+func (t *mfaSuite) TearDownTest() {
+	t.ctrl.Finish()
+}
+
+func (t *mfaSuite) accessToken(ctx context.Context, usr *model.User) (string, *model.UserClaims) {
+	accessToken := gofakeit.LetterN(64)
+	claims := &model.UserClaims{ID: gofakeit.UUID(), UserID: usr.ID, Roles: usr.Roles}
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+
+	return accessToken, claims
+}
+
+// enrolledUser returns a user with a pending TOTP secret, stored encrypted by the service, and the plain secret.
+func (t *mfaSuite) enrolledUser(ctx context.Context) (*model.User, string) {
+	usr := tm.NewUser()
+	usr.Roles = []string{model.RoleUser}
+	accessToken, _ := t.accessToken(ctx, usr)
+
+	t.userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+	t.userRepo.EXPECT().UpdateTOTP(ctx, usr).Return(nil)
+
+	enrollment, err := t.service.EnrollTOTP(ctx, accessToken)
+	t.Require().NoError(err)
+	t.Require().NotEmpty(usr.TOTPSecret)
+	t.Require().NotEqual(enrollment.Secret, usr.TOTPSecret)
+
+	return usr, enrollment.Secret
+}
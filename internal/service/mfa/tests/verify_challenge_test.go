@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/Paul1k96/microservices_course_auth/internal/totp"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestVerifyChallengeSuite(t *testing.T) {
+	suite.Run(t, new(VerifyChallengeSuite))
+}
+
+type VerifyChallengeSuite struct {
+	mfaSuite
+}
+
+// challengedUser returns a user with enabled TOTP, its plain secret and a started login challenge.
+func (t *VerifyChallengeSuite) challengedUser(ctx context.Context) (*model.User, string, string) {
+	usr, secret := t.enrolledUser(ctx)
+	enabledAt := time.Now()
+	usr.TOTPEnabledAt = &enabledAt
+
+	var challenge *model.MFAChallenge
+	t.challenges.EXPECT().Set(ctx, gomock.Any(), gomock.Any(), t.config.GetChallengeTTL()).
+		DoAndReturn(func(_ context.Context, _ string, c *model.MFAChallenge, _ time.Duration) error {
+			challenge = c
+			return nil
+		})
+
+	mfaToken, err := t.service.Challenge(ctx, usr)
+	t.Require().NoError(err)
+	t.Require().Equal(usr.ID, challenge.UserID)
+
+	challenge.Attempts = 1
+	t.challenges.EXPECT().TakeAttempt(ctx, token.Hash(mfaToken), t.config.GetChallengeMaxAttempts()).
+		Return(challenge, nil)
+
+	return usr, secret, mfaToken
+}
+
+func (t *VerifyChallengeSuite) TestVerifyChallenge_Ok() {
+	ctx := context.Background()
+	usr, secret, mfaToken := t.challengedUser(ctx)
+
+	code, err := totp.Code(secret, totp.Counter(time.Now()))
+	t.Require().NoError(err)
+
+	t.userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+	t.userRepo.EXPECT().UpdateTOTP(ctx, usr).Return(nil)
+	t.challenges.EXPECT().Delete(ctx, token.Hash(mfaToken)).Return(nil)
+
+	verified, err := t.service.VerifyChallenge(ctx, mfaToken, code)
+	t.Require().NoError(err)
+	t.Require().Equal(usr, verified)
+}
+
+func (t *VerifyChallengeSuite) TestVerifyChallenge_CompletedConcurrently() {
+	ctx := context.Background()
+	usr, secret, mfaToken := t.challengedUser(ctx)
+
+	code, err := totp.Code(secret, totp.Counter(time.Now()))
+	t.Require().NoError(err)
+
+	t.userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+	t.userRepo.EXPECT().UpdateTOTP(ctx, usr).Return(nil)
+	t.challenges.EXPECT().Delete(ctx, token.Hash(mfaToken)).Return(errs.ErrMFAChallengeNotFound)
+
+	verified, err := t.service.VerifyChallenge(ctx, mfaToken, code)
+	t.Require().Nil(verified)
+	t.Require().ErrorIs(err, errs.ErrInvalidMFAChallenge)
+}
+
+func (t *VerifyChallengeSuite) TestVerifyChallenge_InvalidCode() {
+	ctx := context.Background()
+	usr, secret, mfaToken := t.challengedUser(ctx)
+
+	code, err := totp.Code(secret, totp.Counter(time.Now())-5)
+	t.Require().NoError(err)
+
+	t.userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+
+	verified, err := t.service.VerifyChallenge(ctx, mfaToken, code)
+	t.Require().Nil(verified)
+	t.Require().ErrorIs(err, errs.ErrInvalidMFACode)
+}
+
+func (t *VerifyChallengeSuite) TestVerifyChallenge_LastAttempt() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	enabledAt := time.Now()
+	usr.TOTPEnabledAt = &enabledAt
+	mfaToken := gofakeit.LetterN(43)
+	challenge := &model.MFAChallenge{
+		UserID:    usr.ID,
+		Attempts:  t.config.GetChallengeMaxAttempts(),
+		ExpiresAt: time.Now().Add(time.Minute),
+	}
+
+	t.challenges.EXPECT().TakeAttempt(ctx, token.Hash(mfaToken), t.config.GetChallengeMaxAttempts()).
+		Return(challenge, nil)
+	t.userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+	t.recoveryCodes.EXPECT().MarkUsed(ctx, usr.ID, gomock.Any(), gomock.Any()).Return(errs.ErrRecoveryCodeNotFound)
+
+	verified, err := t.service.VerifyChallenge(ctx, mfaToken, "abcde-fghij")
+	t.Require().Nil(verified)
+	t.Require().ErrorIs(err, errs.ErrInvalidMFACode)
+}
+
+func (t *VerifyChallengeSuite) TestVerifyChallenge_NotFound() {
+	ctx := context.Background()
+	mfaToken := gofakeit.LetterN(43)
+
+	t.challenges.EXPECT().TakeAttempt(ctx, token.Hash(mfaToken), t.config.GetChallengeMaxAttempts()).
+		Return(nil, errs.ErrMFAChallengeNotFound)
+
+	verified, err := t.service.VerifyChallenge(ctx, mfaToken, "123456")
+	t.Require().Nil(verified)
+	t.Require().ErrorIs(err, errs.ErrInvalidMFAChallenge)
+}
+
+func (t *VerifyChallengeSuite) TestVerifyChallenge_Expired() {
+	ctx := context.Background()
+	mfaToken := gofakeit.LetterN(43)
+	challenge := &model.MFAChallenge{UserID: gofakeit.Int64(), Attempts: 1, ExpiresAt: time.Now().Add(-time.Second)}
+
+	t.challenges.EXPECT().TakeAttempt(ctx, token.Hash(mfaToken), t.config.GetChallengeMaxAttempts()).
+		Return(challenge, nil)
+
+	verified, err := t.service.VerifyChallenge(ctx, mfaToken, "123456")
+	t.Require().Nil(verified)
+	t.Require().ErrorIs(err, errs.ErrInvalidMFAChallenge)
+}
@@ -0,0 +1,65 @@
+package mfa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+)
+
+// VerifyChallenge completes the second login step with a TOTP or recovery code and returns the user.
+// Every code entered takes an attempt before it is checked, even when codes are entered concurrently,
+// and the challenge is gone once the attempts are exhausted. A right code removes the challenge,
+// so it cannot be completed twice.
+func (s *service) VerifyChallenge(ctx context.Context, mfaToken, code string) (*model.User, error) {
+	tokenHash := token.Hash(mfaToken)
+	maxAttempts := s.config.GetChallengeMaxAttempts()
+
+	challenge, err := s.challenges.TakeAttempt(ctx, tokenHash, maxAttempts)
+	if err != nil {
+		if errors.Is(err, errs.ErrMFAChallengeNotFound) {
+			return nil, errs.ErrInvalidMFAChallenge
+		}
+
+		return nil, fmt.Errorf("take mfa challenge attempt: %w", err)
+	}
+
+	if !time.Now().Before(challenge.ExpiresAt) {
+		return nil, errs.ErrInvalidMFAChallenge
+	}
+
+	var user *model.User
+
+	if txErr := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		user, err = s.users.GetByID(ctx, challenge.UserID)
+		if err != nil {
+			return fmt.Errorf("get user by id: %w", err)
+		}
+
+		if !user.IsMFAEnabled() {
+			return errs.ErrInvalidMFAChallenge
+		}
+
+		return s.verifyCode(ctx, user, code)
+	}); txErr != nil {
+		return nil, fmt.Errorf("transaction error: %w", txErr)
+	}
+
+	// The last attempt removed the challenge already.
+	if challenge.Attempts < maxAttempts {
+		err = s.challenges.Delete(ctx, tokenHash)
+		if err != nil {
+			if errors.Is(err, errs.ErrMFAChallengeNotFound) {
+				return nil, errs.ErrInvalidMFAChallenge
+			}
+
+			return nil, fmt.Errorf("delete mfa challenge: %w", err)
+		}
+	}
+
+	return user, nil
+}
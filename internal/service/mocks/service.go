@@ -0,0 +1,1290 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source service.go -destination mocks/service.go -package mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/Paul1k96/microservices_course_auth/internal/model"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserService is a mock of UserService interface.
+type MockUserService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockUserServiceMockRecorder is the mock recorder for MockUserService.
+type MockUserServiceMockRecorder struct {
+	mock *MockUserService
+}
+
+// NewMockUserService creates a new mock instance.
+func NewMockUserService(ctrl *gomock.Controller) *MockUserService {
+	mock := &MockUserService{ctrl: ctrl}
+	mock.recorder = &MockUserServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserService) EXPECT() *MockUserServiceMockRecorder {
+	return m.recorder
+}
+
+// AddToOrg mocks base method.
+func (m *MockUserService) AddToOrg(ctx context.Context, id, orgID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddToOrg", ctx, id, orgID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddToOrg indicates an expected call of AddToOrg.
+func (mr *MockUserServiceMockRecorder) AddToOrg(ctx, id, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToOrg", reflect.TypeOf((*MockUserService)(nil).AddToOrg), ctx, id, orgID)
+}
+
+// ChangePassword mocks base method.
+func (m *MockUserService) ChangePassword(ctx context.Context, accessToken, oldPassword, newPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, accessToken, oldPassword, newPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockUserServiceMockRecorder) ChangePassword(ctx, accessToken, oldPassword, newPassword any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockUserService)(nil).ChangePassword), ctx, accessToken, oldPassword, newPassword)
+}
+
+// Create mocks base method.
+func (m *MockUserService) Create(ctx context.Context, user *model.User) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, user)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUserServiceMockRecorder) Create(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserService)(nil).Create), ctx, user)
+}
+
+// Delete mocks base method.
+func (m *MockUserService) Delete(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserServiceMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserService)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockUserService) Get(ctx context.Context, accessToken string, id int64) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, accessToken, id)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockUserServiceMockRecorder) Get(ctx, accessToken, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUserService)(nil).Get), ctx, accessToken, id)
+}
+
+// GetByID mocks base method.
+func (m *MockUserService) GetByID(ctx context.Context, id int64) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockUserServiceMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUserService)(nil).GetByID), ctx, id)
+}
+
+// GetListByIDs mocks base method.
+func (m *MockUserService) GetListByIDs(ctx context.Context, accessToken string, ids []int64) ([]*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListByIDs", ctx, accessToken, ids)
+	ret0, _ := ret[0].([]*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListByIDs indicates an expected call of GetListByIDs.
+func (mr *MockUserServiceMockRecorder) GetListByIDs(ctx, accessToken, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListByIDs", reflect.TypeOf((*MockUserService)(nil).GetListByIDs), ctx, accessToken, ids)
+}
+
+// ResetPassword mocks base method.
+func (m *MockUserService) ResetPassword(ctx context.Context, id int64, newPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, id, newPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockUserServiceMockRecorder) ResetPassword(ctx, id, newPassword any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockUserService)(nil).ResetPassword), ctx, id, newPassword)
+}
+
+// Search mocks base method.
+func (m *MockUserService) Search(ctx context.Context, accessToken string, filter *model.UserFilter, pageToken string) (*model.UsersPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, accessToken, filter, pageToken)
+	ret0, _ := ret[0].(*model.UsersPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockUserServiceMockRecorder) Search(ctx, accessToken, filter, pageToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockUserService)(nil).Search), ctx, accessToken, filter, pageToken)
+}
+
+// Update mocks base method.
+func (m *MockUserService) Update(ctx context.Context, user *model.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUserServiceMockRecorder) Update(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserService)(nil).Update), ctx, user)
+}
+
+// VerifyCredentials mocks base method.
+func (m *MockUserService) VerifyCredentials(ctx context.Context, orgID int64, email, password string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyCredentials", ctx, orgID, email, password)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyCredentials indicates an expected call of VerifyCredentials.
+func (mr *MockUserServiceMockRecorder) VerifyCredentials(ctx, orgID, email, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyCredentials", reflect.TypeOf((*MockUserService)(nil).VerifyCredentials), ctx, orgID, email, password)
+}
+
+// MockAuthService is a mock of AuthService interface.
+type MockAuthService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthServiceMockRecorder is the mock recorder for MockAuthService.
+type MockAuthServiceMockRecorder struct {
+	mock *MockAuthService
+}
+
+// NewMockAuthService creates a new mock instance.
+func NewMockAuthService(ctrl *gomock.Controller) *MockAuthService {
+	mock := &MockAuthService{ctrl: ctrl}
+	mock.recorder = &MockAuthServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthService) EXPECT() *MockAuthServiceMockRecorder {
+	return m.recorder
+}
+
+// Impersonate mocks base method.
+func (m *MockAuthService) Impersonate(ctx context.Context, accessToken string, userID int64, reason string) (*model.ImpersonationToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Impersonate", ctx, accessToken, userID, reason)
+	ret0, _ := ret[0].(*model.ImpersonationToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Impersonate indicates an expected call of Impersonate.
+func (mr *MockAuthServiceMockRecorder) Impersonate(ctx, accessToken, userID, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Impersonate", reflect.TypeOf((*MockAuthService)(nil).Impersonate), ctx, accessToken, userID, reason)
+}
+
+// ListSessions mocks base method.
+func (m *MockAuthService) ListSessions(ctx context.Context, accessToken string, userID int64) ([]*model.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, accessToken, userID)
+	ret0, _ := ret[0].([]*model.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockAuthServiceMockRecorder) ListSessions(ctx, accessToken, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockAuthService)(nil).ListSessions), ctx, accessToken, userID)
+}
+
+// Login mocks base method.
+func (m *MockAuthService) Login(ctx context.Context, orgID int64, email, password, ip string) (*model.LoginResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", ctx, orgID, email, password, ip)
+	ret0, _ := ret[0].(*model.LoginResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockAuthServiceMockRecorder) Login(ctx, orgID, email, password, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthService)(nil).Login), ctx, orgID, email, password, ip)
+}
+
+// Logout mocks base method.
+func (m *MockAuthService) Logout(ctx context.Context, accessToken, refreshToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, accessToken, refreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAuthServiceMockRecorder) Logout(ctx, accessToken, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthService)(nil).Logout), ctx, accessToken, refreshToken)
+}
+
+// RefreshToken mocks base method.
+func (m *MockAuthService) RefreshToken(ctx context.Context, refreshToken string) (*model.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshToken", ctx, refreshToken)
+	ret0, _ := ret[0].(*model.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshToken indicates an expected call of RefreshToken.
+func (mr *MockAuthServiceMockRecorder) RefreshToken(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshToken", reflect.TypeOf((*MockAuthService)(nil).RefreshToken), ctx, refreshToken)
+}
+
+// RevokeSession mocks base method.
+func (m *MockAuthService) RevokeSession(ctx context.Context, accessToken string, sessionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, accessToken, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockAuthServiceMockRecorder) RevokeSession(ctx, accessToken, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockAuthService)(nil).RevokeSession), ctx, accessToken, sessionID)
+}
+
+// RevokeUserTokens mocks base method.
+func (m *MockAuthService) RevokeUserTokens(ctx context.Context, accessToken string, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserTokens", ctx, accessToken, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserTokens indicates an expected call of RevokeUserTokens.
+func (mr *MockAuthServiceMockRecorder) RevokeUserTokens(ctx, accessToken, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserTokens", reflect.TypeOf((*MockAuthService)(nil).RevokeUserTokens), ctx, accessToken, userID)
+}
+
+// VerifyMFA mocks base method.
+func (m *MockAuthService) VerifyMFA(ctx context.Context, mfaToken, code string) (*model.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyMFA", ctx, mfaToken, code)
+	ret0, _ := ret[0].(*model.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyMFA indicates an expected call of VerifyMFA.
+func (mr *MockAuthServiceMockRecorder) VerifyMFA(ctx, mfaToken, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyMFA", reflect.TypeOf((*MockAuthService)(nil).VerifyMFA), ctx, mfaToken, code)
+}
+
+// MockPasswordResetService is a mock of PasswordResetService interface.
+type MockPasswordResetService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordResetServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPasswordResetServiceMockRecorder is the mock recorder for MockPasswordResetService.
+type MockPasswordResetServiceMockRecorder struct {
+	mock *MockPasswordResetService
+}
+
+// NewMockPasswordResetService creates a new mock instance.
+func NewMockPasswordResetService(ctrl *gomock.Controller) *MockPasswordResetService {
+	mock := &MockPasswordResetService{ctrl: ctrl}
+	mock.recorder = &MockPasswordResetServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordResetService) EXPECT() *MockPasswordResetServiceMockRecorder {
+	return m.recorder
+}
+
+// Confirm mocks base method.
+func (m *MockPasswordResetService) Confirm(ctx context.Context, resetToken, newPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", ctx, resetToken, newPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockPasswordResetServiceMockRecorder) Confirm(ctx, resetToken, newPassword any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockPasswordResetService)(nil).Confirm), ctx, resetToken, newPassword)
+}
+
+// Request mocks base method.
+func (m *MockPasswordResetService) Request(ctx context.Context, orgID int64, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Request", ctx, orgID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Request indicates an expected call of Request.
+func (mr *MockPasswordResetServiceMockRecorder) Request(ctx, orgID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Request", reflect.TypeOf((*MockPasswordResetService)(nil).Request), ctx, orgID, email)
+}
+
+// MockEmailVerificationService is a mock of EmailVerificationService interface.
+type MockEmailVerificationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailVerificationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockEmailVerificationServiceMockRecorder is the mock recorder for MockEmailVerificationService.
+type MockEmailVerificationServiceMockRecorder struct {
+	mock *MockEmailVerificationService
+}
+
+// NewMockEmailVerificationService creates a new mock instance.
+func NewMockEmailVerificationService(ctrl *gomock.Controller) *MockEmailVerificationService {
+	mock := &MockEmailVerificationService{ctrl: ctrl}
+	mock.recorder = &MockEmailVerificationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailVerificationService) EXPECT() *MockEmailVerificationServiceMockRecorder {
+	return m.recorder
+}
+
+// Resend mocks base method.
+func (m *MockEmailVerificationService) Resend(ctx context.Context, orgID int64, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resend", ctx, orgID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resend indicates an expected call of Resend.
+func (mr *MockEmailVerificationServiceMockRecorder) Resend(ctx, orgID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resend", reflect.TypeOf((*MockEmailVerificationService)(nil).Resend), ctx, orgID, email)
+}
+
+// Send mocks base method.
+func (m *MockEmailVerificationService) Send(ctx context.Context, user *model.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockEmailVerificationServiceMockRecorder) Send(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockEmailVerificationService)(nil).Send), ctx, user)
+}
+
+// Verify mocks base method.
+func (m *MockEmailVerificationService) Verify(ctx context.Context, verificationToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx, verificationToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockEmailVerificationServiceMockRecorder) Verify(ctx, verificationToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockEmailVerificationService)(nil).Verify), ctx, verificationToken)
+}
+
+// MockSessionService is a mock of SessionService interface.
+type MockSessionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSessionServiceMockRecorder is the mock recorder for MockSessionService.
+type MockSessionServiceMockRecorder struct {
+	mock *MockSessionService
+}
+
+// NewMockSessionService creates a new mock instance.
+func NewMockSessionService(ctrl *gomock.Controller) *MockSessionService {
+	mock := &MockSessionService{ctrl: ctrl}
+	mock.recorder = &MockSessionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionService) EXPECT() *MockSessionServiceMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockSessionService) Authenticate(ctx context.Context, accessToken string) (*model.UserClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, accessToken)
+	ret0, _ := ret[0].(*model.UserClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockSessionServiceMockRecorder) Authenticate(ctx, accessToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockSessionService)(nil).Authenticate), ctx, accessToken)
+}
+
+// Issue mocks base method.
+func (m *MockSessionService) Issue(ctx context.Context, user *model.User, familyID uuid.UUID, mfa bool) (*model.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", ctx, user, familyID, mfa)
+	ret0, _ := ret[0].(*model.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockSessionServiceMockRecorder) Issue(ctx, user, familyID, mfa any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockSessionService)(nil).Issue), ctx, user, familyID, mfa)
+}
+
+// IssueImpersonation mocks base method.
+func (m *MockSessionService) IssueImpersonation(ctx context.Context, actorID int64, user *model.User) (*model.ImpersonationToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueImpersonation", ctx, actorID, user)
+	ret0, _ := ret[0].(*model.ImpersonationToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueImpersonation indicates an expected call of IssueImpersonation.
+func (mr *MockSessionServiceMockRecorder) IssueImpersonation(ctx, actorID, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueImpersonation", reflect.TypeOf((*MockSessionService)(nil).IssueImpersonation), ctx, actorID, user)
+}
+
+// RevokeAccessToken mocks base method.
+func (m *MockSessionService) RevokeAccessToken(ctx context.Context, claims *model.UserClaims) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccessToken", ctx, claims)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAccessToken indicates an expected call of RevokeAccessToken.
+func (mr *MockSessionServiceMockRecorder) RevokeAccessToken(ctx, claims any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccessToken", reflect.TypeOf((*MockSessionService)(nil).RevokeAccessToken), ctx, claims)
+}
+
+// RevokeFamily mocks base method.
+func (m *MockSessionService) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeFamily", ctx, familyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeFamily indicates an expected call of RevokeFamily.
+func (mr *MockSessionServiceMockRecorder) RevokeFamily(ctx, familyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeFamily", reflect.TypeOf((*MockSessionService)(nil).RevokeFamily), ctx, familyID)
+}
+
+// RevokeOtherSessions mocks base method.
+func (m *MockSessionService) RevokeOtherSessions(ctx context.Context, claims *model.UserClaims) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeOtherSessions", ctx, claims)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeOtherSessions indicates an expected call of RevokeOtherSessions.
+func (mr *MockSessionServiceMockRecorder) RevokeOtherSessions(ctx, claims any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeOtherSessions", reflect.TypeOf((*MockSessionService)(nil).RevokeOtherSessions), ctx, claims)
+}
+
+// RevokeUserTokens mocks base method.
+func (m *MockSessionService) RevokeUserTokens(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserTokens", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserTokens indicates an expected call of RevokeUserTokens.
+func (mr *MockSessionServiceMockRecorder) RevokeUserTokens(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserTokens", reflect.TypeOf((*MockSessionService)(nil).RevokeUserTokens), ctx, userID)
+}
+
+// VerifyAccessToken mocks base method.
+func (m *MockSessionService) VerifyAccessToken(ctx context.Context, accessToken string) (*model.UserClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyAccessToken", ctx, accessToken)
+	ret0, _ := ret[0].(*model.UserClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyAccessToken indicates an expected call of VerifyAccessToken.
+func (mr *MockSessionServiceMockRecorder) VerifyAccessToken(ctx, accessToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyAccessToken", reflect.TypeOf((*MockSessionService)(nil).VerifyAccessToken), ctx, accessToken)
+}
+
+// MockAPIKeyService is a mock of APIKeyService interface.
+type MockAPIKeyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIKeyServiceMockRecorder is the mock recorder for MockAPIKeyService.
+type MockAPIKeyServiceMockRecorder struct {
+	mock *MockAPIKeyService
+}
+
+// NewMockAPIKeyService creates a new mock instance.
+func NewMockAPIKeyService(ctrl *gomock.Controller) *MockAPIKeyService {
+	mock := &MockAPIKeyService{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyService) EXPECT() *MockAPIKeyServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAPIKeyService) Create(ctx context.Context, accessToken, name string, scopes []string, expiresAt *time.Time) (*model.CreatedAPIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, accessToken, name, scopes, expiresAt)
+	ret0, _ := ret[0].(*model.CreatedAPIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAPIKeyServiceMockRecorder) Create(ctx, accessToken, name, scopes, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAPIKeyService)(nil).Create), ctx, accessToken, name, scopes, expiresAt)
+}
+
+// List mocks base method.
+func (m *MockAPIKeyService) List(ctx context.Context, accessToken string) ([]*model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, accessToken)
+	ret0, _ := ret[0].([]*model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAPIKeyServiceMockRecorder) List(ctx, accessToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAPIKeyService)(nil).List), ctx, accessToken)
+}
+
+// Revoke mocks base method.
+func (m *MockAPIKeyService) Revoke(ctx context.Context, accessToken string, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, accessToken, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockAPIKeyServiceMockRecorder) Revoke(ctx, accessToken, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockAPIKeyService)(nil).Revoke), ctx, accessToken, id)
+}
+
+// MockOAuthService is a mock of OAuthService interface.
+type MockOAuthService struct {
+	ctrl     *gomock.Controller
+	recorder *MockOAuthServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockOAuthServiceMockRecorder is the mock recorder for MockOAuthService.
+type MockOAuthServiceMockRecorder struct {
+	mock *MockOAuthService
+}
+
+// NewMockOAuthService creates a new mock instance.
+func NewMockOAuthService(ctrl *gomock.Controller) *MockOAuthService {
+	mock := &MockOAuthService{ctrl: ctrl}
+	mock.recorder = &MockOAuthServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOAuthService) EXPECT() *MockOAuthServiceMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockOAuthService) Authorize(ctx context.Context, accessToken string, request *model.AuthorizationRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", ctx, accessToken, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockOAuthServiceMockRecorder) Authorize(ctx, accessToken, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockOAuthService)(nil).Authorize), ctx, accessToken, request)
+}
+
+// CreateClient mocks base method.
+func (m *MockOAuthService) CreateClient(ctx context.Context, accessToken, name string, scopes, redirectURIs []string) (*model.CreatedOAuthClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClient", ctx, accessToken, name, scopes, redirectURIs)
+	ret0, _ := ret[0].(*model.CreatedOAuthClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClient indicates an expected call of CreateClient.
+func (mr *MockOAuthServiceMockRecorder) CreateClient(ctx, accessToken, name, scopes, redirectURIs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClient", reflect.TypeOf((*MockOAuthService)(nil).CreateClient), ctx, accessToken, name, scopes, redirectURIs)
+}
+
+// ExchangeAuthorizationCode mocks base method.
+func (m *MockOAuthService) ExchangeAuthorizationCode(ctx context.Context, clientID, clientSecret, code, redirectURI, codeVerifier string) (*model.ClientToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExchangeAuthorizationCode", ctx, clientID, clientSecret, code, redirectURI, codeVerifier)
+	ret0, _ := ret[0].(*model.ClientToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExchangeAuthorizationCode indicates an expected call of ExchangeAuthorizationCode.
+func (mr *MockOAuthServiceMockRecorder) ExchangeAuthorizationCode(ctx, clientID, clientSecret, code, redirectURI, codeVerifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExchangeAuthorizationCode", reflect.TypeOf((*MockOAuthService)(nil).ExchangeAuthorizationCode), ctx, clientID, clientSecret, code, redirectURI, codeVerifier)
+}
+
+// IssueClientToken mocks base method.
+func (m *MockOAuthService) IssueClientToken(ctx context.Context, clientID, clientSecret string, scopes []string) (*model.ClientToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueClientToken", ctx, clientID, clientSecret, scopes)
+	ret0, _ := ret[0].(*model.ClientToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueClientToken indicates an expected call of IssueClientToken.
+func (mr *MockOAuthServiceMockRecorder) IssueClientToken(ctx, clientID, clientSecret, scopes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueClientToken", reflect.TypeOf((*MockOAuthService)(nil).IssueClientToken), ctx, clientID, clientSecret, scopes)
+}
+
+// RevokeClient mocks base method.
+func (m *MockOAuthService) RevokeClient(ctx context.Context, accessToken, clientID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeClient", ctx, accessToken, clientID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeClient indicates an expected call of RevokeClient.
+func (mr *MockOAuthServiceMockRecorder) RevokeClient(ctx, accessToken, clientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeClient", reflect.TypeOf((*MockOAuthService)(nil).RevokeClient), ctx, accessToken, clientID)
+}
+
+// UserInfo mocks base method.
+func (m *MockOAuthService) UserInfo(ctx context.Context, accessToken string) (*model.UserInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserInfo", ctx, accessToken)
+	ret0, _ := ret[0].(*model.UserInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserInfo indicates an expected call of UserInfo.
+func (mr *MockOAuthServiceMockRecorder) UserInfo(ctx, accessToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserInfo", reflect.TypeOf((*MockOAuthService)(nil).UserInfo), ctx, accessToken)
+}
+
+// MockMFAService is a mock of MFAService interface.
+type MockMFAService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMFAServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockMFAServiceMockRecorder is the mock recorder for MockMFAService.
+type MockMFAServiceMockRecorder struct {
+	mock *MockMFAService
+}
+
+// NewMockMFAService creates a new mock instance.
+func NewMockMFAService(ctrl *gomock.Controller) *MockMFAService {
+	mock := &MockMFAService{ctrl: ctrl}
+	mock.recorder = &MockMFAServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMFAService) EXPECT() *MockMFAServiceMockRecorder {
+	return m.recorder
+}
+
+// Challenge mocks base method.
+func (m *MockMFAService) Challenge(ctx context.Context, user *model.User) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Challenge", ctx, user)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Challenge indicates an expected call of Challenge.
+func (mr *MockMFAServiceMockRecorder) Challenge(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Challenge", reflect.TypeOf((*MockMFAService)(nil).Challenge), ctx, user)
+}
+
+// ConfirmTOTP mocks base method.
+func (m *MockMFAService) ConfirmTOTP(ctx context.Context, accessToken, code string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTOTP", ctx, accessToken, code)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmTOTP indicates an expected call of ConfirmTOTP.
+func (mr *MockMFAServiceMockRecorder) ConfirmTOTP(ctx, accessToken, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTOTP", reflect.TypeOf((*MockMFAService)(nil).ConfirmTOTP), ctx, accessToken, code)
+}
+
+// DisableTOTP mocks base method.
+func (m *MockMFAService) DisableTOTP(ctx context.Context, accessToken, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTOTP", ctx, accessToken, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTOTP indicates an expected call of DisableTOTP.
+func (mr *MockMFAServiceMockRecorder) DisableTOTP(ctx, accessToken, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTOTP", reflect.TypeOf((*MockMFAService)(nil).DisableTOTP), ctx, accessToken, code)
+}
+
+// EnrollTOTP mocks base method.
+func (m *MockMFAService) EnrollTOTP(ctx context.Context, accessToken string) (*model.TOTPEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollTOTP", ctx, accessToken)
+	ret0, _ := ret[0].(*model.TOTPEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollTOTP indicates an expected call of EnrollTOTP.
+func (mr *MockMFAServiceMockRecorder) EnrollTOTP(ctx, accessToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollTOTP", reflect.TypeOf((*MockMFAService)(nil).EnrollTOTP), ctx, accessToken)
+}
+
+// VerifyChallenge mocks base method.
+func (m *MockMFAService) VerifyChallenge(ctx context.Context, mfaToken, code string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyChallenge", ctx, mfaToken, code)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyChallenge indicates an expected call of VerifyChallenge.
+func (mr *MockMFAServiceMockRecorder) VerifyChallenge(ctx, mfaToken, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyChallenge", reflect.TypeOf((*MockMFAService)(nil).VerifyChallenge), ctx, mfaToken, code)
+}
+
+// MockLockoutService is a mock of LockoutService interface.
+type MockLockoutService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLockoutServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockLockoutServiceMockRecorder is the mock recorder for MockLockoutService.
+type MockLockoutServiceMockRecorder struct {
+	mock *MockLockoutService
+}
+
+// NewMockLockoutService creates a new mock instance.
+func NewMockLockoutService(ctrl *gomock.Controller) *MockLockoutService {
+	mock := &MockLockoutService{ctrl: ctrl}
+	mock.recorder = &MockLockoutServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLockoutService) EXPECT() *MockLockoutServiceMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockLockoutService) Check(ctx context.Context, orgID int64, email, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx, orgID, email, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockLockoutServiceMockRecorder) Check(ctx, orgID, email, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockLockoutService)(nil).Check), ctx, orgID, email, ip)
+}
+
+// RegisterFailure mocks base method.
+func (m *MockLockoutService) RegisterFailure(ctx context.Context, orgID int64, email, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterFailure", ctx, orgID, email, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterFailure indicates an expected call of RegisterFailure.
+func (mr *MockLockoutServiceMockRecorder) RegisterFailure(ctx, orgID, email, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterFailure", reflect.TypeOf((*MockLockoutService)(nil).RegisterFailure), ctx, orgID, email, ip)
+}
+
+// Reset mocks base method.
+func (m *MockLockoutService) Reset(ctx context.Context, orgID int64, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", ctx, orgID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockLockoutServiceMockRecorder) Reset(ctx, orgID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockLockoutService)(nil).Reset), ctx, orgID, email)
+}
+
+// Unlock mocks base method.
+func (m *MockLockoutService) Unlock(ctx context.Context, accessToken string, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlock", ctx, accessToken, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unlock indicates an expected call of Unlock.
+func (mr *MockLockoutServiceMockRecorder) Unlock(ctx, accessToken, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockLockoutService)(nil).Unlock), ctx, accessToken, userID)
+}
+
+// MockRateLimitService is a mock of RateLimitService interface.
+type MockRateLimitService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimitServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockRateLimitServiceMockRecorder is the mock recorder for MockRateLimitService.
+type MockRateLimitServiceMockRecorder struct {
+	mock *MockRateLimitService
+}
+
+// NewMockRateLimitService creates a new mock instance.
+func NewMockRateLimitService(ctrl *gomock.Controller) *MockRateLimitService {
+	mock := &MockRateLimitService{ctrl: ctrl}
+	mock.recorder = &MockRateLimitServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimitService) EXPECT() *MockRateLimitServiceMockRecorder {
+	return m.recorder
+}
+
+// Take mocks base method.
+func (m *MockRateLimitService) Take(ctx context.Context, endpoint, caller string) (*model.RateLimitResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Take", ctx, endpoint, caller)
+	ret0, _ := ret[0].(*model.RateLimitResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockRateLimitServiceMockRecorder) Take(ctx, endpoint, caller any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockRateLimitService)(nil).Take), ctx, endpoint, caller)
+}
+
+// MockAccessService is a mock of AccessService interface.
+type MockAccessService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAccessServiceMockRecorder is the mock recorder for MockAccessService.
+type MockAccessServiceMockRecorder struct {
+	mock *MockAccessService
+}
+
+// NewMockAccessService creates a new mock instance.
+func NewMockAccessService(ctrl *gomock.Controller) *MockAccessService {
+	mock := &MockAccessService{ctrl: ctrl}
+	mock.recorder = &MockAccessServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessService) EXPECT() *MockAccessServiceMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockAccessService) Check(ctx context.Context, accessToken, endpointAddress string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx, accessToken, endpointAddress)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockAccessServiceMockRecorder) Check(ctx, accessToken, endpointAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockAccessService)(nil).Check), ctx, accessToken, endpointAddress)
+}
+
+// MockRoleService is a mock of RoleService interface.
+type MockRoleService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockRoleServiceMockRecorder is the mock recorder for MockRoleService.
+type MockRoleServiceMockRecorder struct {
+	mock *MockRoleService
+}
+
+// NewMockRoleService creates a new mock instance.
+func NewMockRoleService(ctrl *gomock.Controller) *MockRoleService {
+	mock := &MockRoleService{ctrl: ctrl}
+	mock.recorder = &MockRoleServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleService) EXPECT() *MockRoleServiceMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockRoleService) Assign(ctx context.Context, accessToken string, userID, roleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, accessToken, userID, roleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockRoleServiceMockRecorder) Assign(ctx, accessToken, userID, roleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockRoleService)(nil).Assign), ctx, accessToken, userID, roleID)
+}
+
+// Create mocks base method.
+func (m *MockRoleService) Create(ctx context.Context, accessToken string, role *model.Role) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, accessToken, role)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRoleServiceMockRecorder) Create(ctx, accessToken, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoleService)(nil).Create), ctx, accessToken, role)
+}
+
+// Delete mocks base method.
+func (m *MockRoleService) Delete(ctx context.Context, accessToken string, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, accessToken, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRoleServiceMockRecorder) Delete(ctx, accessToken, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRoleService)(nil).Delete), ctx, accessToken, id)
+}
+
+// HasPermission mocks base method.
+func (m *MockRoleService) HasPermission(ctx context.Context, userID, orgID int64, permission string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPermission", ctx, userID, orgID, permission)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasPermission indicates an expected call of HasPermission.
+func (mr *MockRoleServiceMockRecorder) HasPermission(ctx, userID, orgID, permission any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPermission", reflect.TypeOf((*MockRoleService)(nil).HasPermission), ctx, userID, orgID, permission)
+}
+
+// List mocks base method.
+func (m *MockRoleService) List(ctx context.Context, accessToken string) ([]*model.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, accessToken)
+	ret0, _ := ret[0].([]*model.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRoleServiceMockRecorder) List(ctx, accessToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRoleService)(nil).List), ctx, accessToken)
+}
+
+// Permissions mocks base method.
+func (m *MockRoleService) Permissions(ctx context.Context, userID, orgID int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Permissions", ctx, userID, orgID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Permissions indicates an expected call of Permissions.
+func (mr *MockRoleServiceMockRecorder) Permissions(ctx, userID, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Permissions", reflect.TypeOf((*MockRoleService)(nil).Permissions), ctx, userID, orgID)
+}
+
+// Seed mocks base method.
+func (m *MockRoleService) Seed(ctx context.Context, permissions []string, grants map[string][]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Seed", ctx, permissions, grants)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Seed indicates an expected call of Seed.
+func (mr *MockRoleServiceMockRecorder) Seed(ctx, permissions, grants any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seed", reflect.TypeOf((*MockRoleService)(nil).Seed), ctx, permissions, grants)
+}
+
+// SetOrgRole mocks base method.
+func (m *MockRoleService) SetOrgRole(ctx context.Context, accessToken string, userID, roleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOrgRole", ctx, accessToken, userID, roleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOrgRole indicates an expected call of SetOrgRole.
+func (mr *MockRoleServiceMockRecorder) SetOrgRole(ctx, accessToken, userID, roleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOrgRole", reflect.TypeOf((*MockRoleService)(nil).SetOrgRole), ctx, accessToken, userID, roleID)
+}
+
+// Unassign mocks base method.
+func (m *MockRoleService) Unassign(ctx context.Context, accessToken string, userID, roleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unassign", ctx, accessToken, userID, roleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unassign indicates an expected call of Unassign.
+func (mr *MockRoleServiceMockRecorder) Unassign(ctx, accessToken, userID, roleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockRoleService)(nil).Unassign), ctx, accessToken, userID, roleID)
+}
+
+// Update mocks base method.
+func (m *MockRoleService) Update(ctx context.Context, accessToken string, role *model.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, accessToken, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRoleServiceMockRecorder) Update(ctx, accessToken, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRoleService)(nil).Update), ctx, accessToken, role)
+}
+
+// MockSigningKeyService is a mock of SigningKeyService interface.
+type MockSigningKeyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSigningKeyServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSigningKeyServiceMockRecorder is the mock recorder for MockSigningKeyService.
+type MockSigningKeyServiceMockRecorder struct {
+	mock *MockSigningKeyService
+}
+
+// NewMockSigningKeyService creates a new mock instance.
+func NewMockSigningKeyService(ctrl *gomock.Controller) *MockSigningKeyService {
+	mock := &MockSigningKeyService{ctrl: ctrl}
+	mock.recorder = &MockSigningKeyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSigningKeyService) EXPECT() *MockSigningKeyServiceMockRecorder {
+	return m.recorder
+}
+
+// GetSigningKey mocks base method.
+func (m *MockSigningKeyService) GetSigningKey(ctx context.Context) (*model.SigningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSigningKey", ctx)
+	ret0, _ := ret[0].(*model.SigningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSigningKey indicates an expected call of GetSigningKey.
+func (mr *MockSigningKeyServiceMockRecorder) GetSigningKey(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSigningKey", reflect.TypeOf((*MockSigningKeyService)(nil).GetSigningKey), ctx)
+}
+
+// GetVerificationKey mocks base method.
+func (m *MockSigningKeyService) GetVerificationKey(ctx context.Context, id string) (*model.SigningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVerificationKey", ctx, id)
+	ret0, _ := ret[0].(*model.SigningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVerificationKey indicates an expected call of GetVerificationKey.
+func (mr *MockSigningKeyServiceMockRecorder) GetVerificationKey(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVerificationKey", reflect.TypeOf((*MockSigningKeyService)(nil).GetVerificationKey), ctx, id)
+}
+
+// GetVerificationKeys mocks base method.
+func (m *MockSigningKeyService) GetVerificationKeys(ctx context.Context) ([]*model.SigningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVerificationKeys", ctx)
+	ret0, _ := ret[0].([]*model.SigningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVerificationKeys indicates an expected call of GetVerificationKeys.
+func (mr *MockSigningKeyServiceMockRecorder) GetVerificationKeys(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVerificationKeys", reflect.TypeOf((*MockSigningKeyService)(nil).GetVerificationKeys), ctx)
+}
+
+// Rotate mocks base method.
+func (m *MockSigningKeyService) Rotate(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rotate indicates an expected call of Rotate.
+func (mr *MockSigningKeyServiceMockRecorder) Rotate(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockSigningKeyService)(nil).Rotate), ctx)
+}
@@ -0,0 +1,94 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+)
+
+const responseTypeCode = "code"
+
+// Authorize handles an OpenID Connect authorization request of the access token owner and returns
+// an authorization code for the client. The client and redirect URI are checked first, errors about them
+// must not be sent to the redirect URI. Only the authorization code flow with S256 PKCE is supported.
+func (s *service) Authorize(
+	ctx context.Context,
+	accessToken string,
+	request *model.AuthorizationRequest,
+) (string, error) {
+	client, err := s.getClient(ctx, request.ClientID)
+	if err != nil {
+		return "", err
+	}
+
+	if !slices.Contains(client.RedirectURIs, request.RedirectURI) {
+		return "", fmt.Errorf("check redirect uri of oauth client %s: %w", client.ID, errs.ErrInvalidRedirectURI)
+	}
+
+	err = validateAuthorizationRequest(request)
+	if err != nil {
+		return "", err
+	}
+
+	claims, err := s.sessionService.VerifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return "", fmt.Errorf("verify access token: %w", err)
+	}
+
+	if claims.APIKeyID != "" || claims.ClientID != "" {
+		return "", fmt.Errorf("authorize oauth client with delegated token: %w", errs.ErrInvalidAccessToken)
+	}
+
+	if claims.HasRole(model.RoleAdmin) && !claims.MFA {
+		return "", fmt.Errorf("authorize oauth client as admin without mfa: %w", errs.ErrMFARequired)
+	}
+
+	code, err := token.GenerateOpaque()
+	if err != nil {
+		return "", fmt.Errorf("generate authorization code: %w", err)
+	}
+
+	ttl := s.config.GetAuthorizationCodeTTL()
+
+	err = s.authorizationCodes.Set(ctx, token.Hash(code), &model.AuthorizationCode{
+		ClientID:      client.ID,
+		UserID:        claims.UserID,
+		RedirectURI:   request.RedirectURI,
+		Scopes:        request.Scopes,
+		Nonce:         request.Nonce,
+		CodeChallenge: request.CodeChallenge,
+		ExpiresAt:     time.Now().Add(ttl),
+	}, ttl)
+	if err != nil {
+		return "", fmt.Errorf("save authorization code: %w", err)
+	}
+
+	return code, nil
+}
+
+func validateAuthorizationRequest(request *model.AuthorizationRequest) error {
+	if request.ResponseType != responseTypeCode {
+		return fmt.Errorf("response type %q: %w", request.ResponseType, errs.ErrInvalidAuthorizationRequest)
+	}
+
+	if !slices.Contains(request.Scopes, model.ScopeOpenID) {
+		return fmt.Errorf("scope %s is required: %w", model.ScopeOpenID, errs.ErrInvalidScope)
+	}
+
+	for _, scope := range request.Scopes {
+		if !model.IsOIDCScope(scope) {
+			return fmt.Errorf("scope %q: %w", scope, errs.ErrInvalidScope)
+		}
+	}
+
+	if request.CodeChallenge == "" || request.CodeChallengeMethod != token.CodeChallengeMethodS256 {
+		return fmt.Errorf("pkce with %s is required: %w", token.CodeChallengeMethodS256, errs.ErrInvalidAuthorizationRequest)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package oauth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+)
+
+// authenticateClient returns the not revoked client with clientID if clientSecret is its secret.
+func (s *service) authenticateClient(ctx context.Context, clientID, clientSecret string) (*model.OAuthClient, error) {
+	client, err := s.getClient(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(token.Hash(clientSecret)), []byte(client.SecretHash)) != 1 {
+		return nil, fmt.Errorf("check secret of oauth client %s: %w", client.ID, errs.ErrInvalidClient)
+	}
+
+	return client, nil
+}
+
+// getClient returns the not revoked client with clientID.
+func (s *service) getClient(ctx context.Context, clientID string) (*model.OAuthClient, error) {
+	client, err := s.clients.GetByID(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, errs.ErrOAuthClientNotFound) {
+			return nil, fmt.Errorf("get oauth client: %w", errs.ErrInvalidClient)
+		}
+
+		return nil, fmt.Errorf("get oauth client: %w", err)
+	}
+
+	if client.RevokedAt != nil {
+		return nil, fmt.Errorf("check oauth client %s: %w", client.ID, errs.ErrInvalidClient)
+	}
+
+	return client, nil
+}
@@ -0,0 +1,136 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+)
+
+const clientIDSize = 16
+
+// CreateClient registers a new OAuth client allowed to request scopes with the client credentials grant
+// and to sign users in with OpenID Connect through redirectURIs. Only admins may do it.
+// The client secret is returned only here.
+func (s *service) CreateClient(
+	ctx context.Context,
+	accessToken, name string,
+	scopes, redirectURIs []string,
+) (*model.CreatedOAuthClient, error) {
+	err := s.checkAdmin(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validate(name, scopes, redirectURIs)
+	if len(violations) > 0 {
+		return nil, errs.NewValidationError(errs.ErrInvalidOAuthClient, violations...)
+	}
+
+	clientID, err := generateClientID()
+	if err != nil {
+		return nil, fmt.Errorf("generate client id: %w", err)
+	}
+
+	secret, err := token.GenerateOpaque()
+	if err != nil {
+		return nil, fmt.Errorf("generate client secret: %w", err)
+	}
+
+	client := &model.OAuthClient{
+		ID:           clientID,
+		Name:         name,
+		SecretHash:   token.Hash(secret),
+		Scopes:       scopes,
+		RedirectURIs: redirectURIs,
+		CreatedAt:    time.Now(),
+	}
+
+	err = s.clients.Create(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("create oauth client: %w", err)
+	}
+
+	return &model.CreatedOAuthClient{Secret: secret, Client: client}, nil
+}
+
+func (s *service) checkAdmin(ctx context.Context, accessToken string) error {
+	claims, err := s.sessionService.VerifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return fmt.Errorf("verify access token: %w", err)
+	}
+
+	granted, err := s.roleService.HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionManageOAuthClients)
+	if err != nil {
+		return fmt.Errorf("check permission: %w", err)
+	}
+
+	if !granted {
+		return fmt.Errorf("manage oauth clients as user %d: %w", claims.UserID, errs.ErrAccessDenied)
+	}
+
+	if !claims.MFA {
+		return fmt.Errorf("manage oauth clients without mfa: %w", errs.ErrMFARequired)
+	}
+
+	return nil
+}
+
+func generateClientID() (string, error) {
+	raw := make([]byte, clientIDSize)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("read random bytes: %w", err)
+	}
+
+	return hex.EncodeToString(raw), nil
+}
+
+func validate(name string, scopes, redirectURIs []string) []errs.FieldViolation {
+	var violations []errs.FieldViolation
+
+	if name == "" {
+		violations = append(violations, errs.FieldViolation{Field: "name", Description: "must not be empty"})
+	}
+
+	if len(scopes) == 0 && len(redirectURIs) == 0 {
+		violations = append(violations, errs.FieldViolation{
+			Field:       "scopes",
+			Description: "must not be empty unless redirect_uris are set",
+		})
+	}
+
+	for _, scope := range scopes {
+		if !model.IsValidScope(scope) {
+			violations = append(violations, errs.FieldViolation{
+				Field:       "scopes",
+				Description: fmt.Sprintf("%q must be a method like /pkg.Service/Method or /pkg.Service/*", scope),
+			})
+		}
+	}
+
+	for _, redirectURI := range redirectURIs {
+		if !isValidRedirectURI(redirectURI) {
+			violations = append(violations, errs.FieldViolation{
+				Field:       "redirect_uris",
+				Description: fmt.Sprintf("%q must be an absolute http or https URL without fragment", redirectURI),
+			})
+		}
+	}
+
+	return violations
+}
+
+func isValidRedirectURI(redirectURI string) bool {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		return false
+	}
+
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" && u.Fragment == ""
+}
@@ -0,0 +1,82 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+)
+
+// ExchangeAuthorizationCode implements the authorization code grant. The code is single use and
+// is accepted only from the client it was issued to, with the same redirect URI and the PKCE code verifier.
+// It returns an ID token and an access token limited to the granted OpenID Connect scopes.
+func (s *service) ExchangeAuthorizationCode(
+	ctx context.Context,
+	clientID, clientSecret, code, redirectURI, codeVerifier string,
+) (*model.ClientToken, error) {
+	client, err := s.authenticateClient(ctx, clientID, clientSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	authorization, err := s.authorizationCodes.Redeem(ctx, token.Hash(code))
+	if err != nil {
+		if errors.Is(err, errs.ErrAuthorizationCodeNotFound) {
+			return nil, fmt.Errorf("redeem authorization code: %w", errs.ErrInvalidGrant)
+		}
+
+		return nil, fmt.Errorf("redeem authorization code: %w", err)
+	}
+
+	if authorization.ClientID != client.ID ||
+		authorization.RedirectURI != redirectURI ||
+		!time.Now().Before(authorization.ExpiresAt) ||
+		!token.VerifyCodeChallenge(codeVerifier, authorization.CodeChallenge) {
+		return nil, fmt.Errorf("check authorization code of oauth client %s: %w", client.ID, errs.ErrInvalidGrant)
+	}
+
+	user, err := s.users.GetByID(ctx, authorization.UserID)
+	if err != nil {
+		if errors.Is(err, errs.ErrUserNotFound) {
+			return nil, fmt.Errorf("get user: %w", errs.ErrInvalidGrant)
+		}
+
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	signingKey, err := s.signingKeyService.GetSigningKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get signing key: %w", err)
+	}
+
+	ttl := s.config.GetIDTokenTTL()
+
+	accessToken, _, err := token.GenerateForUserClient(user, client.ID, authorization.Scopes, signingKey, ttl)
+	if err != nil {
+		return nil, fmt.Errorf("generate access token: %w", err)
+	}
+
+	idToken, err := token.GenerateIDToken(
+		user,
+		s.config.GetIssuer(),
+		client.ID,
+		authorization.Nonce,
+		authorization.Scopes,
+		signingKey,
+		ttl,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("generate id token: %w", err)
+	}
+
+	return &model.ClientToken{
+		AccessToken: accessToken,
+		IDToken:     idToken,
+		ExpiresIn:   ttl,
+		Scopes:      authorization.Scopes,
+	}, nil
+}
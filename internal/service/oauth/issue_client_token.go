@@ -0,0 +1,67 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+)
+
+// IssueClientToken implements the client credentials grant. It checks the client secret and
+// returns an access token limited to scopes, or to all scopes of the client if none are requested.
+func (s *service) IssueClientToken(
+	ctx context.Context,
+	clientID, clientSecret string,
+	scopes []string,
+) (*model.ClientToken, error) {
+	client, err := s.authenticateClient(ctx, clientID, clientSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(scopes) == 0 {
+		scopes = client.Scopes
+	}
+
+	if len(scopes) == 0 {
+		return nil, fmt.Errorf("oauth client %s has no scopes: %w", client.ID, errs.ErrInvalidScope)
+	}
+
+	for _, scope := range scopes {
+		if !isGranted(client.Scopes, scope) {
+			return nil, fmt.Errorf("check scope %q of oauth client %s: %w", scope, client.ID, errs.ErrInvalidScope)
+		}
+	}
+
+	signingKey, err := s.signingKeyService.GetSigningKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get signing key: %w", err)
+	}
+
+	ttl := s.config.GetClientTokenTTL()
+
+	accessToken, _, err := token.GenerateForClient(client.ID, scopes, signingKey, ttl)
+	if err != nil {
+		return nil, fmt.Errorf("generate client token: %w", err)
+	}
+
+	return &model.ClientToken{
+		AccessToken: accessToken,
+		ExpiresIn:   ttl,
+		Scopes:      scopes,
+	}, nil
+}
+
+// isGranted reports whether scope is one of granted or is a method covered by a granted wildcard.
+func isGranted(granted []string, scope string) bool {
+	if !model.IsValidScope(scope) {
+		return false
+	}
+
+	return slices.ContainsFunc(granted, func(pattern string) bool {
+		return model.MatchScope(pattern, scope)
+	})
+}
@@ -0,0 +1,23 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// RevokeClient revokes the OAuth client so it cannot get new tokens. Only admins may do it.
+// Tokens already issued stay valid until they expire.
+func (s *service) RevokeClient(ctx context.Context, accessToken, clientID string) error {
+	err := s.checkAdmin(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+
+	err = s.clients.Revoke(ctx, clientID, time.Now())
+	if err != nil {
+		return fmt.Errorf("revoke oauth client: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package oauth
+
+import (
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository"
+	svc "github.com/Paul1k96/microservices_course_auth/internal/service"
+)
+
+type service struct {
+	logger *slog.Logger
+
+	clients            repository.OAuthClientsRepository
+	authorizationCodes repository.AuthorizationCodesRepository
+	users              repository.UsersRepository
+
+	sessionService    svc.SessionService
+	roleService       svc.RoleService
+	signingKeyService svc.SigningKeyService
+
+	config config.OAuthConfig
+}
+
+// NewService creates a new OAuth service.
+func NewService(
+	logger *slog.Logger,
+	clients repository.OAuthClientsRepository,
+	authorizationCodes repository.AuthorizationCodesRepository,
+	users repository.UsersRepository,
+	sessionService svc.SessionService,
+	roleService svc.RoleService,
+	signingKeyService svc.SigningKeyService,
+	cfg config.OAuthConfig,
+) svc.OAuthService {
+	return &service{
+		logger:             logger,
+		clients:            clients,
+		authorizationCodes: authorizationCodes,
+		users:              users,
+		sessionService:     sessionService,
+		roleService:        roleService,
+		signingKeyService:  signingKeyService,
+		config:             cfg,
+	}
+}
@@ -0,0 +1,155 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuthorizeSuite(t *testing.T) {
+	suite.Run(t, new(AuthorizeSuite))
+}
+
+type AuthorizeSuite struct {
+	oauthSuite
+}
+
+func (t *AuthorizeSuite) request(client *model.OAuthClient) *model.AuthorizationRequest {
+	return &model.AuthorizationRequest{
+		ClientID:            client.ID,
+		RedirectURI:         client.RedirectURIs[0],
+		ResponseType:        "code",
+		Scopes:              []string{model.ScopeOpenID, model.ScopeEmail},
+		Nonce:               gofakeit.LetterN(16),
+		CodeChallenge:       gofakeit.LetterN(43),
+		CodeChallengeMethod: "S256",
+	}
+}
+
+func (t *AuthorizeSuite) TestAuthorize_Ok() {
+	ctx := context.Background()
+	client := t.client(gofakeit.LetterN(32))
+	request := t.request(client)
+	claims := &model.UserClaims{ID: gofakeit.UUID(), UserID: gofakeit.Int64(), Roles: []string{model.RoleUser}}
+	accessToken := t.accessToken(ctx, claims)
+
+	t.clients.EXPECT().GetByID(ctx, client.ID).Return(client, nil)
+	t.authorizationCodes.EXPECT().Set(ctx, gomock.Any(), gomock.Any(), t.config.authorizationCodeTTL).DoAndReturn(
+		func(_ context.Context, _ string, code *model.AuthorizationCode, _ time.Duration) error {
+			t.Require().Equal(client.ID, code.ClientID)
+			t.Require().Equal(claims.UserID, code.UserID)
+			t.Require().Equal(request.RedirectURI, code.RedirectURI)
+			t.Require().Equal(request.Scopes, code.Scopes)
+			t.Require().Equal(request.Nonce, code.Nonce)
+			t.Require().Equal(request.CodeChallenge, code.CodeChallenge)
+			return nil
+		},
+	)
+
+	code, err := t.service.Authorize(ctx, accessToken, request)
+	t.Require().NoError(err)
+	t.Require().NotEmpty(code)
+}
+
+func (t *AuthorizeSuite) TestAuthorize_UnknownRedirectURI() {
+	ctx := context.Background()
+	client := t.client(gofakeit.LetterN(32))
+	request := t.request(client)
+	request.RedirectURI = "https://evil.example.com/cb"
+
+	t.clients.EXPECT().GetByID(ctx, client.ID).Return(client, nil)
+
+	_, err := t.service.Authorize(ctx, gofakeit.LetterN(64), request)
+	t.Require().ErrorIs(err, errs.ErrInvalidRedirectURI)
+}
+
+func (t *AuthorizeSuite) TestAuthorize_UnknownClient() {
+	ctx := context.Background()
+	request := t.request(t.client(gofakeit.LetterN(32)))
+
+	t.clients.EXPECT().GetByID(ctx, request.ClientID).Return(nil, errs.ErrOAuthClientNotFound)
+
+	_, err := t.service.Authorize(ctx, gofakeit.LetterN(64), request)
+	t.Require().ErrorIs(err, errs.ErrInvalidClient)
+}
+
+func (t *AuthorizeSuite) TestAuthorize_InvalidRequest() {
+	ctx := context.Background()
+	client := t.client(gofakeit.LetterN(32))
+
+	cases := []struct {
+		name   string
+		modify func(request *model.AuthorizationRequest)
+		err    error
+	}{
+		{
+			name:   "token response type",
+			modify: func(r *model.AuthorizationRequest) { r.ResponseType = "token" },
+			err:    errs.ErrInvalidAuthorizationRequest,
+		},
+		{
+			name:   "no openid scope",
+			modify: func(r *model.AuthorizationRequest) { r.Scopes = []string{model.ScopeEmail} },
+			err:    errs.ErrInvalidScope,
+		},
+		{
+			name:   "method scope",
+			modify: func(r *model.AuthorizationRequest) { r.Scopes = append(r.Scopes, "/chat_v1.Chat/*") },
+			err:    errs.ErrInvalidScope,
+		},
+		{
+			name:   "no pkce",
+			modify: func(r *model.AuthorizationRequest) { r.CodeChallenge = "" },
+			err:    errs.ErrInvalidAuthorizationRequest,
+		},
+		{
+			name:   "plain pkce",
+			modify: func(r *model.AuthorizationRequest) { r.CodeChallengeMethod = "plain" },
+			err:    errs.ErrInvalidAuthorizationRequest,
+		},
+	}
+
+	for _, c := range cases {
+		request := t.request(client)
+		c.modify(request)
+
+		t.clients.EXPECT().GetByID(ctx, client.ID).Return(client, nil)
+
+		_, err := t.service.Authorize(ctx, gofakeit.LetterN(64), request)
+		t.Require().ErrorIs(err, c.err, c.name)
+	}
+}
+
+func (t *AuthorizeSuite) TestAuthorize_NotLoggedIn() {
+	ctx := context.Background()
+	client := t.client(gofakeit.LetterN(32))
+	accessToken := gofakeit.LetterN(64)
+
+	t.clients.EXPECT().GetByID(ctx, client.ID).Return(client, nil)
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(nil, errs.ErrInvalidAccessToken)
+
+	_, err := t.service.Authorize(ctx, accessToken, t.request(client))
+	t.Require().ErrorIs(err, errs.ErrInvalidAccessToken)
+}
+
+func (t *AuthorizeSuite) TestAuthorize_APIKey() {
+	ctx := context.Background()
+	client := t.client(gofakeit.LetterN(32))
+	accessToken := t.accessToken(ctx, &model.UserClaims{
+		ID:       gofakeit.UUID(),
+		UserID:   gofakeit.Int64(),
+		Roles:    []string{model.RoleUser},
+		APIKeyID: gofakeit.UUID(),
+	})
+
+	t.clients.EXPECT().GetByID(ctx, client.ID).Return(client, nil)
+
+	_, err := t.service.Authorize(ctx, accessToken, t.request(client))
+	t.Require().ErrorIs(err, errs.ErrInvalidAccessToken)
+}
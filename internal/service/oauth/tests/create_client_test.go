@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateClientSuite(t *testing.T) {
+	suite.Run(t, new(CreateClientSuite))
+}
+
+type CreateClientSuite struct {
+	oauthSuite
+}
+
+func (t *CreateClientSuite) TestCreateClient_Ok() {
+	ctx := context.Background()
+	accessToken := t.managerToken(ctx, &model.UserClaims{UserID: gofakeit.Int64(), Roles: []string{model.RoleAdmin}, MFA: true})
+	scopes := []string{"/chat_v1.Chat/*"}
+
+	t.clients.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, client *model.OAuthClient) error {
+			t.Require().NotEmpty(client.ID)
+			t.Require().Equal("billing", client.Name)
+			t.Require().Equal(scopes, client.Scopes)
+			return nil
+		},
+	)
+
+	created, err := t.service.CreateClient(ctx, accessToken, "billing", scopes, nil)
+	t.Require().NoError(err)
+	t.Require().NotEmpty(created.Secret)
+	t.Require().Equal(token.Hash(created.Secret), created.Client.SecretHash)
+}
+
+func (t *CreateClientSuite) TestCreateClient_NotAdmin() {
+	ctx := context.Background()
+	claims := &model.UserClaims{UserID: gofakeit.Int64(), Roles: []string{model.RoleUser}}
+	accessToken := t.accessToken(ctx, claims)
+
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionManageOAuthClients).Return(false, nil)
+
+	_, err := t.service.CreateClient(ctx, accessToken, "billing", []string{"/chat_v1.Chat/*"}, nil)
+	t.Require().ErrorIs(err, errs.ErrAccessDenied)
+}
+
+func (t *CreateClientSuite) TestCreateClient_AdminWithoutMFA() {
+	ctx := context.Background()
+	accessToken := t.managerToken(ctx, &model.UserClaims{UserID: gofakeit.Int64(), Roles: []string{model.RoleAdmin}})
+
+	_, err := t.service.CreateClient(ctx, accessToken, "billing", []string{"/chat_v1.Chat/*"}, nil)
+	t.Require().ErrorIs(err, errs.ErrMFARequired)
+}
+
+func (t *CreateClientSuite) TestCreateClient_InvalidScopes() {
+	ctx := context.Background()
+	accessToken := t.managerToken(ctx, &model.UserClaims{UserID: gofakeit.Int64(), Roles: []string{model.RoleAdmin}, MFA: true})
+
+	_, err := t.service.CreateClient(ctx, accessToken, "billing", []string{"chat"}, nil)
+	t.Require().ErrorIs(err, errs.ErrInvalidOAuthClient)
+
+	var validationErr *errs.ValidationError
+	t.Require().ErrorAs(err, &validationErr)
+	t.Require().Len(validationErr.Violations, 1)
+}
+
+func (t *CreateClientSuite) TestCreateClient_RedirectURIs() {
+	ctx := context.Background()
+	claims := &model.UserClaims{UserID: gofakeit.Int64(), Roles: []string{model.RoleAdmin}, MFA: true}
+	redirectURIs := []string{"https://grafana.example.com/login/generic_oauth"}
+
+	t.clients.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+	created, err := t.service.CreateClient(ctx, t.managerToken(ctx, claims), "grafana", nil, redirectURIs)
+	t.Require().NoError(err)
+	t.Require().Equal(redirectURIs, created.Client.RedirectURIs)
+
+	for _, redirectURI := range []string{"grafana.example.com/cb", "ftp://grafana.example.com/cb", "https://x.io/cb#f"} {
+		_, err = t.service.CreateClient(ctx, t.managerToken(ctx, claims), "grafana", nil, []string{redirectURI})
+		t.Require().ErrorIs(err, errs.ErrInvalidOAuthClient, redirectURI)
+	}
+}
@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestExchangeAuthorizationCodeSuite(t *testing.T) {
+	suite.Run(t, new(ExchangeAuthorizationCodeSuite))
+}
+
+type ExchangeAuthorizationCodeSuite struct {
+	oauthSuite
+}
+
+func (t *ExchangeAuthorizationCodeSuite) authorization(
+	client *model.OAuthClient,
+	userID int64,
+	codeVerifier string,
+) *model.AuthorizationCode {
+	challenge := sha256.Sum256([]byte(codeVerifier))
+
+	return &model.AuthorizationCode{
+		ClientID:      client.ID,
+		UserID:        userID,
+		RedirectURI:   client.RedirectURIs[0],
+		Scopes:        []string{model.ScopeOpenID, model.ScopeEmail},
+		Nonce:         gofakeit.LetterN(16),
+		CodeChallenge: base64.RawURLEncoding.EncodeToString(challenge[:]),
+		ExpiresAt:     time.Now().Add(time.Minute),
+	}
+}
+
+func (t *ExchangeAuthorizationCodeSuite) TestExchangeAuthorizationCode_Ok() {
+	ctx := context.Background()
+	secret := gofakeit.LetterN(32)
+	client := t.client(secret)
+	usr := tm.NewUser()
+	code, codeVerifier := gofakeit.LetterN(43), gofakeit.LetterN(43)
+	authorization := t.authorization(client, usr.ID, codeVerifier)
+	key := tm.NewSigningKey()
+
+	t.clients.EXPECT().GetByID(ctx, client.ID).Return(client, nil)
+	t.authorizationCodes.EXPECT().Redeem(ctx, token.Hash(code)).Return(authorization, nil)
+	t.userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+	t.signingKeys.EXPECT().GetSigningKey(ctx).Return(key, nil)
+
+	clientToken, err := t.service.ExchangeAuthorizationCode(ctx, client.ID, secret, code,
+		authorization.RedirectURI, codeVerifier)
+	t.Require().NoError(err)
+	t.Require().Equal(authorization.Scopes, clientToken.Scopes)
+
+	claims, err := token.VerifyWithKeys(clientToken.AccessToken, token.TypeAccess,
+		func(string) (*model.SigningKey, error) { return key, nil })
+	t.Require().NoError(err)
+	t.Require().Equal(usr.ID, claims.UserID)
+	t.Require().Equal(client.ID, claims.ClientID)
+	t.Require().False(claims.Allows("/user_v1.User/Get"))
+
+	idClaims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(clientToken.IDToken, idClaims, func(*jwt.Token) (interface{}, error) {
+		return key.PublicKey(), nil
+	})
+	t.Require().NoError(err)
+	t.Require().Equal(t.config.issuer, idClaims["iss"])
+	t.Require().Equal(client.ID, idClaims["aud"])
+	t.Require().Equal(authorization.Nonce, idClaims["nonce"])
+	t.Require().Equal(usr.Email, idClaims["email"])
+	t.Require().NotContains(idClaims, "name")
+}
+
+func (t *ExchangeAuthorizationCodeSuite) TestExchangeAuthorizationCode_WrongVerifier() {
+	ctx := context.Background()
+	secret := gofakeit.LetterN(32)
+	client := t.client(secret)
+	code := gofakeit.LetterN(43)
+	authorization := t.authorization(client, gofakeit.Int64(), gofakeit.LetterN(43))
+
+	t.clients.EXPECT().GetByID(ctx, client.ID).Return(client, nil)
+	t.authorizationCodes.EXPECT().Redeem(ctx, token.Hash(code)).Return(authorization, nil)
+
+	_, err := t.service.ExchangeAuthorizationCode(ctx, client.ID, secret, code,
+		authorization.RedirectURI, gofakeit.LetterN(43))
+	t.Require().ErrorIs(err, errs.ErrInvalidGrant)
+}
+
+func (t *ExchangeAuthorizationCodeSuite) TestExchangeAuthorizationCode_OtherClient() {
+	ctx := context.Background()
+	secret := gofakeit.LetterN(32)
+	client := t.client(secret)
+	code, codeVerifier := gofakeit.LetterN(43), gofakeit.LetterN(43)
+	authorization := t.authorization(t.client(gofakeit.LetterN(32)), gofakeit.Int64(), codeVerifier)
+
+	t.clients.EXPECT().GetByID(ctx, client.ID).Return(client, nil)
+	t.authorizationCodes.EXPECT().Redeem(ctx, token.Hash(code)).Return(authorization, nil)
+
+	_, err := t.service.ExchangeAuthorizationCode(ctx, client.ID, secret, code,
+		authorization.RedirectURI, codeVerifier)
+	t.Require().ErrorIs(err, errs.ErrInvalidGrant)
+}
+
+func (t *ExchangeAuthorizationCodeSuite) TestExchangeAuthorizationCode_UsedCode() {
+	ctx := context.Background()
+	secret := gofakeit.LetterN(32)
+	client := t.client(secret)
+	code := gofakeit.LetterN(43)
+
+	t.clients.EXPECT().GetByID(ctx, client.ID).Return(client, nil)
+	t.authorizationCodes.EXPECT().Redeem(ctx, token.Hash(code)).Return(nil, errs.ErrAuthorizationCodeNotFound)
+
+	_, err := t.service.ExchangeAuthorizationCode(ctx, client.ID, secret, code,
+		client.RedirectURIs[0], gofakeit.LetterN(43))
+	t.Require().ErrorIs(err, errs.ErrInvalidGrant)
+}
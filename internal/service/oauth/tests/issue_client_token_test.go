@@ -0,0 +1,100 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestIssueClientTokenSuite(t *testing.T) {
+	suite.Run(t, new(IssueClientTokenSuite))
+}
+
+type IssueClientTokenSuite struct {
+	oauthSuite
+}
+
+func (t *IssueClientTokenSuite) TestIssueClientToken_Ok() {
+	ctx := context.Background()
+	secret := gofakeit.LetterN(32)
+	client := t.client(secret)
+	key := tm.NewSigningKey()
+
+	t.clients.EXPECT().GetByID(ctx, client.ID).Return(client, nil)
+	t.signingKeys.EXPECT().GetSigningKey(ctx).Return(key, nil)
+
+	clientToken, err := t.service.IssueClientToken(ctx, client.ID, secret, []string{"/chat_v1.Chat/SendMessage"})
+	t.Require().NoError(err)
+	t.Require().Equal(t.config.clientTokenTTL, clientToken.ExpiresIn)
+	t.Require().Equal([]string{"/chat_v1.Chat/SendMessage"}, clientToken.Scopes)
+
+	claims, err := token.VerifyWithKeys(clientToken.AccessToken, token.TypeAccess,
+		func(string) (*model.SigningKey, error) { return key, nil })
+	t.Require().NoError(err)
+	t.Require().Equal(client.ID, claims.ClientID)
+	t.Require().Equal(clientToken.Scopes, claims.Scopes)
+}
+
+func (t *IssueClientTokenSuite) TestIssueClientToken_AllScopes() {
+	ctx := context.Background()
+	secret := gofakeit.LetterN(32)
+	client := t.client(secret)
+
+	t.clients.EXPECT().GetByID(ctx, client.ID).Return(client, nil)
+	t.signingKeys.EXPECT().GetSigningKey(ctx).Return(tm.NewSigningKey(), nil)
+
+	clientToken, err := t.service.IssueClientToken(ctx, client.ID, secret, nil)
+	t.Require().NoError(err)
+	t.Require().Equal(client.Scopes, clientToken.Scopes)
+}
+
+func (t *IssueClientTokenSuite) TestIssueClientToken_ScopeNotGranted() {
+	ctx := context.Background()
+	secret := gofakeit.LetterN(32)
+	client := t.client(secret)
+
+	t.clients.EXPECT().GetByID(ctx, client.ID).Return(client, nil)
+
+	_, err := t.service.IssueClientToken(ctx, client.ID, secret, []string{"/user_v1.User/*"})
+	t.Require().ErrorIs(err, errs.ErrInvalidScope)
+}
+
+func (t *IssueClientTokenSuite) TestIssueClientToken_WrongSecret() {
+	ctx := context.Background()
+	client := t.client(gofakeit.LetterN(32))
+
+	t.clients.EXPECT().GetByID(ctx, client.ID).Return(client, nil)
+
+	_, err := t.service.IssueClientToken(ctx, client.ID, gofakeit.LetterN(32), nil)
+	t.Require().ErrorIs(err, errs.ErrInvalidClient)
+}
+
+func (t *IssueClientTokenSuite) TestIssueClientToken_Revoked() {
+	ctx := context.Background()
+	secret := gofakeit.LetterN(32)
+	client := t.client(secret)
+	revokedAt := time.Now()
+	client.RevokedAt = &revokedAt
+
+	t.clients.EXPECT().GetByID(ctx, client.ID).Return(client, nil)
+
+	_, err := t.service.IssueClientToken(ctx, client.ID, secret, nil)
+	t.Require().ErrorIs(err, errs.ErrInvalidClient)
+}
+
+func (t *IssueClientTokenSuite) TestIssueClientToken_UnknownClient() {
+	ctx := context.Background()
+	clientID := gofakeit.UUID()
+
+	t.clients.EXPECT().GetByID(ctx, clientID).Return(nil, errs.ErrOAuthClientNotFound)
+
+	_, err := t.service.IssueClientToken(ctx, clientID, gofakeit.LetterN(32), nil)
+	t.Require().ErrorIs(err, errs.ErrInvalidClient)
+}
@@ -0,0 +1,122 @@
+package tests
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	svcMocks "github.com/Paul1k96/microservices_course_auth/internal/service/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service/oauth"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type oauthConfig struct {
+	clientTokenTTL       time.Duration
+	issuer               string
+	loginURL             string
+	authorizationCodeTTL time.Duration
+	idTokenTTL           time.Duration
+}
+
+func (c *oauthConfig) GetClientTokenTTL() time.Duration {
+	return c.clientTokenTTL
+}
+
+func (c *oauthConfig) GetIssuer() string {
+	return c.issuer
+}
+
+func (c *oauthConfig) GetLoginURL() string {
+	return c.loginURL
+}
+
+func (c *oauthConfig) GetAuthorizationCodeTTL() time.Duration {
+	return c.authorizationCodeTTL
+}
+
+func (c *oauthConfig) GetIDTokenTTL() time.Duration {
+	return c.idTokenTTL
+}
+
+type oauthSuite struct {
+	suite.Suite
+	*require.Assertions
+	ctrl *gomock.Controller
+
+	clients            *mocks.MockOAuthClientsRepository
+	authorizationCodes *mocks.MockAuthorizationCodesRepository
+	userRepo           *mocks.MockUsersRepository
+	sessions           *svcMocks.MockSessionService
+	roles              *svcMocks.MockRoleService
+	signingKeys        *svcMocks.MockSigningKeyService
+	config             *oauthConfig
+
+	service service.OAuthService
+}
+
+func (t *oauthSuite) SetupTest() {
+	t.Assertions = require.New(t.T())
+	t.ctrl = gomock.NewController(t.T())
+
+	t.clients = mocks.NewMockOAuthClientsRepository(t.ctrl)
+	t.authorizationCodes = mocks.NewMockAuthorizationCodesRepository(t.ctrl)
+	t.userRepo = mocks.NewMockUsersRepository(t.ctrl)
+	t.sessions = svcMocks.NewMockSessionService(t.ctrl)
+	t.roles = svcMocks.NewMockRoleService(t.ctrl)
+	t.signingKeys = svcMocks.NewMockSigningKeyService(t.ctrl)
+	t.config = &oauthConfig{
+		clientTokenTTL:       5 * time.Minute,
+		issuer:               "http://localhost:8080",
+		loginURL:             "http://localhost:3000/login",
+		authorizationCodeTTL: time.Minute,
+		idTokenTTL:           15 * time.Minute,
+	}
+
+	t.service = oauth.NewService(
+		slog.Default(),
+		t.clients,
+		t.authorizationCodes,
+		t.userRepo,
+		t.sessions,
+		t.roles,
+		t.signingKeys,
+		t.config,
+	)
+}
+
+func (t *oauthSuite) TearDownTest() {
+	t.ctrl.Finish()
+}
+
+func (t *oauthSuite) client(secret string) *model.OAuthClient {
+	return &model.OAuthClient{
+		ID:           gofakeit.UUID(),
+		Name:         gofakeit.AppName(),
+		SecretHash:   token.Hash(secret),
+		Scopes:       []string{"/chat_v1.Chat/*", "/user_v1.User/Get"},
+		RedirectURIs: []string{"https://grafana.example.com/login/generic_oauth"},
+		CreatedAt:    time.Now(),
+	}
+}
+
+func (t *oauthSuite) accessToken(ctx context.Context, claims *model.UserClaims) string {
+	accessToken := gofakeit.LetterN(64)
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+
+	return accessToken
+}
+
+// managerToken returns an access token of claims whose owner may manage OAuth clients.
+func (t *oauthSuite) managerToken(ctx context.Context, claims *model.UserClaims) string {
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionManageOAuthClients).Return(true, nil)
+
+	return t.accessToken(ctx, claims)
+}
@@ -0,0 +1,59 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestUserInfoSuite(t *testing.T) {
+	suite.Run(t, new(UserInfoSuite))
+}
+
+type UserInfoSuite struct {
+	oauthSuite
+}
+
+func (t *UserInfoSuite) TestUserInfo_Ok() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	scopes := []string{model.ScopeOpenID, model.ScopeProfile}
+	accessToken := t.accessToken(ctx, &model.UserClaims{
+		ID:       gofakeit.UUID(),
+		UserID:   usr.ID,
+		ClientID: gofakeit.UUID(),
+		Scopes:   scopes,
+	})
+
+	t.userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+
+	userInfo, err := t.service.UserInfo(ctx, accessToken)
+	t.Require().NoError(err)
+	t.Require().Equal(usr, userInfo.User)
+	t.Require().Equal(scopes, userInfo.Scopes)
+}
+
+func (t *UserInfoSuite) TestUserInfo_UserToken() {
+	ctx := context.Background()
+	accessToken := t.accessToken(ctx, &model.UserClaims{ID: gofakeit.UUID(), UserID: gofakeit.Int64()})
+
+	_, err := t.service.UserInfo(ctx, accessToken)
+	t.Require().ErrorIs(err, errs.ErrAccessDenied)
+}
+
+func (t *UserInfoSuite) TestUserInfo_ClientToken() {
+	ctx := context.Background()
+	accessToken := t.accessToken(ctx, &model.UserClaims{
+		ID:       gofakeit.UUID(),
+		ClientID: gofakeit.UUID(),
+		Scopes:   []string{model.ScopeOpenID},
+	})
+
+	_, err := t.service.UserInfo(ctx, accessToken)
+	t.Require().ErrorIs(err, errs.ErrAccessDenied)
+}
@@ -0,0 +1,35 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// UserInfo returns the owner of an access token issued by the authorization code grant
+// together with the scopes selecting the claims released to the client.
+func (s *service) UserInfo(ctx context.Context, accessToken string) (*model.UserInfo, error) {
+	claims, err := s.sessionService.VerifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, fmt.Errorf("verify access token: %w", err)
+	}
+
+	if claims.ClientID == "" || claims.UserID == 0 || !slices.Contains(claims.Scopes, model.ScopeOpenID) {
+		return nil, fmt.Errorf("get user info without %s scope: %w", model.ScopeOpenID, errs.ErrAccessDenied)
+	}
+
+	user, err := s.users.GetByID(ctx, claims.UserID)
+	if err != nil {
+		if errors.Is(err, errs.ErrUserNotFound) {
+			return nil, fmt.Errorf("get user: %w", errs.ErrInvalidAccessToken)
+		}
+
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	return &model.UserInfo{User: user, Scopes: claims.Scopes}, nil
+}
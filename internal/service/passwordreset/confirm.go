@@ -0,0 +1,48 @@
+package passwordreset
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/pkg/errors"
+)
+
+// Confirm sets a new password for the owner of the reset token.
+// The token and every other outstanding reset token of the user become used.
+func (s *service) Confirm(ctx context.Context, resetToken, newPassword string) error {
+	if txErr := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		stored, err := s.resetTokens.GetByHashForUpdate(ctx, token.Hash(resetToken))
+		if err != nil {
+			return fmt.Errorf("get password reset token: %w", err)
+		}
+
+		now := time.Now()
+
+		if stored.UsedAt != nil || now.After(stored.ExpiresAt) {
+			return errs.ErrInvalidPasswordResetToken
+		}
+
+		err = s.resetTokens.MarkUsedByUserID(ctx, stored.UserID, now)
+		if err != nil {
+			return fmt.Errorf("mark password reset tokens used: %w", err)
+		}
+
+		err = s.userService.ResetPassword(ctx, stored.UserID, newPassword)
+		if err != nil {
+			return fmt.Errorf("reset password: %w", err)
+		}
+
+		return nil
+	}); txErr != nil {
+		if errors.Is(txErr, errs.ErrPasswordResetTokenNotFound) || errors.Is(txErr, errs.ErrUserNotFound) {
+			return fmt.Errorf("confirm password reset: %w", errs.ErrInvalidPasswordResetToken)
+		}
+
+		return txErr
+	}
+
+	return nil
+}
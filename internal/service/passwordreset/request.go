@@ -0,0 +1,106 @@
+package passwordreset
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/notifier"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/google/uuid"
+)
+
+const sendTimeout = 30 * time.Second
+
+// Request issues a password reset token for the user with email in the organization and sends it to them.
+// The user is looked up, and the token is issued and delivered, in the background and nothing is
+// reported to the caller, so that neither the result nor the latency reveals whether the email is registered.
+// Requests beyond the capacity of the worker pool are dropped.
+func (s *service) Request(ctx context.Context, orgID int64, email string) error {
+	ctx = context.WithoutCancel(ctx)
+
+	err := s.workerPool.Submit(func() {
+		s.request(ctx, orgID, email)
+	})
+	if err != nil {
+		s.logger.Error("failed to queue password reset request:", slog.String("error", err.Error()))
+	}
+
+	return nil
+}
+
+func (s *service) request(ctx context.Context, orgID int64, email string) {
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	user, err := s.users.GetByEmail(ctx, orgID, email)
+	if err != nil {
+		if !errors.Is(err, errs.ErrUserNotFound) {
+			s.logger.Error("failed to get user to reset password:", slog.String("error", err.Error()))
+		}
+
+		return
+	}
+
+	msg, err := s.issue(ctx, user)
+	if err != nil {
+		s.logger.Error("failed to issue password reset token:", slog.String("error", err.Error()))
+		return
+	}
+
+	err = s.notifier.Send(ctx, msg)
+	if err != nil {
+		s.logger.Error("failed to send password reset message:", slog.String("error", err.Error()))
+	}
+}
+
+// issue saves a new password reset token of the user and returns the message carrying it.
+func (s *service) issue(ctx context.Context, user *model.User) (*notifier.Message, error) {
+	resetToken, err := token.GenerateOpaque()
+	if err != nil {
+		return nil, fmt.Errorf("generate password reset token: %w", err)
+	}
+
+	now := time.Now()
+
+	err = s.resetTokens.Create(ctx, &model.PasswordResetToken{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		TokenHash: token.Hash(resetToken),
+		ExpiresAt: now.Add(s.config.GetTokenTTL()),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("save password reset token: %w", err)
+	}
+
+	msg, err := s.newResetMessage(user.Email, resetToken)
+	if err != nil {
+		return nil, fmt.Errorf("build password reset message: %w", err)
+	}
+
+	return msg, nil
+}
+
+func (s *service) newResetMessage(email, resetToken string) (*notifier.Message, error) {
+	link, err := notifier.LinkWithToken(s.config.GetURL(), resetToken)
+	if err != nil {
+		return nil, fmt.Errorf("build password reset link: %w", err)
+	}
+
+	return &notifier.Message{
+		To:      email,
+		Subject: "Password reset",
+		Body: fmt.Sprintf(
+			"Follow the link to set a new password: %s\n\n"+
+				"The link expires in %s and can be used once. "+
+				"If you did not request a password reset, ignore this message.\n",
+			link,
+			s.config.GetTokenTTL(),
+		),
+	}, nil
+}
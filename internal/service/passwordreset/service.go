@@ -0,0 +1,49 @@
+package passwordreset
+
+import (
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/Paul1k96/microservices_course_auth/internal/notifier"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository"
+	svc "github.com/Paul1k96/microservices_course_auth/internal/service"
+	"github.com/Paul1k96/microservices_course_auth/internal/worker"
+	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/db"
+)
+
+type service struct {
+	logger    *slog.Logger
+	txManager db.TxManager
+
+	users       repository.UsersRepository
+	resetTokens repository.PasswordResetTokensRepository
+
+	userService svc.UserService
+	notifier    notifier.Notifier
+	workerPool  *worker.Pool
+
+	config config.PasswordResetConfig
+}
+
+// NewService creates a new password reset service.
+func NewService(
+	logger *slog.Logger,
+	txManager db.TxManager,
+	users repository.UsersRepository,
+	resetTokens repository.PasswordResetTokensRepository,
+	userService svc.UserService,
+	notifier notifier.Notifier,
+	workerPool *worker.Pool,
+	config config.PasswordResetConfig,
+) svc.PasswordResetService {
+	return &service{
+		logger:      logger,
+		txManager:   txManager,
+		users:       users,
+		resetTokens: resetTokens,
+		userService: userService,
+		notifier:    notifier,
+		workerPool:  workerPool,
+		config:      config,
+	}
+}
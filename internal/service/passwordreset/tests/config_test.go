@@ -0,0 +1,18 @@
+package tests
+
+import "time"
+
+type passwordResetConfig struct {
+	tokenTTL time.Duration
+	url      string
+}
+
+func newPasswordResetConfig() *passwordResetConfig {
+	return &passwordResetConfig{
+		tokenTTL: 30 * time.Minute,
+		url:      "https://auth.example.com/password/reset",
+	}
+}
+
+func (c *passwordResetConfig) GetTokenTTL() time.Duration { return c.tokenTTL }
+func (c *passwordResetConfig) GetURL() string             { return c.url }
@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestConfirmSuite(t *testing.T) {
+	suite.Run(t, new(ConfirmSuite))
+}
+
+type ConfirmSuite struct {
+	passwordResetSuite
+}
+
+func (t *ConfirmSuite) newResetToken() (string, *model.PasswordResetToken) {
+	resetToken, err := token.GenerateOpaque()
+	t.Require().NoError(err)
+
+	return resetToken, &model.PasswordResetToken{
+		ID:        uuid.New(),
+		UserID:    gofakeit.Int64(),
+		TokenHash: token.Hash(resetToken),
+		ExpiresAt: time.Now().Add(t.config.GetTokenTTL()),
+		CreatedAt: time.Now(),
+	}
+}
+
+func (t *ConfirmSuite) TestConfirm_Ok() {
+	ctx := context.Background()
+	resetToken, stored := t.newResetToken()
+	newPassword := gofakeit.Password(true, true, true, true, false, 16)
+
+	t.resetTokens.EXPECT().GetByHashForUpdate(ctx, stored.TokenHash).Return(stored, nil)
+	t.resetTokens.EXPECT().MarkUsedByUserID(ctx, stored.UserID, gomock.Any()).Return(nil)
+	t.userService.EXPECT().ResetPassword(ctx, stored.UserID, newPassword).Return(nil)
+
+	err := t.service.Confirm(ctx, resetToken, newPassword)
+	t.Require().NoError(err)
+}
+
+func (t *ConfirmSuite) TestConfirm_Used() {
+	ctx := context.Background()
+	resetToken, stored := t.newResetToken()
+	usedAt := time.Now().Add(-time.Minute)
+	stored.UsedAt = &usedAt
+
+	t.resetTokens.EXPECT().GetByHashForUpdate(ctx, stored.TokenHash).Return(stored, nil)
+
+	err := t.service.Confirm(ctx, resetToken, gofakeit.Password(true, true, true, true, false, 16))
+	t.Require().ErrorIs(err, errs.ErrInvalidPasswordResetToken)
+}
+
+func (t *ConfirmSuite) TestConfirm_Expired() {
+	ctx := context.Background()
+	resetToken, stored := t.newResetToken()
+	stored.ExpiresAt = time.Now().Add(-time.Minute)
+
+	t.resetTokens.EXPECT().GetByHashForUpdate(ctx, stored.TokenHash).Return(stored, nil)
+
+	err := t.service.Confirm(ctx, resetToken, gofakeit.Password(true, true, true, true, false, 16))
+	t.Require().ErrorIs(err, errs.ErrInvalidPasswordResetToken)
+}
+
+func (t *ConfirmSuite) TestConfirm_NotFound() {
+	ctx := context.Background()
+	resetToken, stored := t.newResetToken()
+
+	t.resetTokens.EXPECT().GetByHashForUpdate(ctx, stored.TokenHash).Return(nil, errs.ErrPasswordResetTokenNotFound)
+
+	err := t.service.Confirm(ctx, resetToken, gofakeit.Password(true, true, true, true, false, 16))
+	t.Require().ErrorIs(err, errs.ErrInvalidPasswordResetToken)
+}
+
+func (t *ConfirmSuite) TestConfirm_WeakPassword() {
+	ctx := context.Background()
+	resetToken, stored := t.newResetToken()
+	newPassword := gofakeit.LetterN(4)
+
+	t.resetTokens.EXPECT().GetByHashForUpdate(ctx, stored.TokenHash).Return(stored, nil)
+	t.resetTokens.EXPECT().MarkUsedByUserID(ctx, stored.UserID, gomock.Any()).Return(nil)
+	t.userService.EXPECT().ResetPassword(ctx, stored.UserID, newPassword).Return(errs.ErrWeakPassword)
+
+	err := t.service.Confirm(ctx, resetToken, newPassword)
+	t.Require().ErrorIs(err, errs.ErrWeakPassword)
+}
@@ -0,0 +1,114 @@
+package tests
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/notifier"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRequestSuite(t *testing.T) {
+	suite.Run(t, new(RequestSuite))
+}
+
+type RequestSuite struct {
+	passwordResetSuite
+}
+
+var linkRegexp = regexp.MustCompile(`https://\S+`)
+
+func (t *RequestSuite) TestRequest_Ok() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+
+	var stored *model.PasswordResetToken
+	sent := make(chan *notifier.Message, 1)
+
+	t.userRepo.EXPECT().GetByEmail(gomock.Any(), model.DefaultOrgID, usr.Email).Return(usr, nil)
+	t.resetTokens.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, resetToken *model.PasswordResetToken) error {
+			stored = resetToken
+			return nil
+		},
+	)
+	t.notifier.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, msg *notifier.Message) error {
+			sent <- msg
+			return nil
+		},
+	)
+
+	err := t.service.Request(ctx, model.DefaultOrgID, usr.Email)
+	t.Require().NoError(err)
+
+	var msg *notifier.Message
+	select {
+	case msg = <-sent:
+	case <-time.After(time.Second):
+		t.FailNow("password reset message was not sent")
+	}
+
+	t.Require().Equal(usr.Email, msg.To)
+
+	link, err := url.Parse(linkRegexp.FindString(msg.Body))
+	t.Require().NoError(err)
+
+	resetToken := link.Query().Get("token")
+	t.Require().NotEmpty(resetToken)
+	t.Require().Equal(token.Hash(resetToken), stored.TokenHash)
+	t.Require().Equal(usr.ID, stored.UserID)
+	t.Require().WithinDuration(time.Now().Add(t.config.GetTokenTTL()), stored.ExpiresAt, time.Second)
+}
+
+func (t *RequestSuite) TestRequest_UnknownEmail() {
+	email := gofakeit.Email()
+	looked := make(chan struct{})
+
+	t.userRepo.EXPECT().GetByEmail(gomock.Any(), model.DefaultOrgID, email).DoAndReturn(
+		func(context.Context, int64, string) (*model.User, error) {
+			defer close(looked)
+			return nil, errs.ErrUserNotFound
+		},
+	)
+
+	err := t.service.Request(context.Background(), model.DefaultOrgID, email)
+	t.Require().NoError(err)
+
+	select {
+	case <-looked:
+	case <-time.After(time.Second):
+		t.FailNow("user was not looked up")
+	}
+}
+
+func (t *RequestSuite) TestRequest_FailedToSaveTokenIsNotReported() {
+	usr := tm.NewUser()
+	saved := make(chan struct{})
+
+	t.userRepo.EXPECT().GetByEmail(gomock.Any(), model.DefaultOrgID, usr.Email).Return(usr, nil)
+	t.resetTokens.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(context.Context, *model.PasswordResetToken) error {
+			defer close(saved)
+			return gofakeit.Error()
+		},
+	)
+
+	err := t.service.Request(context.Background(), model.DefaultOrgID, usr.Email)
+	t.Require().NoError(err)
+
+	select {
+	case <-saved:
+	case <-time.After(time.Second):
+		t.FailNow("password reset token was not saved")
+	}
+}
@@ -0,0 +1,59 @@
+package tests
+
+import (
+	"log/slog"
+
+	notifierMocks "github.com/Paul1k96/microservices_course_auth/internal/notifier/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	svcMocks "github.com/Paul1k96/microservices_course_auth/internal/service/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service/passwordreset"
+	"github.com/Paul1k96/microservices_course_auth/internal/worker"
+	infraMocks "github.com/Paul1k96/microservices_course_platform_common/pkg/client/db/transaction"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type passwordResetSuite struct {
+	suite.Suite
+	*require.Assertions
+	ctrl *gomock.Controller
+
+	userRepo    *mocks.MockUsersRepository
+	resetTokens *mocks.MockPasswordResetTokensRepository
+	userService *svcMocks.MockUserService
+	notifier    *notifierMocks.MockNotifier
+	config      *passwordResetConfig
+	workerPool  *worker.Pool
+
+	service service.PasswordResetService
+}
+
+func (t *passwordResetSuite) SetupTest() {
+	t.Assertions = require.New(t.T())
+	t.ctrl = gomock.NewController(t.T())
+
+	t.userRepo = mocks.NewMockUsersRepository(t.ctrl)
+	t.resetTokens = mocks.NewMockPasswordResetTokensRepository(t.ctrl)
+	t.userService = svcMocks.NewMockUserService(t.ctrl)
+	t.notifier = notifierMocks.NewMockNotifier(t.ctrl)
+	t.config = newPasswordResetConfig()
+	t.workerPool = worker.NewPool(slog.Default(), 1, 1)
+
+	t.service = passwordreset.NewService(
+		slog.Default(),
+		infraMocks.NewNopTxManager(),
+		t.userRepo,
+		t.resetTokens,
+		t.userService,
+		t.notifier,
+		t.workerPool,
+		t.config,
+	)
+}
+
+func (t *passwordResetSuite) TearDownTest() {
+	t.workerPool.Stop()
+	t.ctrl.Finish()
+}
@@ -0,0 +1,30 @@
+package ratelimit
+
+import (
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository"
+	svc "github.com/Paul1k96/microservices_course_auth/internal/service"
+)
+
+type service struct {
+	logger *slog.Logger
+
+	buckets repository.RateLimitRepository
+
+	config config.RateLimitConfig
+}
+
+// NewService creates a new rate limit service.
+func NewService(
+	logger *slog.Logger,
+	buckets repository.RateLimitRepository,
+	config config.RateLimitConfig,
+) svc.RateLimitService {
+	return &service{
+		logger:  logger,
+		buckets: buckets,
+		config:  config,
+	}
+}
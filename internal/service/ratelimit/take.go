@@ -0,0 +1,32 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// Take takes a token from the bucket of the caller for the endpoint. gRPC methods, named by their
+// full name, without a limit of their own share the default limit, while HTTP routes, named by
+// method and path, are limited only if configured. Requests to endpoints without a limit are allowed.
+func (s *service) Take(ctx context.Context, endpoint, caller string) (*model.RateLimitResult, error) {
+	limits := s.config.GetLimits()
+
+	limit, ok := limits[endpoint]
+	if !ok && strings.HasPrefix(endpoint, "/") {
+		limit, ok = limits[model.DefaultRateLimitEndpoint]
+	}
+
+	if !ok {
+		return &model.RateLimitResult{Allowed: true}, nil
+	}
+
+	result, err := s.buckets.Take(ctx, endpoint+":"+caller, limit)
+	if err != nil {
+		return nil, fmt.Errorf("take token of %s for %s: %w", caller, endpoint, err)
+	}
+
+	return result, nil
+}
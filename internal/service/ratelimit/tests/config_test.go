@@ -0,0 +1,9 @@
+package tests
+
+import "github.com/Paul1k96/microservices_course_auth/internal/model"
+
+type rateLimitConfig struct {
+	limits map[string]model.RateLimit
+}
+
+func (c *rateLimitConfig) GetLimits() map[string]model.RateLimit { return c.limits }
@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	"github.com/Paul1k96/microservices_course_auth/internal/service/ratelimit"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTakeSuite(t *testing.T) {
+	suite.Run(t, new(TakeSuite))
+}
+
+type TakeSuite struct {
+	suite.Suite
+	*require.Assertions
+	ctrl *gomock.Controller
+
+	buckets *mocks.MockRateLimitRepository
+	config  *rateLimitConfig
+
+	createLimit  model.RateLimit
+	defaultLimit model.RateLimit
+	tokenLimit   model.RateLimit
+
+	service service.RateLimitService
+}
+
+func (t *TakeSuite) SetupTest() {
+	t.Assertions = require.New(t.T())
+	t.ctrl = gomock.NewController(t.T())
+
+	t.buckets = mocks.NewMockRateLimitRepository(t.ctrl)
+	t.createLimit = model.RateLimit{Requests: 5, Period: time.Hour}
+	t.defaultLimit = model.RateLimit{Requests: 50, Period: time.Second}
+	t.tokenLimit = model.RateLimit{Requests: 30, Period: time.Minute}
+	t.config = &rateLimitConfig{limits: map[string]model.RateLimit{
+		"/user_v1.User/Create":         t.createLimit,
+		model.DefaultRateLimitEndpoint: t.defaultLimit,
+		"POST /oauth/token":            t.tokenLimit,
+	}}
+
+	t.service = ratelimit.NewService(slog.Default(), t.buckets, t.config)
+}
+
+func (t *TakeSuite) TearDownTest() {
+	t.ctrl.Finish()
+}
+
+func (t *TakeSuite) TestTake_MethodLimit() {
+	ctx := context.Background()
+	caller := "ip:" + gofakeit.IPv4Address()
+	expected := &model.RateLimitResult{RetryAfter: 12 * time.Minute}
+
+	t.buckets.EXPECT().Take(ctx, "/user_v1.User/Create:"+caller, t.createLimit).Return(expected, nil)
+
+	result, err := t.service.Take(ctx, "/user_v1.User/Create", caller)
+	t.Require().NoError(err)
+	t.Require().Equal(expected, result)
+}
+
+func (t *TakeSuite) TestTake_DefaultLimit() {
+	ctx := context.Background()
+	caller := "user:" + gofakeit.Numerify("####")
+
+	t.buckets.EXPECT().
+		Take(ctx, "/user_v1.User/Get:"+caller, t.defaultLimit).
+		Return(&model.RateLimitResult{Allowed: true}, nil)
+
+	result, err := t.service.Take(ctx, "/user_v1.User/Get", caller)
+	t.Require().NoError(err)
+	t.Require().True(result.Allowed)
+}
+
+func (t *TakeSuite) TestTake_HTTPRouteLimit() {
+	ctx := context.Background()
+	caller := "ip:" + gofakeit.IPv4Address()
+
+	t.buckets.EXPECT().
+		Take(ctx, "POST /oauth/token:"+caller, t.tokenLimit).
+		Return(&model.RateLimitResult{Allowed: true}, nil)
+
+	result, err := t.service.Take(ctx, "POST /oauth/token", caller)
+	t.Require().NoError(err)
+	t.Require().True(result.Allowed)
+}
+
+func (t *TakeSuite) TestTake_HTTPRouteWithoutLimit() {
+	result, err := t.service.Take(context.Background(), "GET /user/v1/1", "ip:"+gofakeit.IPv4Address())
+	t.Require().NoError(err)
+	t.Require().True(result.Allowed)
+}
+
+func (t *TakeSuite) TestTake_RepoError() {
+	ctx := context.Background()
+	caller := "ip:" + gofakeit.IPv4Address()
+
+	t.buckets.EXPECT().Take(ctx, "/user_v1.User/Create:"+caller, t.createLimit).Return(nil, gofakeit.Error())
+
+	result, err := t.service.Take(ctx, "/user_v1.User/Create", caller)
+	t.Require().Nil(result)
+	t.Require().Error(err)
+}
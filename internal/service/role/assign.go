@@ -0,0 +1,42 @@
+package role
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// Assign assigns the role to a member of the organization of the caller in it,
+// the caller must be allowed to manage roles.
+func (s *service) Assign(ctx context.Context, accessToken string, userID, roleID int64) error {
+	claims, err := s.checkManager(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+
+	role, err := s.roles.GetByID(ctx, claims.OrgID, roleID)
+	if err != nil {
+		return fmt.Errorf("get role by id: %w", err)
+	}
+
+	_, err = s.users.GetByIDInOrg(ctx, claims.OrgID, userID)
+	if err != nil {
+		return fmt.Errorf("get user by id: %w", err)
+	}
+
+	err = s.roles.Assign(ctx, claims.OrgID, userID, roleID)
+	if err != nil {
+		return fmt.Errorf("assign role: %w", err)
+	}
+
+	err = s.cache.Delete(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to delete user from cache:", slog.String("error", err.Error()))
+	}
+
+	s.saveEvent(ctx, model.NewRoleAssignedEvent(claims.Actor(), userID, role).WithOrgID(claims.OrgID))
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package role
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+const maxRoleNameLength = 64
+
+// roleNamePattern keeps role names free of separators used to store roles in cache.
+var roleNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+
+// Create creates a custom role of the organization of the caller granting permissions, the caller must be
+// allowed to manage roles. Names of built-in roles are taken in every organization.
+// Permissions which are not known yet are created, so roles may grant permissions of other services.
+func (s *service) Create(ctx context.Context, accessToken string, role *model.Role) (int64, error) {
+	claims, err := s.checkManager(ctx, accessToken)
+	if err != nil {
+		return 0, err
+	}
+
+	violations := validateName(role.Name)
+	violations = append(violations, validatePermissions(role.Permissions)...)
+	if len(violations) > 0 {
+		return 0, errs.NewValidationError(errs.ErrInvalidRole, violations...)
+	}
+
+	if model.IsBuiltInRole(role.Name) {
+		return 0, fmt.Errorf("create role %s: %w", role.Name, errs.ErrRoleAlreadyExists)
+	}
+
+	role.OrgID = claims.OrgID
+	role.BuiltIn = false
+	role.CreatedAt = time.Now()
+
+	if txErr := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		role.ID, err = s.roles.Create(ctx, role)
+		if err != nil {
+			return fmt.Errorf("create role: %w", err)
+		}
+
+		return nil
+	}); txErr != nil {
+		return 0, fmt.Errorf("transaction error: %w", txErr)
+	}
+
+	s.saveEvent(ctx, model.NewRoleCreatedEvent(claims.Actor(), role).WithOrgID(claims.OrgID))
+
+	return role.ID, nil
+}
+
+func validateName(name string) []errs.FieldViolation {
+	switch {
+	case name == "":
+		return []errs.FieldViolation{{Field: "name", Description: "must not be empty"}}
+	case len(name) > maxRoleNameLength:
+		return []errs.FieldViolation{{
+			Field:       "name",
+			Description: fmt.Sprintf("must be at most %d characters long", maxRoleNameLength),
+		}}
+	case !roleNamePattern.MatchString(name):
+		return []errs.FieldViolation{{
+			Field:       "name",
+			Description: "must start with a letter and contain only letters, digits, '_' and '-'",
+		}}
+	}
+
+	return nil
+}
+
+func validatePermissions(permissions []string) []errs.FieldViolation {
+	var violations []errs.FieldViolation
+
+	for _, permission := range permissions {
+		if !model.IsValidPermission(permission) {
+			violations = append(violations, errs.FieldViolation{
+				Field:       "permissions",
+				Description: fmt.Sprintf("%q must be a dot separated name like chat.send_message", permission),
+			})
+		}
+	}
+
+	return violations
+}
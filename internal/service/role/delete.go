@@ -0,0 +1,36 @@
+package role
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// Delete deletes the custom role of the organization of the caller and unassigns it from all users,
+// the caller must be allowed to manage roles. Built-in roles cannot be deleted.
+func (s *service) Delete(ctx context.Context, accessToken string, id int64) error {
+	claims, err := s.checkManager(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+
+	role, err := s.roles.GetByID(ctx, claims.OrgID, id)
+	if err != nil {
+		return fmt.Errorf("get role by id: %w", err)
+	}
+
+	if role.BuiltIn {
+		return fmt.Errorf("delete role %s: %w", role.Name, errs.ErrBuiltInRole)
+	}
+
+	err = s.roles.Delete(ctx, claims.OrgID, id)
+	if err != nil {
+		return fmt.Errorf("delete role: %w", err)
+	}
+
+	s.saveEvent(ctx, model.NewRoleDeletedEvent(claims.Actor(), role).WithOrgID(claims.OrgID))
+
+	return nil
+}
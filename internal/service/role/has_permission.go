@@ -0,0 +1,22 @@
+package role
+
+import (
+	"context"
+	"fmt"
+)
+
+// HasPermission reports whether any role of the user, or their role in the organization, grants permission.
+// Roles are looked up on every call, so granted and withdrawn roles apply
+// to tokens issued before the change.
+func (s *service) HasPermission(ctx context.Context, userID, orgID int64, permission string) (bool, error) {
+	if userID == 0 {
+		return false, nil
+	}
+
+	granted, err := s.roles.HasPermission(ctx, userID, orgID, permission)
+	if err != nil {
+		return false, fmt.Errorf("check permission %s of user %d: %w", permission, userID, err)
+	}
+
+	return granted, nil
+}
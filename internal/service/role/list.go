@@ -0,0 +1,24 @@
+package role
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// List returns built-in roles and roles of the organization of the caller with their permissions,
+// the caller must be allowed to manage roles.
+func (s *service) List(ctx context.Context, accessToken string) ([]*model.Role, error) {
+	claims, err := s.checkManager(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := s.roles.List(ctx, claims.OrgID)
+	if err != nil {
+		return nil, fmt.Errorf("list roles: %w", err)
+	}
+
+	return roles, nil
+}
@@ -0,0 +1,20 @@
+package role
+
+import (
+	"context"
+	"fmt"
+)
+
+// Permissions returns names of the permissions granted to the user in the organization, ordered by name.
+func (s *service) Permissions(ctx context.Context, userID, orgID int64) ([]string, error) {
+	if userID == 0 {
+		return nil, nil
+	}
+
+	permissions, err := s.roles.ListPermissions(ctx, userID, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("list permissions of user %d: %w", userID, err)
+	}
+
+	return permissions, nil
+}
@@ -0,0 +1,49 @@
+package role
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+)
+
+// Seed creates permissions and grants each built-in role named in grants its permissions.
+// It is called on startup, so permissions the consuming services require come from configuration
+// instead of migrations. Permissions already granted are kept.
+func (s *service) Seed(ctx context.Context, permissions []string, grants map[string][]string) error {
+	violations := validatePermissions(permissions)
+	for _, granted := range grants {
+		violations = append(violations, validatePermissions(granted)...)
+	}
+
+	if len(violations) > 0 {
+		return errs.NewValidationError(errs.ErrInvalidRole, violations...)
+	}
+
+	roleNames := make([]string, 0, len(grants))
+	for roleName := range grants {
+		roleNames = append(roleNames, roleName)
+	}
+	slices.Sort(roleNames)
+
+	if txErr := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		err := s.roles.CreatePermissions(ctx, permissions)
+		if err != nil {
+			return fmt.Errorf("create permissions: %w", err)
+		}
+
+		for _, roleName := range roleNames {
+			err = s.roles.GrantByName(ctx, roleName, grants[roleName])
+			if err != nil {
+				return fmt.Errorf("grant permissions to role %s: %w", roleName, err)
+			}
+		}
+
+		return nil
+	}); txErr != nil {
+		return fmt.Errorf("transaction error: %w", txErr)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package role
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository"
+	svc "github.com/Paul1k96/microservices_course_auth/internal/service"
+	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/db"
+)
+
+type service struct {
+	logger    *slog.Logger
+	txManager db.TxManager
+
+	roles  repository.RolesRepository
+	users  repository.UsersRepository
+	events repository.UserEventsRepository
+	cache  repository.UsersCache
+
+	sessionService svc.SessionService
+}
+
+// NewService creates a new role service.
+func NewService(
+	logger *slog.Logger,
+	txManager db.TxManager,
+	roles repository.RolesRepository,
+	users repository.UsersRepository,
+	events repository.UserEventsRepository,
+	cache repository.UsersCache,
+	sessionService svc.SessionService,
+) svc.RoleService {
+	return &service{
+		logger:         logger,
+		txManager:      txManager,
+		roles:          roles,
+		users:          users,
+		events:         events,
+		cache:          cache,
+		sessionService: sessionService,
+	}
+}
+
+// checkManager returns claims of the caller if they may manage roles.
+func (s *service) checkManager(ctx context.Context, accessToken string) (*model.UserClaims, error) {
+	claims, err := s.sessionService.VerifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, fmt.Errorf("verify access token: %w", err)
+	}
+
+	granted, err := s.HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionManageRoles)
+	if err != nil {
+		return nil, fmt.Errorf("check permission: %w", err)
+	}
+
+	if !granted {
+		return nil, fmt.Errorf("manage roles as user %d: %w", claims.UserID, errs.ErrAccessDenied)
+	}
+
+	if !claims.MFA {
+		return nil, fmt.Errorf("manage roles without mfa: %w", errs.ErrMFARequired)
+	}
+
+	return claims, nil
+}
+
+func (s *service) saveEvent(ctx context.Context, event *model.UserEvent) {
+	err := s.events.Save(ctx, event)
+	if err != nil {
+		s.logger.Error("failed to save user event:", slog.String("error", err.Error()))
+	}
+}
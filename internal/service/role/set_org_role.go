@@ -0,0 +1,31 @@
+package role
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// SetOrgRole sets the role the user holds in the organization of the caller only, replacing
+// their previous role there. The caller must be allowed to manage roles.
+func (s *service) SetOrgRole(ctx context.Context, accessToken string, userID, roleID int64) error {
+	claims, err := s.checkManager(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+
+	role, err := s.roles.GetByID(ctx, claims.OrgID, roleID)
+	if err != nil {
+		return fmt.Errorf("get role by id: %w", err)
+	}
+
+	err = s.roles.SetMemberRole(ctx, claims.OrgID, userID, roleID)
+	if err != nil {
+		return fmt.Errorf("set member role: %w", err)
+	}
+
+	s.saveEvent(ctx, model.NewRoleAssignedEvent(claims.Actor(), userID, role).WithOrgID(claims.OrgID))
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAssignSuite(t *testing.T) {
+	suite.Run(t, new(AssignSuite))
+}
+
+type AssignSuite struct {
+	roleSuite
+}
+
+func (t *AssignSuite) TestAssign_Ok() {
+	ctx := context.Background()
+	accessToken, claims := t.managerToken(ctx)
+	usr := tm.NewUser()
+	role := newCustomRole()
+
+	t.roleRepo.EXPECT().GetByID(ctx, claims.OrgID, role.ID).Return(role, nil)
+	t.userRepo.EXPECT().GetByIDInOrg(ctx, claims.OrgID, usr.ID).Return(usr, nil)
+	t.roleRepo.EXPECT().Assign(ctx, claims.OrgID, usr.ID, role.ID).Return(nil)
+	t.userCache.EXPECT().Delete(ctx, usr.ID).Return(nil)
+	t.events.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, event *model.UserEvent) error {
+			t.Require().Equal(model.UserEventTypeRoleAssigned, event.Type)
+			t.Require().Equal(claims.UserID, event.UserID)
+			t.Require().Equal(usr.ID, event.EntityID)
+			t.Require().Equal(claims.OrgID, event.OrgID)
+			t.Require().Equal(&model.RoleAssignedEventValue{RoleID: role.ID, Name: role.Name}, event.Value)
+			return nil
+		},
+	)
+
+	err := t.service.Assign(ctx, accessToken, usr.ID, role.ID)
+	t.Require().NoError(err)
+}
+
+func (t *AssignSuite) TestAssign_UserNotFound() {
+	ctx := context.Background()
+	accessToken, claims := t.managerToken(ctx)
+	userID := gofakeit.Int64()
+	role := newCustomRole()
+
+	t.roleRepo.EXPECT().GetByID(ctx, claims.OrgID, role.ID).Return(role, nil)
+	t.userRepo.EXPECT().GetByIDInOrg(ctx, claims.OrgID, userID).Return(nil, errs.ErrUserNotFound)
+
+	err := t.service.Assign(ctx, accessToken, userID, role.ID)
+	t.Require().ErrorIs(err, errs.ErrUserNotFound)
+}
+
+func (t *AssignSuite) TestAssign_RoleNotFound() {
+	ctx := context.Background()
+	accessToken, claims := t.managerToken(ctx)
+	roleID := gofakeit.Int64()
+
+	t.roleRepo.EXPECT().GetByID(ctx, claims.OrgID, roleID).Return(nil, errs.ErrRoleNotFound)
+
+	err := t.service.Assign(ctx, accessToken, gofakeit.Int64(), roleID)
+	t.Require().ErrorIs(err, errs.ErrRoleNotFound)
+}
+
+func (t *AssignSuite) TestUnassign_Ok() {
+	ctx := context.Background()
+	accessToken, claims := t.managerToken(ctx)
+	usr := tm.NewUser()
+	role := newCustomRole()
+
+	t.roleRepo.EXPECT().GetByID(ctx, claims.OrgID, role.ID).Return(role, nil)
+	t.userRepo.EXPECT().GetByIDInOrg(ctx, claims.OrgID, usr.ID).Return(usr, nil)
+	t.roleRepo.EXPECT().Unassign(ctx, claims.OrgID, usr.ID, role.ID).Return(nil)
+	t.userCache.EXPECT().Delete(ctx, usr.ID).Return(nil)
+	t.events.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, event *model.UserEvent) error {
+			t.Require().Equal(model.UserEventTypeRoleUnassigned, event.Type)
+			t.Require().Equal(claims.UserID, event.UserID)
+			t.Require().Equal(usr.ID, event.EntityID)
+			t.Require().Equal(claims.OrgID, event.OrgID)
+			return nil
+		},
+	)
+
+	err := t.service.Unassign(ctx, accessToken, usr.ID, role.ID)
+	t.Require().NoError(err)
+}
+
+func (t *AssignSuite) TestUnassign_NotAssigned() {
+	ctx := context.Background()
+	accessToken, claims := t.managerToken(ctx)
+	usr := tm.NewUser()
+	role := newCustomRole()
+
+	t.roleRepo.EXPECT().GetByID(ctx, claims.OrgID, role.ID).Return(role, nil)
+	t.userRepo.EXPECT().GetByIDInOrg(ctx, claims.OrgID, usr.ID).Return(usr, nil)
+	t.roleRepo.EXPECT().Unassign(ctx, claims.OrgID, usr.ID, role.ID).Return(errs.ErrRoleNotFound)
+
+	err := t.service.Unassign(ctx, accessToken, usr.ID, role.ID)
+	t.Require().ErrorIs(err, errs.ErrRoleNotFound)
+}
@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateSuite(t *testing.T) {
+	suite.Run(t, new(CreateSuite))
+}
+
+type CreateSuite struct {
+	roleSuite
+}
+
+func (t *CreateSuite) TestCreate_Ok() {
+	ctx := context.Background()
+	accessToken, claims := t.managerToken(ctx)
+	role := newCustomRole()
+	roleID := gofakeit.Int64()
+
+	t.roleRepo.EXPECT().Create(ctx, role).Return(roleID, nil)
+	t.events.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, event *model.UserEvent) error {
+			t.Require().Equal(model.UserEventTypeRoleCreated, event.Type)
+			t.Require().Equal(claims.UserID, event.UserID)
+			t.Require().Equal(roleID, event.EntityID)
+			t.Require().Equal(claims.OrgID, event.OrgID)
+			return nil
+		},
+	)
+
+	id, err := t.service.Create(ctx, accessToken, role)
+	t.Require().NoError(err)
+	t.Require().Equal(roleID, id)
+	t.Require().False(role.BuiltIn)
+	t.Require().Equal(claims.OrgID, role.OrgID)
+	t.Require().False(role.CreatedAt.IsZero())
+}
+
+func (t *CreateSuite) TestCreate_BuiltInName() {
+	ctx := context.Background()
+	accessToken, _ := t.managerToken(ctx)
+	role := newCustomRole()
+	role.Name = model.RoleAdmin
+
+	_, err := t.service.Create(ctx, accessToken, role)
+	t.Require().ErrorIs(err, errs.ErrRoleAlreadyExists)
+}
+
+func (t *CreateSuite) TestCreate_AlreadyExists() {
+	ctx := context.Background()
+	accessToken, _ := t.managerToken(ctx)
+
+	t.roleRepo.EXPECT().Create(ctx, gomock.Any()).Return(int64(0), errs.ErrRoleAlreadyExists)
+
+	_, err := t.service.Create(ctx, accessToken, newCustomRole())
+	t.Require().ErrorIs(err, errs.ErrRoleAlreadyExists)
+}
+
+func (t *CreateSuite) TestCreate_Invalid() {
+	ctx := context.Background()
+
+	for _, role := range []*model.Role{
+		{Name: ""},
+		{Name: "support,admin"},
+		{Name: "1support"},
+		{Name: gofakeit.LetterN(65)},
+		{Name: "support", Permissions: []string{"chat"}},
+		{Name: "support", Permissions: []string{"Chat.Delete"}},
+	} {
+		accessToken, _ := t.managerToken(ctx)
+
+		_, err := t.service.Create(ctx, accessToken, role)
+		t.Require().ErrorIs(err, errs.ErrInvalidRole, role.Name)
+
+		var validationErr *errs.ValidationError
+		t.Require().ErrorAs(err, &validationErr)
+		t.Require().Len(validationErr.Violations, 1)
+	}
+}
+
+func (t *CreateSuite) TestCreate_NotAllowed() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	claims := &model.UserClaims{UserID: gofakeit.Int64(), Roles: []string{model.RoleUser}, MFA: true}
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.roleRepo.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionManageRoles).Return(false, nil)
+
+	_, err := t.service.Create(ctx, accessToken, newCustomRole())
+	t.Require().ErrorIs(err, errs.ErrAccessDenied)
+}
+
+func (t *CreateSuite) TestCreate_WithoutMFA() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	claims := &model.UserClaims{UserID: gofakeit.Int64(), Roles: []string{model.RoleAdmin}}
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.roleRepo.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionManageRoles).Return(true, nil)
+
+	_, err := t.service.Create(ctx, accessToken, newCustomRole())
+	t.Require().ErrorIs(err, errs.ErrMFARequired)
+}
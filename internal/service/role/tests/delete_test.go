@@ -0,0 +1,51 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteSuite(t *testing.T) {
+	suite.Run(t, new(DeleteSuite))
+}
+
+type DeleteSuite struct {
+	roleSuite
+}
+
+func (t *DeleteSuite) TestDelete_Ok() {
+	ctx := context.Background()
+	accessToken, claims := t.managerToken(ctx)
+	role := newCustomRole()
+
+	t.roleRepo.EXPECT().GetByID(ctx, claims.OrgID, role.ID).Return(role, nil)
+	t.roleRepo.EXPECT().Delete(ctx, claims.OrgID, role.ID).Return(nil)
+	t.events.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, event *model.UserEvent) error {
+			t.Require().Equal(model.UserEventTypeRoleDeleted, event.Type)
+			t.Require().Equal(claims.UserID, event.UserID)
+			t.Require().Equal(role.ID, event.EntityID)
+			t.Require().Equal(&model.RoleDeletedEventValue{Name: role.Name}, event.Value)
+			return nil
+		},
+	)
+
+	err := t.service.Delete(ctx, accessToken, role.ID)
+	t.Require().NoError(err)
+}
+
+func (t *DeleteSuite) TestDelete_BuiltIn() {
+	ctx := context.Background()
+	accessToken, claims := t.managerToken(ctx)
+	role := &model.Role{ID: 2, Name: model.RoleUser, BuiltIn: true}
+
+	t.roleRepo.EXPECT().GetByID(ctx, claims.OrgID, role.ID).Return(role, nil)
+
+	err := t.service.Delete(ctx, accessToken, role.ID)
+	t.Require().ErrorIs(err, errs.ErrBuiltInRole)
+}
@@ -0,0 +1,76 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestHasPermissionSuite(t *testing.T) {
+	suite.Run(t, new(HasPermissionSuite))
+}
+
+type HasPermissionSuite struct {
+	roleSuite
+}
+
+func (t *HasPermissionSuite) TestHasPermission_Granted() {
+	ctx := context.Background()
+	userID := gofakeit.Int64()
+
+	t.roleRepo.EXPECT().HasPermission(ctx, userID, model.DefaultOrgID, model.PermissionRevokeTokens).Return(true, nil)
+
+	granted, err := t.service.HasPermission(ctx, userID, model.DefaultOrgID, model.PermissionRevokeTokens)
+	t.Require().NoError(err)
+	t.Require().True(granted)
+}
+
+func (t *HasPermissionSuite) TestHasPermission_NotGranted() {
+	ctx := context.Background()
+	userID := gofakeit.Int64()
+
+	t.roleRepo.EXPECT().HasPermission(ctx, userID, model.DefaultOrgID, model.PermissionRevokeTokens).Return(false, nil)
+
+	granted, err := t.service.HasPermission(ctx, userID, model.DefaultOrgID, model.PermissionRevokeTokens)
+	t.Require().NoError(err)
+	t.Require().False(granted)
+}
+
+func (t *HasPermissionSuite) TestHasPermission_NoUser() {
+	granted, err := t.service.HasPermission(context.Background(), 0, model.DefaultOrgID, model.PermissionRevokeTokens)
+	t.Require().NoError(err)
+	t.Require().False(granted)
+}
+
+func (t *HasPermissionSuite) TestHasPermission_RepositoryError() {
+	ctx := context.Background()
+	userID := gofakeit.Int64()
+
+	t.roleRepo.EXPECT().HasPermission(ctx, userID, model.DefaultOrgID, model.PermissionRevokeTokens).
+		Return(false, errors.New("connection refused"))
+
+	_, err := t.service.HasPermission(ctx, userID, model.DefaultOrgID, model.PermissionRevokeTokens)
+	t.Require().Error(err)
+}
+
+func (t *HasPermissionSuite) TestPermissions_Ok() {
+	ctx := context.Background()
+	userID := gofakeit.Int64()
+	permissions := []string{model.PermissionManageUsers, model.PermissionRevokeTokens}
+
+	t.roleRepo.EXPECT().ListPermissions(ctx, userID, model.DefaultOrgID).Return(permissions, nil)
+
+	res, err := t.service.Permissions(ctx, userID, model.DefaultOrgID)
+	t.Require().NoError(err)
+	t.Require().Equal(permissions, res)
+}
+
+func (t *HasPermissionSuite) TestPermissions_NoUser() {
+	res, err := t.service.Permissions(context.Background(), 0, model.DefaultOrgID)
+	t.Require().NoError(err)
+	t.Require().Empty(res)
+}
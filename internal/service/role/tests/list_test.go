@@ -0,0 +1,29 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestListSuite(t *testing.T) {
+	suite.Run(t, new(ListSuite))
+}
+
+type ListSuite struct {
+	roleSuite
+}
+
+func (t *ListSuite) TestList_Ok() {
+	ctx := context.Background()
+	accessToken, claims := t.managerToken(ctx)
+	roles := []*model.Role{{ID: 1, Name: model.RoleUnknown, BuiltIn: true}, newCustomRole()}
+
+	t.roleRepo.EXPECT().List(ctx, claims.OrgID).Return(roles, nil)
+
+	res, err := t.service.List(ctx, accessToken)
+	t.Require().NoError(err)
+	t.Require().Equal(roles, res)
+}
@@ -0,0 +1,56 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSeedSuite(t *testing.T) {
+	suite.Run(t, new(SeedSuite))
+}
+
+type SeedSuite struct {
+	roleSuite
+}
+
+func (t *SeedSuite) TestSeed_Ok() {
+	ctx := context.Background()
+	permissions := []string{"chat.create", "chat.delete", "chat.send_message"}
+	grants := map[string][]string{
+		model.RoleUser:  {"chat.send_message"},
+		model.RoleAdmin: permissions,
+	}
+
+	gomock.InOrder(
+		t.roleRepo.EXPECT().CreatePermissions(ctx, permissions).Return(nil),
+		t.roleRepo.EXPECT().GrantByName(ctx, model.RoleAdmin, permissions).Return(nil),
+		t.roleRepo.EXPECT().GrantByName(ctx, model.RoleUser, []string{"chat.send_message"}).Return(nil),
+	)
+
+	err := t.service.Seed(ctx, permissions, grants)
+	t.Require().NoError(err)
+}
+
+func (t *SeedSuite) TestSeed_InvalidPermission() {
+	err := t.service.Seed(
+		context.Background(),
+		[]string{"chat.create"},
+		map[string][]string{model.RoleUser: {"send message"}},
+	)
+	t.Require().ErrorIs(err, errs.ErrInvalidRole)
+}
+
+func (t *SeedSuite) TestSeed_UnknownRole() {
+	ctx := context.Background()
+
+	t.roleRepo.EXPECT().CreatePermissions(ctx, []string{"chat.create"}).Return(nil)
+	t.roleRepo.EXPECT().GrantByName(ctx, "SUPPORT", []string{"chat.create"}).Return(errs.ErrRoleNotFound)
+
+	err := t.service.Seed(ctx, []string{"chat.create"}, map[string][]string{"SUPPORT": {"chat.create"}})
+	t.Require().ErrorIs(err, errs.ErrRoleNotFound)
+}
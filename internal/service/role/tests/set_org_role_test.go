@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSetOrgRoleSuite(t *testing.T) {
+	suite.Run(t, new(SetOrgRoleSuite))
+}
+
+type SetOrgRoleSuite struct {
+	roleSuite
+}
+
+func (t *SetOrgRoleSuite) TestSetOrgRole_Ok() {
+	ctx := context.Background()
+	accessToken, claims := t.managerToken(ctx)
+	userID := gofakeit.Int64()
+	role := newCustomRole()
+
+	t.roleRepo.EXPECT().GetByID(ctx, claims.OrgID, role.ID).Return(role, nil)
+	t.roleRepo.EXPECT().SetMemberRole(ctx, claims.OrgID, userID, role.ID).Return(nil)
+	t.events.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, event *model.UserEvent) error {
+			t.Require().Equal(model.UserEventTypeRoleAssigned, event.Type)
+			t.Require().Equal(claims.UserID, event.UserID)
+			t.Require().Equal(userID, event.EntityID)
+			t.Require().Equal(claims.OrgID, event.OrgID)
+			t.Require().Equal(&model.RoleAssignedEventValue{RoleID: role.ID, Name: role.Name}, event.Value)
+			return nil
+		},
+	)
+
+	err := t.service.SetOrgRole(ctx, accessToken, userID, role.ID)
+	t.Require().NoError(err)
+}
+
+func (t *SetOrgRoleSuite) TestSetOrgRole_NotMember() {
+	ctx := context.Background()
+	accessToken, claims := t.managerToken(ctx)
+	userID := gofakeit.Int64()
+	role := newCustomRole()
+
+	t.roleRepo.EXPECT().GetByID(ctx, claims.OrgID, role.ID).Return(role, nil)
+	t.roleRepo.EXPECT().SetMemberRole(ctx, claims.OrgID, userID, role.ID).Return(errs.ErrUserNotFound)
+
+	err := t.service.SetOrgRole(ctx, accessToken, userID, role.ID)
+	t.Require().ErrorIs(err, errs.ErrUserNotFound)
+}
+
+func (t *SetOrgRoleSuite) TestSetOrgRole_RoleNotFound() {
+	ctx := context.Background()
+	accessToken, claims := t.managerToken(ctx)
+	roleID := gofakeit.Int64()
+
+	t.roleRepo.EXPECT().GetByID(ctx, claims.OrgID, roleID).Return(nil, errs.ErrRoleNotFound)
+
+	err := t.service.SetOrgRole(ctx, accessToken, gofakeit.Int64(), roleID)
+	t.Require().ErrorIs(err, errs.ErrRoleNotFound)
+}
@@ -0,0 +1,82 @@
+package tests
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	svcMocks "github.com/Paul1k96/microservices_course_auth/internal/service/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service/role"
+	infraMocks "github.com/Paul1k96/microservices_course_platform_common/pkg/client/db/transaction"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type roleSuite struct {
+	suite.Suite
+	*require.Assertions
+	ctrl *gomock.Controller
+
+	roleRepo  *mocks.MockRolesRepository
+	userRepo  *mocks.MockUsersRepository
+	events    *mocks.MockUserEventsRepository
+	userCache *mocks.MockUsersCache
+	sessions  *svcMocks.MockSessionService
+
+	service service.RoleService
+}
+
+func (t *roleSuite) SetupTest() {
+	t.Assertions = require.New(t.T())
+	t.ctrl = gomock.NewController(t.T())
+
+	t.roleRepo = mocks.NewMockRolesRepository(t.ctrl)
+	t.userRepo = mocks.NewMockUsersRepository(t.ctrl)
+	t.events = mocks.NewMockUserEventsRepository(t.ctrl)
+	t.userCache = mocks.NewMockUsersCache(t.ctrl)
+	t.sessions = svcMocks.NewMockSessionService(t.ctrl)
+
+	t.service = role.NewService(
+		slog.Default(),
+		infraMocks.NewNopTxManager(),
+		t.roleRepo,
+		t.userRepo,
+		t.events,
+		t.userCache,
+		t.sessions,
+	)
+}
+
+func (t *roleSuite) TearDownTest() {
+	t.ctrl.Finish()
+}
+
+// managerToken returns an access token of a caller allowed to manage roles.
+func (t *roleSuite) managerToken(ctx context.Context) (string, *model.UserClaims) {
+	accessToken := gofakeit.LetterN(64)
+	claims := &model.UserClaims{
+		ID:     gofakeit.UUID(),
+		UserID: gofakeit.Int64(),
+		OrgID:  model.DefaultOrgID,
+		Roles:  []string{model.RoleAdmin},
+		MFA:    true,
+	}
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.roleRepo.EXPECT().HasPermission(ctx, claims.UserID, claims.OrgID, model.PermissionManageRoles).Return(true, nil)
+
+	return accessToken, claims
+}
+
+func newCustomRole() *model.Role {
+	return &model.Role{
+		ID:          gofakeit.Int64(),
+		Name:        "support",
+		Description: "Support team",
+		Permissions: []string{model.PermissionUnlockUsers, "chat.delete"},
+	}
+}
@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUpdateSuite(t *testing.T) {
+	suite.Run(t, new(UpdateSuite))
+}
+
+type UpdateSuite struct {
+	roleSuite
+}
+
+func (t *UpdateSuite) TestUpdate_Ok() {
+	ctx := context.Background()
+	accessToken, claims := t.managerToken(ctx)
+	current := newCustomRole()
+	role := &model.Role{ID: current.ID, Description: "Support", Permissions: []string{"chat.delete"}}
+
+	t.roleRepo.EXPECT().GetByID(ctx, claims.OrgID, role.ID).Return(current, nil)
+	t.roleRepo.EXPECT().Update(ctx, role).Return(nil)
+	t.events.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, event *model.UserEvent) error {
+			t.Require().Equal(model.UserEventTypeRoleUpdated, event.Type)
+			t.Require().Equal(claims.UserID, event.UserID)
+			t.Require().Equal(role.ID, event.EntityID)
+			t.Require().Equal(claims.OrgID, event.OrgID)
+			return nil
+		},
+	)
+
+	err := t.service.Update(ctx, accessToken, role)
+	t.Require().NoError(err)
+	t.Require().Equal(current.Name, role.Name)
+	t.Require().Equal(claims.OrgID, role.OrgID)
+}
+
+func (t *UpdateSuite) TestUpdate_BuiltIn() {
+	ctx := context.Background()
+	accessToken, claims := t.managerToken(ctx)
+	current := &model.Role{ID: 3, Name: model.RoleAdmin, BuiltIn: true}
+
+	t.roleRepo.EXPECT().GetByID(ctx, claims.OrgID, current.ID).Return(current, nil)
+
+	err := t.service.Update(ctx, accessToken, &model.Role{ID: current.ID})
+	t.Require().ErrorIs(err, errs.ErrBuiltInRole)
+}
+
+func (t *UpdateSuite) TestUpdate_NotFound() {
+	ctx := context.Background()
+	accessToken, claims := t.managerToken(ctx)
+	role := newCustomRole()
+
+	t.roleRepo.EXPECT().GetByID(ctx, claims.OrgID, role.ID).Return(nil, errs.ErrRoleNotFound)
+
+	err := t.service.Update(ctx, accessToken, role)
+	t.Require().ErrorIs(err, errs.ErrRoleNotFound)
+}
+
+func (t *UpdateSuite) TestUpdate_InvalidPermissions() {
+	ctx := context.Background()
+	accessToken, _ := t.managerToken(ctx)
+	role := newCustomRole()
+	role.Permissions = []string{"/chat_v1.Chat/Delete"}
+
+	err := t.service.Update(ctx, accessToken, role)
+	t.Require().ErrorIs(err, errs.ErrInvalidRole)
+}
@@ -0,0 +1,51 @@
+package role
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// Update replaces description and permissions of the custom role of the organization of the caller,
+// the caller must be allowed to manage roles. Built-in roles cannot be updated.
+func (s *service) Update(ctx context.Context, accessToken string, role *model.Role) error {
+	claims, err := s.checkManager(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+
+	violations := validatePermissions(role.Permissions)
+	if len(violations) > 0 {
+		return errs.NewValidationError(errs.ErrInvalidRole, violations...)
+	}
+
+	if txErr := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		current, err := s.roles.GetByID(ctx, claims.OrgID, role.ID)
+		if err != nil {
+			return fmt.Errorf("get role by id: %w", err)
+		}
+
+		if current.BuiltIn {
+			return fmt.Errorf("update role %s: %w", current.Name, errs.ErrBuiltInRole)
+		}
+
+		role.OrgID = claims.OrgID
+		err = s.roles.Update(ctx, role)
+		if err != nil {
+			return fmt.Errorf("update role: %w", err)
+		}
+
+		role.Name = current.Name
+		role.CreatedAt = current.CreatedAt
+
+		return nil
+	}); txErr != nil {
+		return fmt.Errorf("transaction error: %w", txErr)
+	}
+
+	s.saveEvent(ctx, model.NewRoleUpdatedEvent(claims.Actor(), role).WithOrgID(claims.OrgID))
+
+	return nil
+}
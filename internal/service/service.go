@@ -2,15 +2,140 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/google/uuid"
 )
 
+//go:generate ../../bin/mockgen -source $GOFILE -destination "mocks/service.go" -package "mocks"
+
 // UserService represents user service.
 type UserService interface {
 	Create(ctx context.Context, user *model.User) (int64, error)
 	GetByID(ctx context.Context, id int64) (*model.User, error)
-	GetListByIDs(ctx context.Context, ids []int64) ([]*model.User, error)
+	Get(ctx context.Context, accessToken string, id int64) (*model.User, error)
+	GetListByIDs(ctx context.Context, accessToken string, ids []int64) ([]*model.User, error)
+	Search(ctx context.Context, accessToken string, filter *model.UserFilter, pageToken string) (*model.UsersPage, error)
 	Update(ctx context.Context, user *model.User) error
 	Delete(ctx context.Context, id int64) error
+	VerifyCredentials(ctx context.Context, orgID int64, email, password string) (*model.User, error)
+	ChangePassword(ctx context.Context, accessToken, oldPassword, newPassword string) error
+	ResetPassword(ctx context.Context, id int64, newPassword string) error
+	AddToOrg(ctx context.Context, id, orgID int64) error
+}
+
+// AuthService represents authentication service.
+type AuthService interface {
+	Login(ctx context.Context, orgID int64, email, password, ip string) (*model.LoginResult, error)
+	VerifyMFA(ctx context.Context, mfaToken, code string) (*model.TokenPair, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*model.TokenPair, error)
+	Logout(ctx context.Context, accessToken, refreshToken string) error
+	RevokeUserTokens(ctx context.Context, accessToken string, userID int64) error
+	ListSessions(ctx context.Context, accessToken string, userID int64) ([]*model.Session, error)
+	RevokeSession(ctx context.Context, accessToken string, sessionID uuid.UUID) error
+	Impersonate(ctx context.Context, accessToken string, userID int64, reason string) (*model.ImpersonationToken, error)
+}
+
+// PasswordResetService represents self-service password reset service.
+type PasswordResetService interface {
+	Request(ctx context.Context, orgID int64, email string) error
+	Confirm(ctx context.Context, resetToken, newPassword string) error
+}
+
+// EmailVerificationService represents email ownership verification service.
+type EmailVerificationService interface {
+	Send(ctx context.Context, user *model.User) error
+	Resend(ctx context.Context, orgID int64, email string) error
+	Verify(ctx context.Context, verificationToken string) error
+}
+
+// SessionService represents service issuing, verifying and revoking token pairs.
+type SessionService interface {
+	Issue(ctx context.Context, user *model.User, familyID uuid.UUID, mfa bool) (*model.TokenPair, error)
+	IssueImpersonation(ctx context.Context, actorID int64, user *model.User) (*model.ImpersonationToken, error)
+	VerifyAccessToken(ctx context.Context, accessToken string) (*model.UserClaims, error)
+	Authenticate(ctx context.Context, accessToken string) (*model.UserClaims, error)
+	RevokeAccessToken(ctx context.Context, claims *model.UserClaims) error
+	RevokeFamily(ctx context.Context, familyID uuid.UUID) error
+	RevokeUserTokens(ctx context.Context, userID int64) error
+	RevokeOtherSessions(ctx context.Context, claims *model.UserClaims) error
+}
+
+// APIKeyService represents personal API keys service.
+type APIKeyService interface {
+	Create(
+		ctx context.Context,
+		accessToken, name string,
+		scopes []string,
+		expiresAt *time.Time,
+	) (*model.CreatedAPIKey, error)
+	List(ctx context.Context, accessToken string) ([]*model.APIKey, error)
+	Revoke(ctx context.Context, accessToken string, id uuid.UUID) error
+}
+
+// OAuthService represents OAuth 2.0 authorization server and OpenID Connect provider.
+type OAuthService interface {
+	CreateClient(
+		ctx context.Context,
+		accessToken, name string,
+		scopes, redirectURIs []string,
+	) (*model.CreatedOAuthClient, error)
+	RevokeClient(ctx context.Context, accessToken, clientID string) error
+	IssueClientToken(ctx context.Context, clientID, clientSecret string, scopes []string) (*model.ClientToken, error)
+	Authorize(ctx context.Context, accessToken string, request *model.AuthorizationRequest) (string, error)
+	ExchangeAuthorizationCode(
+		ctx context.Context,
+		clientID, clientSecret, code, redirectURI, codeVerifier string,
+	) (*model.ClientToken, error)
+	UserInfo(ctx context.Context, accessToken string) (*model.UserInfo, error)
+}
+
+// MFAService represents two-factor authentication service.
+type MFAService interface {
+	EnrollTOTP(ctx context.Context, accessToken string) (*model.TOTPEnrollment, error)
+	ConfirmTOTP(ctx context.Context, accessToken, code string) ([]string, error)
+	DisableTOTP(ctx context.Context, accessToken, code string) error
+	Challenge(ctx context.Context, user *model.User) (string, error)
+	VerifyChallenge(ctx context.Context, mfaToken, code string) (*model.User, error)
+}
+
+// LockoutService represents service throttling failed login attempts per account and client address.
+type LockoutService interface {
+	Check(ctx context.Context, orgID int64, email, ip string) error
+	RegisterFailure(ctx context.Context, orgID int64, email, ip string) error
+	Reset(ctx context.Context, orgID int64, email string) error
+	Unlock(ctx context.Context, accessToken string, userID int64) error
+}
+
+// RateLimitService represents rate limiting of requests service.
+type RateLimitService interface {
+	Take(ctx context.Context, endpoint, caller string) (*model.RateLimitResult, error)
+}
+
+// AccessService represents endpoint access check service.
+type AccessService interface {
+	Check(ctx context.Context, accessToken, endpointAddress string) error
+}
+
+// RoleService represents roles and permissions service.
+type RoleService interface {
+	HasPermission(ctx context.Context, userID, orgID int64, permission string) (bool, error)
+	Permissions(ctx context.Context, userID, orgID int64) ([]string, error)
+	Create(ctx context.Context, accessToken string, role *model.Role) (int64, error)
+	Update(ctx context.Context, accessToken string, role *model.Role) error
+	Delete(ctx context.Context, accessToken string, id int64) error
+	List(ctx context.Context, accessToken string) ([]*model.Role, error)
+	Assign(ctx context.Context, accessToken string, userID, roleID int64) error
+	Unassign(ctx context.Context, accessToken string, userID, roleID int64) error
+	SetOrgRole(ctx context.Context, accessToken string, userID, roleID int64) error
+	Seed(ctx context.Context, permissions []string, grants map[string][]string) error
+}
+
+// SigningKeyService represents access token signing keys service.
+type SigningKeyService interface {
+	GetSigningKey(ctx context.Context) (*model.SigningKey, error)
+	GetVerificationKey(ctx context.Context, id string) (*model.SigningKey, error)
+	GetVerificationKeys(ctx context.Context) ([]*model.SigningKey, error)
+	Rotate(ctx context.Context) error
 }
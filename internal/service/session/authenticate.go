@@ -0,0 +1,31 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/endpoint"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// Authenticate verifies the access token the endpoint is called with and returns its claims.
+// Every call made with an impersonation token is recorded as an event of the admin together
+// with the endpoint called.
+func (s *service) Authenticate(ctx context.Context, accessToken string) (*model.UserClaims, error) {
+	claims, err := s.VerifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, fmt.Errorf("verify access token: %w", err)
+	}
+
+	if claims.IsImpersonated() {
+		event := model.NewImpersonatedCallEvent(claims.ActorID, claims.UserID, claims.ID, endpoint.FromContext(ctx))
+
+		err = s.events.Save(ctx, event.WithOrgID(claims.OrgID))
+		if err != nil {
+			s.logger.Error("failed to save user event:", slog.String("error", err.Error()))
+		}
+	}
+
+	return claims, nil
+}
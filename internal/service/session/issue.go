@@ -0,0 +1,87 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/clientip"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/Paul1k96/microservices_course_auth/internal/useragent"
+	"github.com/google/uuid"
+)
+
+// Issue issues a new pair of tokens and stores the refresh token as a member of the familyID family.
+// The session of the family is created on login and marked as seen from the client address on every rotation.
+// mfa tells whether the session was opened with the second factor, it is kept by the session on rotations.
+func (s *service) Issue(
+	ctx context.Context,
+	user *model.User,
+	familyID uuid.UUID,
+	mfa bool,
+) (*model.TokenPair, error) {
+	signingKey, err := s.signingKeyService.GetSigningKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get signing key: %w", err)
+	}
+
+	accessToken, accessClaims, err := token.GenerateWithKey(
+		user,
+		token.TypeAccess,
+		signingKey,
+		s.tokenConfig.GetAccessTokenTTL(),
+		mfa,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("generate access token: %w", err)
+	}
+
+	refreshToken, err := token.Generate(
+		user,
+		token.TypeRefresh,
+		s.tokenConfig.GetRefreshTokenSecretKey(),
+		s.tokenConfig.GetRefreshTokenTTL(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("generate refresh token: %w", err)
+	}
+
+	now := time.Now()
+
+	err = s.refreshTokens.Create(ctx, &model.RefreshToken{
+		ID:                   uuid.New(),
+		FamilyID:             familyID,
+		UserID:               user.ID,
+		TokenHash:            token.Hash(refreshToken),
+		ExpiresAt:            now.Add(s.tokenConfig.GetRefreshTokenTTL()),
+		CreatedAt:            now,
+		AccessTokenID:        accessClaims.ID,
+		AccessTokenExpiresAt: accessClaims.ExpiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("save refresh token: %w", err)
+	}
+
+	userAgent := useragent.FromIncomingContext(ctx)
+
+	err = s.sessions.Save(ctx, &model.Session{
+		ID:         familyID,
+		UserID:     user.ID,
+		Device:     useragent.Describe(userAgent),
+		UserAgent:  userAgent,
+		IP:         clientip.FromIncomingContext(ctx),
+		ExpiresAt:  now.Add(s.tokenConfig.GetRefreshTokenTTL()),
+		LastSeenAt: now,
+		CreatedAt:  now,
+		MFA:        mfa,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("save session: %w", err)
+	}
+
+	return &model.TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
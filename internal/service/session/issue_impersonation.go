@@ -0,0 +1,38 @@
+package session
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+)
+
+// IssueImpersonation issues a short-lived access token of the user for the admin actorID acting as them.
+// No refresh token or session is created, so the token cannot outlive its TTL.
+func (s *service) IssueImpersonation(
+	ctx context.Context,
+	actorID int64,
+	user *model.User,
+) (*model.ImpersonationToken, error) {
+	signingKey, err := s.signingKeyService.GetSigningKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get signing key: %w", err)
+	}
+
+	accessToken, claims, err := token.GenerateForImpersonation(
+		user,
+		actorID,
+		signingKey,
+		s.tokenConfig.GetImpersonationTokenTTL(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("generate impersonation token: %w", err)
+	}
+
+	return &model.ImpersonationToken{
+		ID:          claims.ID,
+		AccessToken: accessToken,
+		ExpiresAt:   claims.ExpiresAt,
+	}, nil
+}
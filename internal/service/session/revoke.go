@@ -0,0 +1,125 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/google/uuid"
+)
+
+// RevokeAccessToken puts access token into denylist until it expires.
+func (s *service) RevokeAccessToken(ctx context.Context, claims *model.UserClaims) error {
+	return s.deny(ctx, claims.ID, claims.ExpiresAt, time.Now())
+}
+
+// RevokeFamily revokes the session, refresh tokens of its family and access tokens issued with them.
+func (s *service) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	now := time.Now()
+
+	tokens, err := s.refreshTokens.GetByFamilyID(ctx, familyID, now)
+	if err != nil {
+		return fmt.Errorf("get refresh tokens by family id: %w", err)
+	}
+
+	err = s.refreshTokens.RevokeFamily(ctx, familyID, now)
+	if err != nil {
+		return fmt.Errorf("revoke refresh token family: %w", err)
+	}
+
+	err = s.sessions.Revoke(ctx, familyID, now)
+	if err != nil {
+		return fmt.Errorf("revoke session: %w", err)
+	}
+
+	return s.denyAccessTokens(ctx, tokens, now)
+}
+
+// RevokeUserTokens revokes every session and refresh token of the user and access tokens issued with them.
+func (s *service) RevokeUserTokens(ctx context.Context, userID int64) error {
+	now := time.Now()
+
+	tokens, err := s.refreshTokens.GetByUserID(ctx, userID, now)
+	if err != nil {
+		return fmt.Errorf("get refresh tokens by user id: %w", err)
+	}
+
+	err = s.refreshTokens.RevokeByUserID(ctx, userID, now)
+	if err != nil {
+		return fmt.Errorf("revoke refresh tokens: %w", err)
+	}
+
+	err = s.sessions.RevokeByUserID(ctx, userID, now)
+	if err != nil {
+		return fmt.Errorf("revoke sessions: %w", err)
+	}
+
+	return s.denyAccessTokens(ctx, tokens, now)
+}
+
+// RevokeOtherSessions revokes every session of the user except the one the access token was issued with.
+func (s *service) RevokeOtherSessions(ctx context.Context, claims *model.UserClaims) error {
+	now := time.Now()
+
+	tokens, err := s.refreshTokens.GetByUserID(ctx, claims.UserID, now)
+	if err != nil {
+		return fmt.Errorf("get refresh tokens by user id: %w", err)
+	}
+
+	var currentFamilyID uuid.UUID
+	for _, refreshToken := range tokens {
+		if refreshToken.AccessTokenID == claims.ID {
+			currentFamilyID = refreshToken.FamilyID
+			break
+		}
+	}
+
+	err = s.refreshTokens.RevokeByUserIDExceptFamily(ctx, claims.UserID, currentFamilyID, now)
+	if err != nil {
+		return fmt.Errorf("revoke refresh tokens: %w", err)
+	}
+
+	err = s.sessions.RevokeByUserIDExcept(ctx, claims.UserID, currentFamilyID, now)
+	if err != nil {
+		return fmt.Errorf("revoke sessions: %w", err)
+	}
+
+	others := make([]*model.RefreshToken, 0, len(tokens))
+	for _, refreshToken := range tokens {
+		if refreshToken.FamilyID != currentFamilyID {
+			others = append(others, refreshToken)
+		}
+	}
+
+	return s.denyAccessTokens(ctx, others, now)
+}
+
+func (s *service) denyAccessTokens(ctx context.Context, tokens []*model.RefreshToken, now time.Time) error {
+	for _, refreshToken := range tokens {
+		if len(refreshToken.AccessTokenID) == 0 {
+			continue
+		}
+
+		err := s.deny(ctx, refreshToken.AccessTokenID, refreshToken.AccessTokenExpiresAt, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *service) deny(ctx context.Context, tokenID string, expiresAt, now time.Time) error {
+	ttl := expiresAt.Sub(now)
+	if ttl <= 0 {
+		return nil
+	}
+
+	err := s.denylist.Add(ctx, tokenID, ttl)
+	if err != nil {
+		return fmt.Errorf("add access token to denylist: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package session
+
+import (
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository"
+	svc "github.com/Paul1k96/microservices_course_auth/internal/service"
+)
+
+type service struct {
+	logger *slog.Logger
+
+	refreshTokens repository.RefreshTokensRepository
+	sessions      repository.SessionsRepository
+	denylist      repository.AccessTokenDenylist
+	apiKeys       repository.APIKeysRepository
+	users         repository.UsersRepository
+	events        repository.UserEventsRepository
+
+	signingKeyService svc.SigningKeyService
+	tokenConfig       config.TokenConfig
+}
+
+// NewService creates a new session service.
+func NewService(
+	logger *slog.Logger,
+	refreshTokens repository.RefreshTokensRepository,
+	sessions repository.SessionsRepository,
+	denylist repository.AccessTokenDenylist,
+	apiKeys repository.APIKeysRepository,
+	users repository.UsersRepository,
+	events repository.UserEventsRepository,
+	signingKeyService svc.SigningKeyService,
+	tokenConfig config.TokenConfig,
+) svc.SessionService {
+	return &service{
+		logger:            logger,
+		refreshTokens:     refreshTokens,
+		sessions:          sessions,
+		denylist:          denylist,
+		apiKeys:           apiKeys,
+		users:             users,
+		events:            events,
+		signingKeyService: signingKeyService,
+		tokenConfig:       tokenConfig,
+	}
+}
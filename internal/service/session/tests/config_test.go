@@ -0,0 +1,28 @@
+package tests
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+)
+
+type tokenConfig struct {
+	refreshTokenSecretKey []byte
+	accessTokenTTL        time.Duration
+	refreshTokenTTL       time.Duration
+	impersonationTokenTTL time.Duration
+}
+
+func newTokenConfig() *tokenConfig {
+	return &tokenConfig{
+		refreshTokenSecretKey: []byte(gofakeit.LetterN(32)),
+		accessTokenTTL:        15 * time.Minute,
+		refreshTokenTTL:       24 * time.Hour,
+		impersonationTokenTTL: 10 * time.Minute,
+	}
+}
+
+func (c *tokenConfig) GetRefreshTokenSecretKey() []byte        { return c.refreshTokenSecretKey }
+func (c *tokenConfig) GetAccessTokenTTL() time.Duration        { return c.accessTokenTTL }
+func (c *tokenConfig) GetRefreshTokenTTL() time.Duration       { return c.refreshTokenTTL }
+func (c *tokenConfig) GetImpersonationTokenTTL() time.Duration { return c.impersonationTokenTTL }
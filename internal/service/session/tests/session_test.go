@@ -0,0 +1,317 @@
+package tests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/endpoint"
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/principal"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	svcMocks "github.com/Paul1k96/microservices_course_auth/internal/service/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service/session"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestSessionSuite(t *testing.T) {
+	suite.Run(t, new(SessionSuite))
+}
+
+type SessionSuite struct {
+	suite.Suite
+	*require.Assertions
+	ctrl *gomock.Controller
+
+	refreshTokens *mocks.MockRefreshTokensRepository
+	sessions      *mocks.MockSessionsRepository
+	denylist      *mocks.MockAccessTokenDenylist
+	apiKeys       *mocks.MockAPIKeysRepository
+	userRepo      *mocks.MockUsersRepository
+	events        *mocks.MockUserEventsRepository
+	signingKeys   *svcMocks.MockSigningKeyService
+	signingKey    *model.SigningKey
+	tokenConfig   *tokenConfig
+
+	service service.SessionService
+}
+
+func (t *SessionSuite) SetupTest() {
+	t.Assertions = require.New(t.T())
+	t.ctrl = gomock.NewController(t.T())
+
+	t.refreshTokens = mocks.NewMockRefreshTokensRepository(t.ctrl)
+	t.sessions = mocks.NewMockSessionsRepository(t.ctrl)
+	t.denylist = mocks.NewMockAccessTokenDenylist(t.ctrl)
+	t.apiKeys = mocks.NewMockAPIKeysRepository(t.ctrl)
+	t.userRepo = mocks.NewMockUsersRepository(t.ctrl)
+	t.events = mocks.NewMockUserEventsRepository(t.ctrl)
+	t.signingKeys = svcMocks.NewMockSigningKeyService(t.ctrl)
+	t.signingKey = tm.NewSigningKey()
+	t.tokenConfig = newTokenConfig()
+
+	t.service = session.NewService(
+		slog.Default(),
+		t.refreshTokens,
+		t.sessions,
+		t.denylist,
+		t.apiKeys,
+		t.userRepo,
+		t.events,
+		t.signingKeys,
+		t.tokenConfig,
+	)
+}
+
+func (t *SessionSuite) TearDownTest() {
+	t.ctrl.Finish()
+}
+
+func (t *SessionSuite) newAccessToken(usr *model.User) (string, *model.UserClaims) {
+	accessToken, claims, err := token.GenerateWithKey(
+		usr,
+		token.TypeAccess,
+		t.signingKey,
+		t.tokenConfig.GetAccessTokenTTL(),
+		false,
+	)
+	t.Require().NoError(err)
+
+	return accessToken, claims
+}
+
+func (t *SessionSuite) TestIssue_Ok() {
+	userAgent := "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36"
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-agent", userAgent))
+	usr := tm.NewUser()
+	familyID := uuid.New()
+
+	var stored *model.RefreshToken
+	var session *model.Session
+	t.signingKeys.EXPECT().GetSigningKey(ctx).Return(t.signingKey, nil)
+	t.refreshTokens.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, refreshToken *model.RefreshToken) error {
+			stored = refreshToken
+			return nil
+		},
+	)
+	t.sessions.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, s *model.Session) error {
+			session = s
+			return nil
+		},
+	)
+
+	pair, err := t.service.Issue(ctx, usr, familyID, true)
+	t.Require().NoError(err)
+	t.Require().NotNil(stored)
+
+	claims, err := token.VerifyWithKeys(pair.AccessToken, token.TypeAccess, func(string) (*model.SigningKey, error) {
+		return t.signingKey, nil
+	})
+	t.Require().NoError(err)
+	t.Require().Equal(usr.ID, claims.UserID)
+	t.Require().True(claims.MFA)
+
+	t.Require().Equal(familyID, stored.FamilyID)
+	t.Require().Equal(usr.ID, stored.UserID)
+	t.Require().Equal(token.Hash(pair.RefreshToken), stored.TokenHash)
+	t.Require().Equal(claims.ID, stored.AccessTokenID)
+	t.Require().WithinDuration(claims.ExpiresAt, stored.AccessTokenExpiresAt, time.Second)
+
+	t.Require().NotNil(session)
+	t.Require().Equal(familyID, session.ID)
+	t.Require().Equal(usr.ID, session.UserID)
+	t.Require().Equal(userAgent, session.UserAgent)
+	t.Require().Equal("Chrome on Linux", session.Device)
+	t.Require().Equal(stored.ExpiresAt, session.ExpiresAt)
+	t.Require().True(session.MFA)
+}
+
+func (t *SessionSuite) TestVerifyAccessToken_Ok() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	accessToken, expected := t.newAccessToken(usr)
+
+	t.signingKeys.EXPECT().GetVerificationKey(ctx, t.signingKey.ID).Return(t.signingKey, nil)
+	t.denylist.EXPECT().Contains(ctx, expected.ID).Return(false, nil)
+
+	claims, err := t.service.VerifyAccessToken(ctx, accessToken)
+	t.Require().NoError(err)
+	t.Require().Equal(expected.ID, claims.ID)
+	t.Require().Equal(usr.ID, claims.UserID)
+}
+
+func (t *SessionSuite) TestVerifyAccessToken_Revoked() {
+	ctx := context.Background()
+	accessToken, expected := t.newAccessToken(tm.NewUser())
+
+	t.signingKeys.EXPECT().GetVerificationKey(ctx, t.signingKey.ID).Return(t.signingKey, nil)
+	t.denylist.EXPECT().Contains(ctx, expected.ID).Return(true, nil)
+
+	claims, err := t.service.VerifyAccessToken(ctx, accessToken)
+	t.Require().Nil(claims)
+	t.Require().ErrorIs(err, errs.ErrInvalidAccessToken)
+}
+
+func (t *SessionSuite) TestVerifyAccessToken_InvalidSignature() {
+	ctx := context.Background()
+	accessToken, _ := t.newAccessToken(tm.NewUser())
+
+	t.signingKeys.EXPECT().GetVerificationKey(ctx, t.signingKey.ID).Return(tm.NewSigningKey(), nil)
+
+	claims, err := t.service.VerifyAccessToken(ctx, accessToken)
+	t.Require().Nil(claims)
+	t.Require().ErrorIs(err, errs.ErrInvalidAccessToken)
+}
+
+func (t *SessionSuite) TestIssueImpersonation_Ok() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	actorID := gofakeit.Int64()
+
+	t.signingKeys.EXPECT().GetSigningKey(ctx).Return(t.signingKey, nil)
+
+	impersonation, err := t.service.IssueImpersonation(ctx, actorID, usr)
+	t.Require().NoError(err)
+
+	keyFunc := func(string) (*model.SigningKey, error) {
+		return t.signingKey, nil
+	}
+
+	claims, err := token.VerifyWithKeys(impersonation.AccessToken, token.TypeAccess, keyFunc)
+	t.Require().NoError(err)
+	t.Require().Equal(usr.ID, claims.UserID)
+	t.Require().Equal(actorID, claims.ActorID)
+	t.Require().True(claims.IsImpersonated())
+	t.Require().Equal(impersonation.ID, claims.ID)
+	t.Require().WithinDuration(
+		time.Now().Add(t.tokenConfig.GetImpersonationTokenTTL()),
+		impersonation.ExpiresAt,
+		time.Second,
+	)
+}
+
+func (t *SessionSuite) TestAuthenticate_ImpersonatedCallAudited() {
+	method := "/chat_v1.Chat/SendMessage"
+	ctx := endpoint.NewContext(context.Background(), method)
+	usr := tm.NewUser()
+	actorID := gofakeit.Int64()
+
+	t.signingKeys.EXPECT().GetSigningKey(ctx).Return(t.signingKey, nil)
+	impersonation, err := t.service.IssueImpersonation(ctx, actorID, usr)
+	t.Require().NoError(err)
+
+	t.signingKeys.EXPECT().GetVerificationKey(ctx, t.signingKey.ID).Return(t.signingKey, nil)
+	t.denylist.EXPECT().Contains(ctx, impersonation.ID).Return(false, nil)
+	t.events.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, event *model.UserEvent) error {
+			t.Require().Equal(actorID, event.UserID)
+			t.Require().Equal(usr.ID, event.EntityID)
+			t.Require().Equal(usr.OrgID, event.OrgID)
+			t.Require().Equal(model.UserEventTypeImpersonatedCall, event.Type)
+			t.Require().Equal(&model.ImpersonatedCallEventValue{
+				TokenID:  impersonation.ID,
+				Endpoint: method,
+			}, event.Value.Value())
+			return nil
+		},
+	)
+
+	claims, err := t.service.Authenticate(ctx, impersonation.AccessToken)
+	t.Require().NoError(err)
+	t.Require().Equal(usr.ID, claims.UserID)
+	t.Require().Equal(actorID, claims.Actor())
+}
+
+func (t *SessionSuite) TestVerifyAccessToken_AuthenticatedRequest() {
+	accessToken, expected := t.newAccessToken(tm.NewUser())
+	ctx := principal.NewContext(context.Background(), accessToken, expected)
+
+	claims, err := t.service.VerifyAccessToken(ctx, accessToken)
+	t.Require().NoError(err)
+	t.Require().Same(expected, claims)
+}
+
+func (t *SessionSuite) TestRevokeFamily_Ok() {
+	ctx := context.Background()
+	familyID := uuid.New()
+	live := &model.RefreshToken{
+		FamilyID:             familyID,
+		AccessTokenID:        gofakeit.UUID(),
+		AccessTokenExpiresAt: time.Now().Add(time.Minute),
+	}
+
+	t.refreshTokens.EXPECT().GetByFamilyID(ctx, familyID, gomock.Any()).Return([]*model.RefreshToken{live}, nil)
+	t.refreshTokens.EXPECT().RevokeFamily(ctx, familyID, gomock.Any()).Return(nil)
+	t.sessions.EXPECT().Revoke(ctx, familyID, gomock.Any()).Return(nil)
+	t.denylist.EXPECT().Add(ctx, live.AccessTokenID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, ttl time.Duration) error {
+			t.Require().Positive(ttl)
+			t.Require().LessOrEqual(ttl, time.Minute)
+			return nil
+		},
+	)
+
+	err := t.service.RevokeFamily(ctx, familyID)
+	t.Require().NoError(err)
+}
+
+func (t *SessionSuite) TestRevokeUserTokens_SkipsExpiredAccessTokens() {
+	ctx := context.Background()
+	userID := gofakeit.Int64()
+	live := &model.RefreshToken{
+		UserID:               userID,
+		AccessTokenID:        gofakeit.UUID(),
+		AccessTokenExpiresAt: time.Now().Add(time.Minute),
+	}
+	expired := &model.RefreshToken{
+		UserID:               userID,
+		AccessTokenID:        gofakeit.UUID(),
+		AccessTokenExpiresAt: time.Now().Add(-time.Minute),
+	}
+
+	t.refreshTokens.EXPECT().GetByUserID(ctx, userID, gomock.Any()).Return([]*model.RefreshToken{live, expired}, nil)
+	t.refreshTokens.EXPECT().RevokeByUserID(ctx, userID, gomock.Any()).Return(nil)
+	t.sessions.EXPECT().RevokeByUserID(ctx, userID, gomock.Any()).Return(nil)
+	t.denylist.EXPECT().Add(ctx, live.AccessTokenID, gomock.Any()).Return(nil)
+
+	err := t.service.RevokeUserTokens(ctx, userID)
+	t.Require().NoError(err)
+}
+
+func (t *SessionSuite) TestRevokeOtherSessions_KeepsCurrentFamily() {
+	ctx := context.Background()
+	userID := gofakeit.Int64()
+	current := &model.RefreshToken{
+		FamilyID:             uuid.New(),
+		UserID:               userID,
+		AccessTokenID:        gofakeit.UUID(),
+		AccessTokenExpiresAt: time.Now().Add(time.Minute),
+	}
+	other := &model.RefreshToken{
+		FamilyID:             uuid.New(),
+		UserID:               userID,
+		AccessTokenID:        gofakeit.UUID(),
+		AccessTokenExpiresAt: time.Now().Add(time.Minute),
+	}
+	claims := &model.UserClaims{ID: current.AccessTokenID, UserID: userID}
+
+	t.refreshTokens.EXPECT().GetByUserID(ctx, userID, gomock.Any()).Return([]*model.RefreshToken{current, other}, nil)
+	t.refreshTokens.EXPECT().RevokeByUserIDExceptFamily(ctx, userID, current.FamilyID, gomock.Any()).Return(nil)
+	t.sessions.EXPECT().RevokeByUserIDExcept(ctx, userID, current.FamilyID, gomock.Any()).Return(nil)
+	t.denylist.EXPECT().Add(ctx, other.AccessTokenID, gomock.Any()).Return(nil)
+
+	err := t.service.RevokeOtherSessions(ctx, claims)
+	t.Require().NoError(err)
+}
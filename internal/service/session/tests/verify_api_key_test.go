@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"context"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+)
+
+func (t *SessionSuite) newAPIKey(usr *model.User) (string, *model.APIKey) {
+	key, prefix, err := token.GenerateAPIKey()
+	t.Require().NoError(err)
+
+	return key, &model.APIKey{
+		ID:        uuid.New(),
+		UserID:    usr.ID,
+		Prefix:    prefix,
+		KeyHash:   token.Hash(key),
+		Scopes:    []string{"/chat_v1.Chat/*"},
+		CreatedAt: time.Now(),
+	}
+}
+
+func (t *SessionSuite) TestVerifyAccessToken_APIKey() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	enabledAt := time.Now()
+	usr.TOTPEnabledAt = &enabledAt
+	key, apiKey := t.newAPIKey(usr)
+
+	t.apiKeys.EXPECT().GetByPrefix(ctx, apiKey.Prefix).Return(apiKey, nil)
+	t.userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+	t.apiKeys.EXPECT().Touch(ctx, apiKey.ID, gomock.Any(), gomock.Any()).Return(errors.New("connection refused"))
+
+	claims, err := t.service.VerifyAccessToken(ctx, key)
+	t.Require().NoError(err)
+	t.Require().Equal(usr.ID, claims.UserID)
+	t.Require().Equal(usr.Roles, claims.Roles)
+	t.Require().Equal(apiKey.ID.String(), claims.APIKeyID)
+	t.Require().False(claims.MFA)
+	t.Require().True(claims.Allows("/chat_v1.Chat/SendMessage"))
+	t.Require().False(claims.Allows("/user_v1.User/Delete"))
+}
+
+func (t *SessionSuite) TestVerifyAccessToken_APIKeyWrongSecret() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	key, apiKey := t.newAPIKey(usr)
+
+	t.apiKeys.EXPECT().GetByPrefix(ctx, apiKey.Prefix).Return(apiKey, nil)
+
+	claims, err := t.service.VerifyAccessToken(ctx, key+"x")
+	t.Require().Nil(claims)
+	t.Require().ErrorIs(err, errs.ErrInvalidAccessToken)
+}
+
+func (t *SessionSuite) TestVerifyAccessToken_APIKeyRevoked() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	key, apiKey := t.newAPIKey(usr)
+	revokedAt := time.Now().Add(-time.Minute)
+	apiKey.RevokedAt = &revokedAt
+
+	t.apiKeys.EXPECT().GetByPrefix(ctx, apiKey.Prefix).Return(apiKey, nil)
+
+	claims, err := t.service.VerifyAccessToken(ctx, key)
+	t.Require().Nil(claims)
+	t.Require().ErrorIs(err, errs.ErrInvalidAccessToken)
+}
+
+func (t *SessionSuite) TestVerifyAccessToken_APIKeyExpired() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	key, apiKey := t.newAPIKey(usr)
+	expiresAt := time.Now().Add(-time.Minute)
+	apiKey.ExpiresAt = &expiresAt
+
+	t.apiKeys.EXPECT().GetByPrefix(ctx, apiKey.Prefix).Return(apiKey, nil)
+
+	claims, err := t.service.VerifyAccessToken(ctx, key)
+	t.Require().Nil(claims)
+	t.Require().ErrorIs(err, errs.ErrInvalidAccessToken)
+}
+
+func (t *SessionSuite) TestVerifyAccessToken_APIKeyUnknown() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	key, apiKey := t.newAPIKey(usr)
+
+	t.apiKeys.EXPECT().GetByPrefix(ctx, apiKey.Prefix).Return(nil, errs.ErrAPIKeyNotFound)
+
+	claims, err := t.service.VerifyAccessToken(ctx, key)
+	t.Require().Nil(claims)
+	t.Require().ErrorIs(err, errs.ErrInvalidAccessToken)
+}
@@ -0,0 +1,42 @@
+package session
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/principal"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+)
+
+// VerifyAccessToken checks access token signature, expiration and revocation and returns its claims.
+// API keys are accepted in place of access tokens. The token the request was already authenticated
+// with is not verified again.
+func (s *service) VerifyAccessToken(ctx context.Context, accessToken string) (*model.UserClaims, error) {
+	if claims, ok := principal.ClaimsOf(ctx, accessToken); ok {
+		return claims, nil
+	}
+
+	if token.IsAPIKey(accessToken) {
+		return s.verifyAPIKey(ctx, accessToken)
+	}
+
+	claims, err := token.VerifyWithKeys(accessToken, token.TypeAccess, func(keyID string) (*model.SigningKey, error) {
+		return s.signingKeyService.GetVerificationKey(ctx, keyID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("verify access token: %w", errs.ErrInvalidAccessToken)
+	}
+
+	revoked, err := s.denylist.Contains(ctx, claims.ID)
+	if err != nil {
+		return nil, fmt.Errorf("check access token denylist: %w", err)
+	}
+
+	if revoked {
+		return nil, fmt.Errorf("access token revoked: %w", errs.ErrInvalidAccessToken)
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,29 @@
+package signingkey
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// GetSigningKey returns the latest key to start signing new tokens, keys published ahead are skipped.
+func (s *service) GetSigningKey(ctx context.Context) (*model.SigningKey, error) {
+	keys := s.cached(s.isSigning(time.Now()))
+	if len(keys) == 0 {
+		err := s.load(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = s.cached(s.isSigning(time.Now()))
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("get signing key: %w", errs.ErrSigningKeyNotFound)
+	}
+
+	return keys[0], nil
+}
@@ -0,0 +1,55 @@
+package signingkey
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// GetVerificationKey returns not expired key by id.
+// Keys are reloaded when id is unknown, since another instance may have rotated them.
+func (s *service) GetVerificationKey(ctx context.Context, id string) (*model.SigningKey, error) {
+	if key := s.findVerificationKey(id); key != nil {
+		return key, nil
+	}
+
+	s.mu.RLock()
+	loadedAt := s.loadedAt
+	s.mu.RUnlock()
+
+	if time.Since(loadedAt) < minReloadInterval {
+		return nil, fmt.Errorf("get verification key %s: %w", id, errs.ErrSigningKeyNotFound)
+	}
+
+	err := s.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if key := s.findVerificationKey(id); key != nil {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("get verification key %s: %w", id, errs.ErrSigningKeyNotFound)
+}
+
+// GetVerificationKeys returns all not expired keys.
+func (s *service) GetVerificationKeys(_ context.Context) ([]*model.SigningKey, error) {
+	return s.cached(isVerifying(time.Now())), nil
+}
+
+func (s *service) findVerificationKey(id string) *model.SigningKey {
+	verifying := isVerifying(time.Now())
+
+	keys := s.cached(func(key *model.SigningKey) bool {
+		return key.ID == id && verifying(key)
+	})
+	if len(keys) == 0 {
+		return nil
+	}
+
+	return keys[0]
+}
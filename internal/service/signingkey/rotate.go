@@ -0,0 +1,98 @@
+package signingkey
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+)
+
+// Rotate reloads keys, generates the next signing key ahead of the end of the rotation period
+// of the current one and deletes keys which can no longer verify issued tokens.
+// The next key is published in JWKS for at least token.JWKSCacheTTL before it signs tokens,
+// so clients caching JWKS know it by then. The previous key stays available for verification
+// until every token it signed expires.
+func (s *service) Rotate(ctx context.Context) error {
+	err := s.load(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	if len(s.cached(s.isSigning(now))) == 0 {
+		// Without a signing key no token can be issued at all, so there is nothing to wait for.
+		err = s.generate(ctx, now)
+		if err != nil {
+			return err
+		}
+	} else if activatesAt, ok := s.nextActivation(now); ok {
+		err = s.generate(ctx, activatesAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.repo.DeleteExpired(ctx, now)
+	if err != nil {
+		return fmt.Errorf("delete expired signing keys: %w", err)
+	}
+
+	return s.load(ctx)
+}
+
+// nextActivation reports whether the next key is due and when it starts signing. It is due once
+// the latest key stops signing before another check could publish the next one in time.
+func (s *service) nextActivation(now time.Time) (time.Time, bool) {
+	keys := s.cached(isVerifying(now))
+	if len(keys) == 0 {
+		return time.Time{}, false
+	}
+
+	activatesAt := keys[0].ActivatesAt.Add(s.signingKeyConfig.GetRotationPeriod())
+	if now.Add(token.JWKSCacheTTL + s.signingKeyConfig.GetRotationCheckInterval()).Before(activatesAt) {
+		return time.Time{}, false
+	}
+
+	if published := now.Add(token.JWKSCacheTTL); activatesAt.Before(published) {
+		activatesAt = published
+	}
+
+	return activatesAt, true
+}
+
+// generate creates a new key which signs tokens from activatesAt for the rotation period.
+func (s *service) generate(ctx context.Context, activatesAt time.Time) error {
+	expiresAt := activatesAt.Add(s.signingKeyConfig.GetRotationPeriod() + s.maxTokenTTL())
+
+	key, err := token.GenerateKey(s.signingKeyConfig.GetAlgorithm(), expiresAt)
+	if err != nil {
+		return fmt.Errorf("generate signing key: %w", err)
+	}
+	key.ActivatesAt = activatesAt
+
+	err = s.repo.Create(ctx, key)
+	if err != nil {
+		return fmt.Errorf("create signing key: %w", err)
+	}
+
+	s.logger.Info("signing key rotated",
+		slog.String("kid", key.ID),
+		slog.String("algorithm", key.Algorithm),
+		slog.Time("activates_at", activatesAt),
+	)
+
+	return nil
+}
+
+// maxTokenTTL returns the longest lifetime of the tokens signed with keys, a key must verify every token it signed.
+func (s *service) maxTokenTTL() time.Duration {
+	return max(
+		s.tokenConfig.GetAccessTokenTTL(),
+		s.tokenConfig.GetImpersonationTokenTTL(),
+		s.oauthConfig.GetClientTokenTTL(),
+		s.oauthConfig.GetIDTokenTTL(),
+	)
+}
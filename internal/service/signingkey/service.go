@@ -0,0 +1,94 @@
+package signingkey
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository"
+	svc "github.com/Paul1k96/microservices_course_auth/internal/service"
+)
+
+// minReloadInterval limits how often an unknown key ID forces keys to be reloaded from the repository.
+const minReloadInterval = 10 * time.Second
+
+type service struct {
+	logger *slog.Logger
+
+	repo repository.SigningKeysRepository
+
+	signingKeyConfig config.SigningKeyConfig
+	tokenConfig      config.TokenConfig
+	oauthConfig      config.OAuthConfig
+
+	mu       sync.RWMutex
+	keys     []*model.SigningKey
+	loadedAt time.Time
+}
+
+// NewService creates a new signing key service.
+func NewService(
+	logger *slog.Logger,
+	repo repository.SigningKeysRepository,
+	signingKeyConfig config.SigningKeyConfig,
+	tokenConfig config.TokenConfig,
+	oauthConfig config.OAuthConfig,
+) svc.SigningKeyService {
+	return &service{
+		logger:           logger,
+		repo:             repo,
+		signingKeyConfig: signingKeyConfig,
+		tokenConfig:      tokenConfig,
+		oauthConfig:      oauthConfig,
+	}
+}
+
+// load replaces cached keys with active keys from the repository.
+func (s *service) load(ctx context.Context) error {
+	now := time.Now()
+
+	keys, err := s.repo.GetActive(ctx, now)
+	if err != nil {
+		return fmt.Errorf("get active signing keys: %w", err)
+	}
+
+	s.mu.Lock()
+	s.keys = keys
+	s.loadedAt = now
+	s.mu.Unlock()
+
+	return nil
+}
+
+// cached returns cached keys matching filter.
+func (s *service) cached(filter func(key *model.SigningKey) bool) []*model.SigningKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	res := make([]*model.SigningKey, 0, len(s.keys))
+	for _, key := range s.keys {
+		if filter(key) {
+			res = append(res, key)
+		}
+	}
+
+	return res
+}
+
+func (s *service) isSigning(now time.Time) func(key *model.SigningKey) bool {
+	return func(key *model.SigningKey) bool {
+		return !key.ActivatesAt.After(now) &&
+			key.ActivatesAt.Add(s.signingKeyConfig.GetRotationPeriod()).After(now) &&
+			key.ExpiresAt.After(now)
+	}
+}
+
+func isVerifying(now time.Time) func(key *model.SigningKey) bool {
+	return func(key *model.SigningKey) bool {
+		return key.ExpiresAt.After(now)
+	}
+}
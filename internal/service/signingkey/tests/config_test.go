@@ -0,0 +1,28 @@
+package tests
+
+import "time"
+
+type signingKeyConfig struct {
+	algorithm      string
+	rotationPeriod time.Duration
+}
+
+func (c *signingKeyConfig) GetAlgorithm() string                    { return c.algorithm }
+func (c *signingKeyConfig) GetRotationPeriod() time.Duration        { return c.rotationPeriod }
+func (c *signingKeyConfig) GetRotationCheckInterval() time.Duration { return time.Minute }
+func (c *signingKeyConfig) GetSecretKey() []byte                    { return []byte("signing_key_secret") }
+
+type tokenConfig struct{}
+
+func (c *tokenConfig) GetRefreshTokenSecretKey() []byte        { return nil }
+func (c *tokenConfig) GetAccessTokenTTL() time.Duration        { return 15 * time.Minute }
+func (c *tokenConfig) GetRefreshTokenTTL() time.Duration       { return 24 * time.Hour }
+func (c *tokenConfig) GetImpersonationTokenTTL() time.Duration { return 10 * time.Minute }
+
+type oauthConfig struct{}
+
+func (c *oauthConfig) GetClientTokenTTL() time.Duration       { return 30 * time.Minute }
+func (c *oauthConfig) GetIssuer() string                      { return "http://localhost:8080" }
+func (c *oauthConfig) GetLoginURL() string                    { return "http://localhost:8080/login" }
+func (c *oauthConfig) GetAuthorizationCodeTTL() time.Duration { return time.Minute }
+func (c *oauthConfig) GetIDTokenTTL() time.Duration           { return time.Hour }
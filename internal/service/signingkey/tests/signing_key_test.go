@@ -0,0 +1,190 @@
+package tests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	"github.com/Paul1k96/microservices_course_auth/internal/service/signingkey"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSigningKeySuite(t *testing.T) {
+	suite.Run(t, new(SigningKeySuite))
+}
+
+type SigningKeySuite struct {
+	suite.Suite
+	*require.Assertions
+	ctrl *gomock.Controller
+
+	repo   *mocks.MockSigningKeysRepository
+	config *signingKeyConfig
+
+	service service.SigningKeyService
+}
+
+func (t *SigningKeySuite) SetupTest() {
+	t.Assertions = require.New(t.T())
+	t.ctrl = gomock.NewController(t.T())
+
+	t.repo = mocks.NewMockSigningKeysRepository(t.ctrl)
+	t.config = &signingKeyConfig{
+		algorithm:      model.SigningAlgorithmEdDSA,
+		rotationPeriod: 24 * time.Hour,
+	}
+
+	t.service = signingkey.NewService(slog.Default(), t.repo, t.config, &tokenConfig{}, &oauthConfig{})
+}
+
+func (t *SigningKeySuite) TearDownTest() {
+	t.ctrl.Finish()
+}
+
+func (t *SigningKeySuite) TestRotate_NoKeys() {
+	ctx := context.Background()
+
+	var created *model.SigningKey
+
+	gomock.InOrder(
+		t.repo.EXPECT().GetActive(ctx, gomock.Any()).Return(nil, nil),
+		t.repo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, key *model.SigningKey) error {
+			created = key
+			return nil
+		}),
+		t.repo.EXPECT().DeleteExpired(ctx, gomock.Any()).Return(nil),
+		t.repo.EXPECT().GetActive(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ time.Time) ([]*model.SigningKey, error) {
+				return []*model.SigningKey{created}, nil
+			},
+		),
+	)
+
+	err := t.service.Rotate(ctx)
+	t.Require().NoError(err)
+	t.Require().Equal(model.SigningAlgorithmEdDSA, created.Algorithm)
+	t.Require().WithinDuration(time.Now().Add(24*time.Hour+time.Hour), created.ExpiresAt, time.Second)
+
+	key, err := t.service.GetSigningKey(ctx)
+	t.Require().NoError(err)
+	t.Require().Equal(created.ID, key.ID)
+}
+
+func (t *SigningKeySuite) TestRotate_CurrentKeyIsFresh() {
+	ctx := context.Background()
+	current := tm.NewSigningKey()
+
+	t.repo.EXPECT().GetActive(ctx, gomock.Any()).Return([]*model.SigningKey{current}, nil).Times(2)
+	t.repo.EXPECT().DeleteExpired(ctx, gomock.Any()).Return(nil)
+
+	err := t.service.Rotate(ctx)
+	t.Require().NoError(err)
+}
+
+func (t *SigningKeySuite) TestRotate_CurrentKeyIsOld() {
+	ctx := context.Background()
+	previous := tm.NewSigningKey()
+	previous.CreatedAt = time.Now().Add(-25 * time.Hour)
+	previous.ActivatesAt = previous.CreatedAt
+
+	t.repo.EXPECT().GetActive(ctx, gomock.Any()).Return([]*model.SigningKey{previous}, nil).Times(2)
+	t.repo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+	t.repo.EXPECT().DeleteExpired(ctx, gomock.Any()).Return(nil)
+
+	err := t.service.Rotate(ctx)
+	t.Require().NoError(err)
+
+	keys, err := t.service.GetVerificationKeys(ctx)
+	t.Require().NoError(err)
+	t.Require().Equal([]*model.SigningKey{previous}, keys)
+}
+
+func (t *SigningKeySuite) TestRotate_PublishesNextKeyAhead() {
+	ctx := context.Background()
+	current := tm.NewSigningKey()
+	current.ActivatesAt = time.Now().Add(-24*time.Hour + 5*time.Minute + 30*time.Second)
+	current.CreatedAt = current.ActivatesAt
+
+	var next *model.SigningKey
+
+	gomock.InOrder(
+		t.repo.EXPECT().GetActive(ctx, gomock.Any()).Return([]*model.SigningKey{current}, nil),
+		t.repo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, key *model.SigningKey) error {
+			next = key
+			return nil
+		}),
+		t.repo.EXPECT().DeleteExpired(ctx, gomock.Any()).Return(nil),
+		t.repo.EXPECT().GetActive(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ time.Time) ([]*model.SigningKey, error) {
+				return []*model.SigningKey{next, current}, nil
+			},
+		),
+	)
+
+	err := t.service.Rotate(ctx)
+	t.Require().NoError(err)
+	t.Require().Equal(current.ActivatesAt.Add(24*time.Hour), next.ActivatesAt)
+	t.Require().Equal(next.ActivatesAt.Add(24*time.Hour+time.Hour), next.ExpiresAt)
+
+	keys, err := t.service.GetVerificationKeys(ctx)
+	t.Require().NoError(err)
+	t.Require().Equal([]*model.SigningKey{next, current}, keys)
+
+	key, err := t.service.GetSigningKey(ctx)
+	t.Require().NoError(err)
+	t.Require().Equal(current.ID, key.ID)
+}
+
+func (t *SigningKeySuite) TestRotate_NextKeyIsLate() {
+	ctx := context.Background()
+	current := tm.NewSigningKey()
+	current.ActivatesAt = time.Now().Add(-24*time.Hour + time.Minute)
+	current.CreatedAt = current.ActivatesAt
+
+	var next *model.SigningKey
+
+	t.repo.EXPECT().GetActive(ctx, gomock.Any()).Return([]*model.SigningKey{current}, nil).Times(2)
+	t.repo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, key *model.SigningKey) error {
+		next = key
+		return nil
+	})
+	t.repo.EXPECT().DeleteExpired(ctx, gomock.Any()).Return(nil)
+
+	err := t.service.Rotate(ctx)
+	t.Require().NoError(err)
+	t.Require().WithinDuration(time.Now().Add(5*time.Minute), next.ActivatesAt, time.Second)
+}
+
+func (t *SigningKeySuite) TestGetSigningKey_NotFound() {
+	ctx := context.Background()
+
+	t.repo.EXPECT().GetActive(ctx, gomock.Any()).Return(nil, nil)
+
+	key, err := t.service.GetSigningKey(ctx)
+	t.Require().Nil(key)
+	t.Require().ErrorIs(err, errs.ErrSigningKeyNotFound)
+}
+
+func (t *SigningKeySuite) TestGetVerificationKey_ReloadsUnknownKey() {
+	ctx := context.Background()
+	key := tm.NewSigningKey()
+
+	t.repo.EXPECT().GetActive(ctx, gomock.Any()).Return([]*model.SigningKey{key}, nil)
+
+	res, err := t.service.GetVerificationKey(ctx, key.ID)
+	t.Require().NoError(err)
+	t.Require().Equal(key, res)
+
+	// keys were just reloaded, so an unknown id does not hit the repository again
+	res, err = t.service.GetVerificationKey(ctx, tm.NewSigningKey().ID)
+	t.Require().Nil(res)
+	t.Require().ErrorIs(err, errs.ErrSigningKeyNotFound)
+}
@@ -0,0 +1,43 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/principal"
+)
+
+// AddToOrg adds a member of the organization of the caller to another organization,
+// the caller must be allowed to manage users in it too. The user keeps the account
+// of their own organization and holds no role in the new one until it is set or assigned there.
+func (s *service) AddToOrg(ctx context.Context, id, orgID int64) error {
+	claims, ok := principal.FromContext(ctx)
+	if !ok {
+		return errs.ErrInvalidAccessToken
+	}
+
+	_, err := s.repo.GetByIDInOrg(ctx, claims.OrgID, id)
+	if err != nil {
+		return fmt.Errorf("get user by id: %w", err)
+	}
+
+	err = s.checkOrgManager(ctx, claims, orgID)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.AddMember(ctx, orgID, id)
+	if err != nil {
+		return fmt.Errorf("add organization member: %w", err)
+	}
+
+	err = s.events.Save(ctx, model.NewOrgMemberAddedEvent(principal.ActorID(ctx, id), id).WithOrgID(orgID))
+	if err != nil {
+		s.logger.Error("failed to save user event:", slog.String("error", err.Error()))
+	}
+
+	return nil
+}
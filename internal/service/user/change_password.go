@@ -0,0 +1,82 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// ChangePassword replaces password of the access token owner and revokes all of their other sessions.
+// Impersonated sessions may not change the password.
+func (s *service) ChangePassword(ctx context.Context, accessToken, oldPassword, newPassword string) error {
+	claims, err := s.sessionService.VerifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return fmt.Errorf("verify access token: %w", err)
+	}
+
+	if claims.IsImpersonated() {
+		return fmt.Errorf("change password as admin %d: %w", claims.ActorID, errs.ErrImpersonatedSession)
+	}
+
+	if txErr := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		user, err := s.repo.GetByID(ctx, claims.UserID)
+		if err != nil {
+			return fmt.Errorf("get user by id: %w", err)
+		}
+
+		ok, err := s.hasher.Verify(user.Password, oldPassword)
+		if err != nil {
+			return fmt.Errorf("verify password: %w", err)
+		}
+
+		if !ok {
+			return errs.ErrInvalidCredentials
+		}
+
+		violations := s.passwordPolicy.violations("new_password", newPassword, user)
+		if newPassword == oldPassword {
+			violations = append(violations, errs.FieldViolation{
+				Field:       "new_password",
+				Description: "must differ from the current password",
+			})
+		}
+
+		if len(violations) > 0 {
+			return errs.NewValidationError(errs.ErrWeakPassword, violations...)
+		}
+
+		passwordHash, err := s.hasher.Hash(newPassword)
+		if err != nil {
+			return fmt.Errorf("hash password: %w", err)
+		}
+
+		err = s.repo.UpdatePassword(ctx, user.ID, passwordHash)
+		if err != nil {
+			return fmt.Errorf("update password: %w", err)
+		}
+
+		err = s.sessionService.RevokeOtherSessions(ctx, claims)
+		if err != nil {
+			return fmt.Errorf("revoke other sessions: %w", err)
+		}
+
+		return nil
+	}); txErr != nil {
+		return fmt.Errorf("transaction error: %w", txErr)
+	}
+
+	err = s.cache.Delete(ctx, claims.UserID)
+	if err != nil {
+		s.logger.Error("failed to delete user from cache:", slog.String("error", err.Error()))
+	}
+
+	err = s.events.Save(ctx, model.NewPasswordChangedEvent(claims.UserID).WithOrgID(claims.OrgID))
+	if err != nil {
+		s.logger.Error("failed to save user event:", slog.String("error", err.Error()))
+	}
+
+	return nil
+}
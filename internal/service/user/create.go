@@ -6,34 +6,100 @@ import (
 	"log/slog"
 	"net/mail"
 
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
 	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/principal"
 )
 
-// Create creates a new user.
+// Create creates a new user and sends them an email verification link.
+// Users join the requested organization only if the caller may manage users in it,
+// users created anonymously or without an organization join the default one.
 func (s *service) Create(ctx context.Context, user *model.User) (int64, error) {
+	orgID, err := s.newUserOrgID(ctx, user.OrgID)
+	if err != nil {
+		return 0, fmt.Errorf("create user: %w", err)
+	}
+	user.OrgID = orgID
+
 	if err := s.validateUser(user); err != nil {
 		return 0, fmt.Errorf("create user: %w", err)
 	}
 
-	id, err := s.repo.Create(ctx, user)
-	if err != nil {
+	if err := s.passwordPolicy.Validate("password", user.Password, user); err != nil {
 		return 0, fmt.Errorf("create user: %w", err)
 	}
 
+	passwordHash, err := s.hasher.Hash(user.Password)
+	if err != nil {
+		return 0, fmt.Errorf("hash password: %w", err)
+	}
+	user.Password = passwordHash
+
+	var id int64
+	if txErr := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		id, err = s.repo.Create(ctx, user)
+		if err != nil {
+			return fmt.Errorf("create user: %w", err)
+		}
+
+		return nil
+	}); txErr != nil {
+		return 0, fmt.Errorf("transaction error: %w", txErr)
+	}
+
 	user.ID = id
-	err = s.cache.Set(ctx, user)
+	err = s.cache.Set(ctx, user.OrgID, user)
 	if err != nil {
 		s.logger.Error("failed to set user to cache:", slog.String("error", err.Error()))
 	}
 
-	err = s.events.Save(ctx, model.NewCreateUserEvent(id, user))
+	err = s.events.Save(ctx, model.NewCreateUserEvent(principal.ActorID(ctx, id), user).WithOrgID(user.OrgID))
 	if err != nil {
 		s.logger.Error("failed to save user event:", slog.String("error", err.Error()))
 	}
 
+	err = s.emailVerificationService.Send(ctx, user)
+	if err != nil {
+		s.logger.Error("failed to send email verification:", slog.String("error", err.Error()))
+	}
+
 	return id, nil
 }
 
+// newUserOrgID returns the organization a new user joins. Anonymous callers can't choose it,
+// while the others must be allowed to manage users in the organization they choose.
+func (s *service) newUserOrgID(ctx context.Context, orgID int64) (int64, error) {
+	claims, ok := principal.FromContext(ctx)
+	if !ok || orgID == 0 || orgID == model.DefaultOrgID {
+		return model.DefaultOrgID, nil
+	}
+
+	err := s.checkOrgManager(ctx, claims, orgID)
+	if err != nil {
+		return 0, err
+	}
+
+	return orgID, nil
+}
+
+// checkOrgManager checks the caller may manage users in the organization.
+func (s *service) checkOrgManager(ctx context.Context, claims *model.UserClaims, orgID int64) error {
+	granted, err := s.roleService.HasPermission(ctx, claims.UserID, orgID, model.PermissionManageUsers)
+	if err != nil {
+		return fmt.Errorf("check permission: %w", err)
+	}
+
+	if !granted {
+		return fmt.Errorf("manage users of organization %d as user %d: %w", orgID, claims.UserID, errs.ErrAccessDenied)
+	}
+
+	if !claims.MFA {
+		return fmt.Errorf("manage users of organization %d without mfa: %w", orgID, errs.ErrMFARequired)
+	}
+
+	return nil
+}
+
 func (s *service) validateUser(user *model.User) error {
 	err := s.validateUserName(user.Name)
 	if err != nil {
@@ -45,7 +111,7 @@ func (s *service) validateUser(user *model.User) error {
 		return fmt.Errorf("validate user: %w", err)
 	}
 
-	err = s.validateUserRole(user.Role)
+	err = s.validateUserRoles(user.Roles)
 	if err != nil {
 		return fmt.Errorf("validate user: %w", err)
 	}
@@ -94,10 +160,12 @@ func (s *service) checkUserEmailFormat(email string) error {
 	return nil
 }
 
-func (s *service) validateUserRole(role model.Role) error {
-	if role.IsARole() {
-		return nil
+func (s *service) validateUserRoles(roles []string) error {
+	for _, role := range roles {
+		if !model.IsBuiltInRole(role) {
+			return fmt.Errorf("role is not valid")
+		}
 	}
 
-	return fmt.Errorf("role is not valid")
+	return nil
 }
@@ -5,16 +5,33 @@ import (
 	"fmt"
 	"log/slog"
 
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
 	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/principal"
 )
 
-// Delete deletes user by id.
+// Delete deletes user by id if their account is owned by the organization of the caller
+// and revokes all of their tokens. Members added from other organizations cannot be deleted.
 func (s *service) Delete(ctx context.Context, id int64) error {
-	_, err := s.repo.GetByID(ctx, id)
+	claims, ok := principal.FromContext(ctx)
+	if !ok {
+		return errs.ErrInvalidAccessToken
+	}
+
+	user, err := s.repo.GetByIDInOrg(ctx, claims.OrgID, id)
 	if err != nil {
 		return fmt.Errorf("get user by id: %w", err)
 	}
 
+	if user.OrgID != claims.OrgID {
+		return fmt.Errorf("delete user of organization %d: %w", user.OrgID, errs.ErrAccessDenied)
+	}
+
+	err = s.sessionService.RevokeUserTokens(ctx, id)
+	if err != nil {
+		return fmt.Errorf("revoke user tokens: %w", err)
+	}
+
 	err = s.repo.Delete(ctx, id)
 	if err != nil {
 		return fmt.Errorf("delete user: %w", err)
@@ -25,8 +42,7 @@ func (s *service) Delete(ctx context.Context, id int64) error {
 		s.logger.Error("failed to delete user from cache:", slog.String("error", err.Error()))
 	}
 
-	// first argument must be user, which do delete
-	err = s.events.Save(ctx, model.NewDeleteUserEvent(id, id))
+	err = s.events.Save(ctx, model.NewDeleteUserEvent(principal.ActorID(ctx, id), id).WithOrgID(user.OrgID))
 	if err != nil {
 		s.logger.Error("failed to save user event:", slog.String("error", err.Error()))
 	}
@@ -0,0 +1,44 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// Get returns user by id if they are a member of the organization of the access token.
+func (s *service) Get(ctx context.Context, accessToken string, id int64) (*model.User, error) {
+	claims, err := s.sessionService.VerifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, fmt.Errorf("verify access token: %w", err)
+	}
+
+	user, err := s.cache.Get(ctx, claims.OrgID, id)
+	if err != nil && !errors.Is(err, errs.ErrUserNotFound) {
+		s.logger.Error("failed to get user from cache:", slog.String("error", err.Error()))
+	}
+
+	if user != nil {
+		return user, nil
+	}
+
+	user, err = s.repo.GetByIDInOrg(ctx, claims.OrgID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by id: %w", err)
+	}
+
+	if user == nil {
+		return nil, errs.ErrUserNotFound
+	}
+
+	err = s.cache.Set(ctx, claims.OrgID, user)
+	if err != nil {
+		s.logger.Error("failed to set user to cache:", slog.String("error", err.Error()))
+	}
+
+	return user, nil
+}
@@ -13,8 +13,13 @@ const (
 	groupLimit = 10
 )
 
-// GetListByIDs returns list of users by ids.
-func (s *service) GetListByIDs(ctx context.Context, ids []int64) ([]*model.User, error) {
+// GetListByIDs returns list of users by ids, users outside the organization of the access token are skipped.
+func (s *service) GetListByIDs(ctx context.Context, accessToken string, ids []int64) ([]*model.User, error) {
+	claims, err := s.sessionService.VerifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, fmt.Errorf("verify access token: %w", err)
+	}
+
 	notInCacheCh := make(chan int64, len(ids))
 	notInCache := make([]int64, 0, len(ids))
 
@@ -26,7 +31,7 @@ func (s *service) GetListByIDs(ctx context.Context, ids []int64) ([]*model.User,
 	for _, id := range ids {
 		id := id
 		errGroup.Go(func() error {
-			user, err := s.cache.Get(errCtx, id)
+			user, err := s.cache.Get(errCtx, claims.OrgID, id)
 			if err != nil {
 				notInCacheCh <- id
 				return nil
@@ -52,7 +57,7 @@ func (s *service) GetListByIDs(ctx context.Context, ids []int64) ([]*model.User,
 	}
 
 	if len(notInCache) > 0 {
-		users, err := s.repo.GetByIDs(ctx, notInCache)
+		users, err := s.repo.GetByIDsInOrg(ctx, claims.OrgID, notInCache)
 		if err != nil {
 			return nil, fmt.Errorf("get list by ids: %w", err)
 		}
@@ -60,7 +65,7 @@ func (s *service) GetListByIDs(ctx context.Context, ids []int64) ([]*model.User,
 		for _, user := range users {
 			user := user
 			errGroup.Go(func() error {
-				err = s.cache.Set(errCtx, user)
+				err = s.cache.Set(errCtx, claims.OrgID, user)
 				if err != nil {
 					s.logger.Error("failed to set user to cache:", slog.String("error", err.Error()))
 				}
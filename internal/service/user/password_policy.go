@@ -0,0 +1,168 @@
+package user
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/config"
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// minPersonalInfoLength is the shortest name part or email local part which is
+// looked for in passwords. Shorter ones would reject too many good passwords.
+const minPersonalInfoLength = 3
+
+// PasswordPolicy checks passwords against the configured rules.
+type PasswordPolicy struct {
+	minLength     int
+	maxLength     int
+	requireLower  bool
+	requireUpper  bool
+	requireDigit  bool
+	requireSymbol bool
+	breached      map[string]struct{}
+}
+
+// NewPasswordPolicy creates a new PasswordPolicy and loads the breached-password list from config.
+func NewPasswordPolicy(cfg config.PasswordPolicyConfig) (*PasswordPolicy, error) {
+	breached, err := loadBreachedPasswords(cfg.GetBreachedListPath())
+	if err != nil {
+		return nil, fmt.Errorf("load breached passwords: %w", err)
+	}
+
+	return &PasswordPolicy{
+		minLength:     cfg.GetMinLength(),
+		maxLength:     cfg.GetMaxLength(),
+		requireLower:  cfg.IsLowerRequired(),
+		requireUpper:  cfg.IsUpperRequired(),
+		requireDigit:  cfg.IsDigitRequired(),
+		requireSymbol: cfg.IsSymbolRequired(),
+		breached:      breached,
+	}, nil
+}
+
+// loadBreachedPasswords reads a newline separated password list. Passwords are
+// compared case-insensitively, so the list is stored lowercased.
+func loadBreachedPasswords(path string) (map[string]struct{}, error) {
+	breached := make(map[string]struct{})
+	if path == "" {
+		return breached, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open file: %w", err)
+	}
+	defer file.Close() // nolint: errcheck
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		breached[strings.ToLower(line)] = struct{}{}
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+
+	return breached, nil
+}
+
+// Validate checks password of the user. Every failed rule is reported as a
+// violation of field in errs.ValidationError wrapping errs.ErrWeakPassword.
+func (p *PasswordPolicy) Validate(field, password string, user *model.User) error {
+	violations := p.violations(field, password, user)
+	if len(violations) > 0 {
+		return errs.NewValidationError(errs.ErrWeakPassword, violations...)
+	}
+
+	return nil
+}
+
+func (p *PasswordPolicy) violations(field, password string, user *model.User) []errs.FieldViolation {
+	var descriptions []string
+
+	if utf8.RuneCountInString(password) < p.minLength {
+		descriptions = append(descriptions, fmt.Sprintf("must be at least %d characters long", p.minLength))
+	}
+
+	if len(password) > p.maxLength {
+		descriptions = append(descriptions, fmt.Sprintf("must be at most %d bytes long", p.maxLength))
+	}
+
+	var hasLower, hasUpper, hasDigit, hasSymbol bool
+	for _, char := range password {
+		switch {
+		case unicode.IsLower(char):
+			hasLower = true
+		case unicode.IsUpper(char):
+			hasUpper = true
+		case unicode.IsDigit(char):
+			hasDigit = true
+		case !unicode.IsLetter(char):
+			hasSymbol = true
+		}
+	}
+
+	if p.requireLower && !hasLower {
+		descriptions = append(descriptions, "must contain a lowercase letter")
+	}
+
+	if p.requireUpper && !hasUpper {
+		descriptions = append(descriptions, "must contain an uppercase letter")
+	}
+
+	if p.requireDigit && !hasDigit {
+		descriptions = append(descriptions, "must contain a digit")
+	}
+
+	if p.requireSymbol && !hasSymbol {
+		descriptions = append(descriptions, "must contain a symbol")
+	}
+
+	lowered := strings.ToLower(password)
+
+	if user != nil && containsNamePart(lowered, user.Name) {
+		descriptions = append(descriptions, "must not contain the user name")
+	}
+
+	if user != nil && containsEmail(lowered, user.Email) {
+		descriptions = append(descriptions, "must not contain the email")
+	}
+
+	if _, ok := p.breached[lowered]; ok {
+		descriptions = append(descriptions, "has appeared in a data breach")
+	}
+
+	violations := make([]errs.FieldViolation, 0, len(descriptions))
+	for _, description := range descriptions {
+		violations = append(violations, errs.FieldViolation{Field: field, Description: description})
+	}
+
+	return violations
+}
+
+func containsNamePart(password, name string) bool {
+	for _, part := range strings.Fields(strings.ToLower(name)) {
+		if utf8.RuneCountInString(part) >= minPersonalInfoLength && strings.Contains(password, part) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsEmail(password, email string) bool {
+	localPart, _, _ := strings.Cut(strings.ToLower(email), "@")
+
+	return utf8.RuneCountInString(localPart) >= minPersonalInfoLength && strings.Contains(password, localPart)
+}
@@ -0,0 +1,61 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// ResetPassword replaces password of the user without checking the current one
+// and revokes all of their sessions. The caller is responsible for proving the
+// right to do so.
+func (s *service) ResetPassword(ctx context.Context, id int64, newPassword string) error {
+	var orgID int64
+
+	if txErr := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		user, err := s.repo.GetByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get user by id: %w", err)
+		}
+
+		orgID = user.OrgID
+
+		err = s.passwordPolicy.Validate("new_password", newPassword, user)
+		if err != nil {
+			return fmt.Errorf("validate password: %w", err)
+		}
+
+		passwordHash, err := s.hasher.Hash(newPassword)
+		if err != nil {
+			return fmt.Errorf("hash password: %w", err)
+		}
+
+		err = s.repo.UpdatePassword(ctx, id, passwordHash)
+		if err != nil {
+			return fmt.Errorf("update password: %w", err)
+		}
+
+		err = s.sessionService.RevokeUserTokens(ctx, id)
+		if err != nil {
+			return fmt.Errorf("revoke user tokens: %w", err)
+		}
+
+		return nil
+	}); txErr != nil {
+		return fmt.Errorf("transaction error: %w", txErr)
+	}
+
+	err := s.cache.Delete(ctx, id)
+	if err != nil {
+		s.logger.Error("failed to delete user from cache:", slog.String("error", err.Error()))
+	}
+
+	err = s.events.Save(ctx, model.NewPasswordChangedEvent(id).WithOrgID(orgID))
+	if err != nil {
+		s.logger.Error("failed to save user event:", slog.String("error", err.Error()))
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package user
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+)
+
+// pageToken is the last user of a page along with the hash of the search, it is encoded into an opaque string.
+type pageToken struct {
+	Search    []byte    `json:"h"`
+	ID        int64     `json:"i"`
+	CreatedAt time.Time `json:"c"`
+	UpdatedAt time.Time `json:"u"`
+	Email     string    `json:"e"`
+}
+
+// searchKey is the part of a filter a page token is bound to, the page size and the position are not.
+type searchKey struct {
+	OrgID       int64               `json:"o"`
+	Role        string              `json:"r"`
+	CreatedFrom *time.Time          `json:"cf"`
+	CreatedTo   *time.Time          `json:"ct"`
+	UpdatedFrom *time.Time          `json:"uf"`
+	UpdatedTo   *time.Time          `json:"ut"`
+	EmailDomain string              `json:"e"`
+	SortBy      model.UserSortField `json:"s"`
+	Descending  bool                `json:"d"`
+}
+
+// Search returns a page of users of the organization of the access token matching the filter.
+// filter.Limit is the page size, it is capped at 100 and defaults to 20. A non-empty token continues
+// the search it was returned by and must be used with the same filter and sorting.
+func (s *service) Search(
+	ctx context.Context,
+	accessToken string,
+	filter *model.UserFilter,
+	token string,
+) (*model.UsersPage, error) {
+	claims, err := s.sessionService.VerifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, fmt.Errorf("verify access token: %w", err)
+	}
+
+	search := *filter
+	search.OrgID = claims.OrgID
+
+	pageSize := search.Limit
+	switch {
+	case pageSize == 0:
+		pageSize = defaultSearchPageSize
+	case pageSize > maxSearchPageSize:
+		pageSize = maxSearchPageSize
+	}
+
+	hash, err := searchHash(&search)
+	if err != nil {
+		return nil, err
+	}
+
+	if token != "" {
+		search.After, err = decodePageToken(token, hash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// One more user than the page holds tells whether there is a next page.
+	search.Limit = pageSize + 1
+
+	users, err := s.repo.Search(ctx, &search)
+	if err != nil {
+		return nil, fmt.Errorf("search users: %w", err)
+	}
+
+	page := &model.UsersPage{Users: users}
+	if uint64(len(users)) > pageSize {
+		page.Users = users[:pageSize]
+
+		page.NextPageToken, err = encodePageToken(page.Users[pageSize-1], hash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
+
+// searchHash returns the hash of the filter and sorting of a search. Times are compared as instants and
+// the email domain is matched case-insensitive, so they are normalized before hashing.
+func searchHash(filter *model.UserFilter) ([]byte, error) {
+	key := searchKey{
+		OrgID:       filter.OrgID,
+		Role:        filter.Role,
+		CreatedFrom: utc(filter.CreatedFrom),
+		CreatedTo:   utc(filter.CreatedTo),
+		UpdatedFrom: utc(filter.UpdatedFrom),
+		UpdatedTo:   utc(filter.UpdatedTo),
+		EmailDomain: strings.ToLower(filter.EmailDomain),
+		SortBy:      filter.SortBy,
+		Descending:  filter.Descending,
+	}
+
+	raw, err := json.Marshal(key)
+	if err != nil {
+		return nil, fmt.Errorf("hash search: %w", err)
+	}
+
+	sum := sha256.Sum256(raw)
+
+	return sum[:], nil
+}
+
+func utc(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	res := t.UTC()
+
+	return &res
+}
+
+func encodePageToken(user *model.User, hash []byte) (string, error) {
+	token := pageToken{
+		Search:    hash,
+		ID:        user.ID,
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.CreatedAt,
+		Email:     user.Email,
+	}
+
+	if user.UpdatedAt != nil {
+		token.UpdatedAt = *user.UpdatedAt
+	}
+
+	raw, err := json.Marshal(token)
+	if err != nil {
+		return "", fmt.Errorf("encode page token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodePageToken(encoded string, hash []byte) (*model.UserCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decode page token: %w", errs.ErrInvalidPageToken)
+	}
+
+	var token pageToken
+	if err = json.Unmarshal(raw, &token); err != nil {
+		return nil, fmt.Errorf("decode page token: %w", errs.ErrInvalidPageToken)
+	}
+
+	if subtle.ConstantTimeCompare(token.Search, hash) != 1 {
+		return nil, fmt.Errorf("page token of another search: %w", errs.ErrInvalidPageToken)
+	}
+
+	return &model.UserCursor{
+		ID:        token.ID,
+		CreatedAt: token.CreatedAt,
+		UpdatedAt: token.UpdatedAt,
+		Email:     token.Email,
+	}, nil
+}
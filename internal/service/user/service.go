@@ -3,6 +3,7 @@ package user
 import (
 	"log/slog"
 
+	"github.com/Paul1k96/microservices_course_auth/internal/password"
 	"github.com/Paul1k96/microservices_course_auth/internal/repository"
 	svc "github.com/Paul1k96/microservices_course_auth/internal/service"
 	"github.com/Paul1k96/microservices_course_platform_common/pkg/client/db"
@@ -15,6 +16,13 @@ type service struct {
 	repo   repository.UsersRepository
 	events repository.UserEventsRepository
 	cache  repository.UsersCache
+
+	hasher         password.Hasher
+	passwordPolicy *PasswordPolicy
+
+	sessionService           svc.SessionService
+	emailVerificationService svc.EmailVerificationService
+	roleService              svc.RoleService
 }
 
 // NewService creates a new service.
@@ -24,12 +32,22 @@ func NewService(
 	repo repository.UsersRepository,
 	events repository.UserEventsRepository,
 	cache repository.UsersCache,
+	hasher password.Hasher,
+	passwordPolicy *PasswordPolicy,
+	sessionService svc.SessionService,
+	emailVerificationService svc.EmailVerificationService,
+	roleService svc.RoleService,
 ) svc.UserService {
 	return &service{
-		logger:    logger,
-		txManager: txManager,
-		repo:      repo,
-		events:    events,
-		cache:     cache,
+		logger:                   logger,
+		txManager:                txManager,
+		repo:                     repo,
+		events:                   events,
+		cache:                    cache,
+		hasher:                   hasher,
+		passwordPolicy:           passwordPolicy,
+		sessionService:           sessionService,
+		emailVerificationService: emailVerificationService,
+		roleService:              roleService,
 	}
 }
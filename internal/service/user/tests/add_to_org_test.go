@@ -0,0 +1,129 @@
+package tests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	passwordMocks "github.com/Paul1k96/microservices_course_auth/internal/password/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/principal"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	svcMocks "github.com/Paul1k96/microservices_course_auth/internal/service/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service/user"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	infraMocks "github.com/Paul1k96/microservices_course_platform_common/pkg/client/db/transaction"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAddToOrgSuite(t *testing.T) {
+	suite.Run(t, new(AddToOrgSuite))
+}
+
+type AddToOrgSuite struct {
+	suite.Suite
+	*require.Assertions
+	ctrl *gomock.Controller
+
+	userRepo   *mocks.MockUsersRepository
+	userEvents *mocks.MockUserEventsRepository
+	roles      *svcMocks.MockRoleService
+
+	service service.UserService
+}
+
+func (t *AddToOrgSuite) SetupTest() {
+	t.Assertions = require.New(t.T())
+	t.ctrl = gomock.NewController(t.T())
+
+	t.userRepo = mocks.NewMockUsersRepository(t.ctrl)
+	t.userEvents = mocks.NewMockUserEventsRepository(t.ctrl)
+	t.roles = svcMocks.NewMockRoleService(t.ctrl)
+
+	t.service = user.NewService(
+		slog.Default(),
+		infraMocks.NewNopTxManager(),
+		t.userRepo,
+		t.userEvents,
+		mocks.NewMockUsersCache(t.ctrl),
+		passwordMocks.NewMockHasher(t.ctrl),
+		newPasswordPolicy(t.Assertions),
+		svcMocks.NewMockSessionService(t.ctrl),
+		svcMocks.NewMockEmailVerificationService(t.ctrl),
+		t.roles,
+	)
+}
+
+func (t *AddToOrgSuite) TearDownTest() {
+	t.ctrl.Finish()
+}
+
+func (t *AddToOrgSuite) callerContext(mfa bool) (context.Context, *model.UserClaims) {
+	claims := &model.UserClaims{ID: gofakeit.UUID(), UserID: gofakeit.Int64(), OrgID: model.DefaultOrgID, MFA: mfa}
+
+	return principal.NewContext(context.Background(), gofakeit.LetterN(64), claims), claims
+}
+
+func (t *AddToOrgSuite) TestAddToOrg_Ok() {
+	ctx, claims := t.callerContext(true)
+	usr := tm.NewUser()
+	orgID := int64(2)
+
+	t.userRepo.EXPECT().GetByIDInOrg(ctx, claims.OrgID, usr.ID).Return(usr, nil)
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, orgID, model.PermissionManageUsers).Return(true, nil)
+	t.userRepo.EXPECT().AddMember(ctx, orgID, usr.ID).Return(nil)
+	t.userEvents.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, event *model.UserEvent) error {
+			t.Equal(model.UserEventTypeOrgMemberAdded, event.Type)
+			t.Equal(claims.UserID, event.UserID)
+			t.Equal(usr.ID, event.EntityID)
+			t.Equal(orgID, event.OrgID)
+			return nil
+		},
+	)
+
+	err := t.service.AddToOrg(ctx, usr.ID, orgID)
+	t.NoError(err)
+}
+
+func (t *AddToOrgSuite) TestAddToOrg_UserOfAnotherOrg() {
+	ctx, claims := t.callerContext(true)
+	id := gofakeit.Int64()
+
+	t.userRepo.EXPECT().GetByIDInOrg(ctx, claims.OrgID, id).Return(nil, errs.ErrUserNotFound)
+
+	err := t.service.AddToOrg(ctx, id, 2)
+	t.ErrorIs(err, errs.ErrUserNotFound)
+}
+
+func (t *AddToOrgSuite) TestAddToOrg_OrgNotManagedByCaller() {
+	ctx, claims := t.callerContext(true)
+	usr := tm.NewUser()
+
+	t.userRepo.EXPECT().GetByIDInOrg(ctx, claims.OrgID, usr.ID).Return(usr, nil)
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, int64(2), model.PermissionManageUsers).Return(false, nil)
+
+	err := t.service.AddToOrg(ctx, usr.ID, 2)
+	t.ErrorIs(err, errs.ErrAccessDenied)
+}
+
+func (t *AddToOrgSuite) TestAddToOrg_WithoutMFA() {
+	ctx, claims := t.callerContext(false)
+	usr := tm.NewUser()
+
+	t.userRepo.EXPECT().GetByIDInOrg(ctx, claims.OrgID, usr.ID).Return(usr, nil)
+	t.roles.EXPECT().HasPermission(ctx, claims.UserID, int64(2), model.PermissionManageUsers).Return(true, nil)
+
+	err := t.service.AddToOrg(ctx, usr.ID, 2)
+	t.ErrorIs(err, errs.ErrMFARequired)
+}
+
+func (t *AddToOrgSuite) TestAddToOrg_NoCaller() {
+	err := t.service.AddToOrg(context.Background(), gofakeit.Int64(), 2)
+	t.ErrorIs(err, errs.ErrInvalidAccessToken)
+}
@@ -0,0 +1,213 @@
+package tests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	passwordMocks "github.com/Paul1k96/microservices_course_auth/internal/password/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	svcMocks "github.com/Paul1k96/microservices_course_auth/internal/service/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service/user"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	infraMocks "github.com/Paul1k96/microservices_course_platform_common/pkg/client/db/transaction"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestChangePasswordSuite(t *testing.T) {
+	suite.Run(t, new(ChangePasswordSuite))
+}
+
+type ChangePasswordSuite struct {
+	suite.Suite
+	*require.Assertions
+	ctrl *gomock.Controller
+
+	userRepo   *mocks.MockUsersRepository
+	userCache  *mocks.MockUsersCache
+	userEvents *mocks.MockUserEventsRepository
+	hasher     *passwordMocks.MockHasher
+	sessions   *svcMocks.MockSessionService
+	emailVerif *svcMocks.MockEmailVerificationService
+
+	service service.UserService
+}
+
+func (t *ChangePasswordSuite) SetupTest() {
+	t.Assertions = require.New(t.T())
+	t.ctrl = gomock.NewController(t.T())
+
+	t.userRepo = mocks.NewMockUsersRepository(t.ctrl)
+	t.userCache = mocks.NewMockUsersCache(t.ctrl)
+	t.userEvents = mocks.NewMockUserEventsRepository(t.ctrl)
+	t.hasher = passwordMocks.NewMockHasher(t.ctrl)
+	t.sessions = svcMocks.NewMockSessionService(t.ctrl)
+	t.emailVerif = svcMocks.NewMockEmailVerificationService(t.ctrl)
+
+	t.service = user.NewService(
+		slog.Default(),
+		infraMocks.NewNopTxManager(),
+		t.userRepo,
+		t.userEvents,
+		t.userCache,
+		t.hasher,
+		newPasswordPolicy(t.Assertions),
+		t.sessions,
+		t.emailVerif,
+		svcMocks.NewMockRoleService(t.ctrl),
+	)
+}
+
+func (t *ChangePasswordSuite) TearDownTest() {
+	t.ctrl.Finish()
+}
+
+func (t *ChangePasswordSuite) newClaims(usr *model.User) *model.UserClaims {
+	return &model.UserClaims{
+		ID:        gofakeit.UUID(),
+		UserID:    usr.ID,
+		OrgID:     usr.OrgID,
+		Roles:     usr.Roles,
+		ExpiresAt: time.Now().Add(time.Minute),
+	}
+}
+
+func (t *ChangePasswordSuite) TestChangePassword_Ok() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	claims := t.newClaims(usr)
+	accessToken := gofakeit.LetterN(64)
+	oldPassword := gofakeit.Password(true, true, true, true, false, 12)
+	newPassword := tm.NewPassword()
+	newHash := gofakeit.LetterN(60)
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+	t.hasher.EXPECT().Verify(usr.Password, oldPassword).Return(true, nil)
+	t.hasher.EXPECT().Hash(newPassword).Return(newHash, nil)
+	t.userRepo.EXPECT().UpdatePassword(ctx, usr.ID, newHash).Return(nil)
+	t.sessions.EXPECT().RevokeOtherSessions(ctx, claims).Return(nil)
+	t.userCache.EXPECT().Delete(ctx, usr.ID).Return(nil)
+	t.userEvents.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, event *model.UserEvent) error {
+			t.Require().Equal(model.UserEventTypePasswordChanged, event.Type)
+			t.Require().Equal(usr.ID, event.UserID)
+			t.Require().Equal(&model.PasswordChangedEventValue{}, event.Value)
+			t.Require().Nil(event.Value.Value())
+			return nil
+		},
+	)
+
+	err := t.service.ChangePassword(ctx, accessToken, oldPassword, newPassword)
+	t.Require().NoError(err)
+}
+
+func (t *ChangePasswordSuite) TestChangePassword_WrongOldPassword() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	claims := t.newClaims(usr)
+	accessToken := gofakeit.LetterN(64)
+	oldPassword := gofakeit.Password(true, true, true, true, false, 12)
+	newPassword := tm.NewPassword()
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+	t.userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+	t.hasher.EXPECT().Verify(usr.Password, oldPassword).Return(false, nil)
+
+	err := t.service.ChangePassword(ctx, accessToken, oldPassword, newPassword)
+	t.Require().ErrorIs(err, errs.ErrInvalidCredentials)
+}
+
+func (t *ChangePasswordSuite) TestChangePassword_WeakPassword() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	accessToken := gofakeit.LetterN(64)
+	oldPassword := tm.NewPassword()
+
+	tests := []struct {
+		name        string
+		newPassword string
+		violations  int
+	}{
+		{name: "too short", newPassword: "aA1" + gofakeit.LetterN(4), violations: 1},
+		{name: "too long", newPassword: "aA1" + gofakeit.LetterN(70), violations: 1},
+		{name: "same as old", newPassword: oldPassword, violations: 1},
+		{name: "several rules", newPassword: "abc", violations: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func() {
+			t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(t.newClaims(usr), nil)
+			t.userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+			t.hasher.EXPECT().Verify(usr.Password, oldPassword).Return(true, nil)
+
+			err := t.service.ChangePassword(ctx, accessToken, oldPassword, tt.newPassword)
+			t.Require().ErrorIs(err, errs.ErrWeakPassword)
+
+			var validationErr *errs.ValidationError
+			t.Require().ErrorAs(err, &validationErr)
+			t.Require().Len(validationErr.Violations, tt.violations)
+
+			for _, violation := range validationErr.Violations {
+				t.Require().Equal("new_password", violation.Field)
+			}
+		})
+	}
+}
+
+func (t *ChangePasswordSuite) TestChangePassword_InvalidAccessToken() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(nil, errs.ErrInvalidAccessToken)
+
+	err := t.service.ChangePassword(ctx, accessToken, gofakeit.LetterN(12), gofakeit.LetterN(12))
+	t.Require().ErrorIs(err, errs.ErrInvalidAccessToken)
+}
+
+func (t *ChangePasswordSuite) TestChangePassword_ImpersonatedSession() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+	claims := t.newClaims(tm.NewUser())
+	claims.ActorID = gofakeit.Int64()
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(claims, nil)
+
+	err := t.service.ChangePassword(ctx, accessToken, gofakeit.LetterN(12), gofakeit.LetterN(12))
+	t.Require().ErrorIs(err, errs.ErrImpersonatedSession)
+}
+
+func (t *ChangePasswordSuite) TestResetPassword_Ok() {
+	ctx := context.Background()
+	usr := tm.NewUser()
+	newPassword := tm.NewPassword()
+	newHash := gofakeit.LetterN(60)
+
+	t.userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+	t.hasher.EXPECT().Hash(newPassword).Return(newHash, nil)
+	t.userRepo.EXPECT().UpdatePassword(ctx, usr.ID, newHash).Return(nil)
+	t.sessions.EXPECT().RevokeUserTokens(ctx, usr.ID).Return(nil)
+	t.userCache.EXPECT().Delete(ctx, usr.ID).Return(nil)
+	t.userEvents.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+
+	err := t.service.ResetPassword(ctx, usr.ID, newPassword)
+	t.Require().NoError(err)
+}
+
+func (t *ChangePasswordSuite) TestResetPassword_WeakPassword() {
+	ctx := context.Background()
+
+	usr := tm.NewUser()
+
+	t.userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil)
+
+	err := t.service.ResetPassword(ctx, usr.ID, gofakeit.LetterN(4))
+	t.Require().ErrorIs(err, errs.ErrWeakPassword)
+}
@@ -0,0 +1,26 @@
+package tests
+
+import (
+	"github.com/Paul1k96/microservices_course_auth/internal/service/user"
+	"github.com/stretchr/testify/require"
+)
+
+type passwordPolicyConfig struct {
+	requireSymbol    bool
+	breachedListPath string
+}
+
+func (c *passwordPolicyConfig) GetMinLength() int           { return 8 }
+func (c *passwordPolicyConfig) GetMaxLength() int           { return 72 }
+func (c *passwordPolicyConfig) IsLowerRequired() bool       { return true }
+func (c *passwordPolicyConfig) IsUpperRequired() bool       { return true }
+func (c *passwordPolicyConfig) IsDigitRequired() bool       { return true }
+func (c *passwordPolicyConfig) IsSymbolRequired() bool      { return c.requireSymbol }
+func (c *passwordPolicyConfig) GetBreachedListPath() string { return c.breachedListPath }
+
+func newPasswordPolicy(r *require.Assertions) *user.PasswordPolicy {
+	policy, err := user.NewPasswordPolicy(&passwordPolicyConfig{})
+	r.NoError(err)
+
+	return policy
+}
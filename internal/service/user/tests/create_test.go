@@ -5,9 +5,13 @@ import (
 	"log/slog"
 	"testing"
 
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
 	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	passwordMocks "github.com/Paul1k96/microservices_course_auth/internal/password/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/principal"
 	"github.com/Paul1k96/microservices_course_auth/internal/repository/mocks"
 	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	svcMocks "github.com/Paul1k96/microservices_course_auth/internal/service/mocks"
 	"github.com/Paul1k96/microservices_course_auth/internal/service/user"
 	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
 	infraMocks "github.com/Paul1k96/microservices_course_platform_common/pkg/client/db/transaction"
@@ -30,6 +34,10 @@ type CreateUserSuite struct {
 	userRepo   *mocks.MockUsersRepository
 	userCache  *mocks.MockUsersCache
 	userEvents *mocks.MockUserEventsRepository
+	hasher     *passwordMocks.MockHasher
+	sessions   *svcMocks.MockSessionService
+	emailVerif *svcMocks.MockEmailVerificationService
+	roles      *svcMocks.MockRoleService
 
 	service service.UserService
 }
@@ -41,8 +49,23 @@ func (t *CreateUserSuite) SetupTest() {
 	t.userRepo = mocks.NewMockUsersRepository(t.ctrl)
 	t.userCache = mocks.NewMockUsersCache(t.ctrl)
 	t.userEvents = mocks.NewMockUserEventsRepository(t.ctrl)
-
-	t.service = user.NewService(slog.Default(), infraMocks.NewNopTxManager(), t.userRepo, t.userEvents, t.userCache)
+	t.hasher = passwordMocks.NewMockHasher(t.ctrl)
+	t.sessions = svcMocks.NewMockSessionService(t.ctrl)
+	t.emailVerif = svcMocks.NewMockEmailVerificationService(t.ctrl)
+	t.roles = svcMocks.NewMockRoleService(t.ctrl)
+
+	t.service = user.NewService(
+		slog.Default(),
+		infraMocks.NewNopTxManager(),
+		t.userRepo,
+		t.userEvents,
+		t.userCache,
+		t.hasher,
+		newPasswordPolicy(t.Assertions),
+		t.sessions,
+		t.emailVerif,
+		t.roles,
+	)
 }
 
 func (t *CreateUserSuite) TearDownTest() {
@@ -82,13 +105,16 @@ func (t *CreateUserSuite) TestCreateUser_Ok() {
 		err: nil,
 	}
 
+	t.hasher.EXPECT().Hash(args.user.Password).Return(gofakeit.Password(true, true, true, true, false, 60), nil)
 	t.userRepo.EXPECT().Create(args.ctx, args.user).Return(want.id, want.err)
 	args.user.ID = want.id
 
-	t.userCache.EXPECT().Set(args.ctx, args.user).Return(nil)
+	t.userCache.EXPECT().Set(args.ctx, model.DefaultOrgID, args.user).Return(nil)
 
 	t.userEvents.EXPECT().Save(args.ctx, gomock.Any())
 
+	t.emailVerif.EXPECT().Send(args.ctx, args.user).Return(nil)
+
 	t.do(args, want)
 }
 
@@ -127,7 +153,7 @@ func (t *CreateUserSuite) TestCreateUser_RoleInvalid() {
 		ctx:  context.Background(),
 		user: tm.NewUser(),
 	}
-	args.user.Role = 100
+	args.user.Roles = []string{"SUPERUSER"}
 
 	want := CreateUserWant{
 		id:  0,
@@ -137,6 +163,21 @@ func (t *CreateUserSuite) TestCreateUser_RoleInvalid() {
 	t.do(args, want)
 }
 
+func (t *CreateUserSuite) TestCreateUser_WeakPassword() {
+	args := CreateUserArgs{
+		ctx:  context.Background(),
+		user: tm.NewUser(),
+	}
+	args.user.Password = "short"
+
+	want := CreateUserWant{
+		id:  0,
+		err: errs.ErrWeakPassword,
+	}
+
+	t.do(args, want)
+}
+
 func (t *CreateUserSuite) TestCreateUser_RepoError() {
 	args := CreateUserArgs{
 		ctx:  context.Background(),
@@ -148,7 +189,75 @@ func (t *CreateUserSuite) TestCreateUser_RepoError() {
 		err: errors.New("repo error"),
 	}
 
+	t.hasher.EXPECT().Hash(args.user.Password).Return(gofakeit.Password(true, true, true, true, false, 60), nil)
 	t.userRepo.EXPECT().Create(args.ctx, args.user).Return(int64(0), want.err)
 
 	t.do(args, want)
 }
+
+func (t *CreateUserSuite) TestCreateUser_HashError() {
+	args := CreateUserArgs{
+		ctx:  context.Background(),
+		user: tm.NewUser(),
+	}
+
+	want := CreateUserWant{
+		id:  0,
+		err: errors.New("hash error"),
+	}
+
+	t.hasher.EXPECT().Hash(args.user.Password).Return("", want.err)
+
+	t.do(args, want)
+}
+
+func (t *CreateUserSuite) expectCreated(ctx context.Context, usr *model.User, orgID, id int64) {
+	t.hasher.EXPECT().Hash(usr.Password).Return(gofakeit.Password(true, true, true, true, false, 60), nil)
+	t.userRepo.EXPECT().Create(ctx, usr).Return(id, nil)
+	t.userCache.EXPECT().Set(ctx, orgID, usr).Return(nil)
+	t.userEvents.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+	t.emailVerif.EXPECT().Send(ctx, usr).Return(nil)
+}
+
+func (t *CreateUserSuite) TestCreateUser_AnonymousIgnoresOrg() {
+	args := CreateUserArgs{
+		ctx:  context.Background(),
+		user: tm.NewUser(),
+	}
+	args.user.OrgID = model.DefaultOrgID + 1
+
+	t.expectCreated(args.ctx, args.user, model.DefaultOrgID, 1)
+
+	t.do(args, CreateUserWant{id: 1})
+	t.Require().Equal(model.DefaultOrgID, args.user.OrgID)
+}
+
+func (t *CreateUserSuite) TestCreateUser_OkInOrgOfManager() {
+	orgID := model.DefaultOrgID + 1
+	claims := &model.UserClaims{UserID: gofakeit.Int64(), OrgID: orgID, MFA: true}
+	args := CreateUserArgs{
+		ctx:  principal.NewContext(context.Background(), gofakeit.LetterN(64), claims),
+		user: tm.NewUser(),
+	}
+	args.user.OrgID = orgID
+
+	t.roles.EXPECT().HasPermission(args.ctx, claims.UserID, orgID, model.PermissionManageUsers).Return(true, nil)
+	t.expectCreated(args.ctx, args.user, orgID, 1)
+
+	t.do(args, CreateUserWant{id: 1})
+	t.Require().Equal(orgID, args.user.OrgID)
+}
+
+func (t *CreateUserSuite) TestCreateUser_OrgNotManagedByCaller() {
+	orgID := model.DefaultOrgID + 1
+	claims := &model.UserClaims{UserID: gofakeit.Int64(), OrgID: model.DefaultOrgID, MFA: true}
+	args := CreateUserArgs{
+		ctx:  principal.NewContext(context.Background(), gofakeit.LetterN(64), claims),
+		user: tm.NewUser(),
+	}
+	args.user.OrgID = orgID
+
+	t.roles.EXPECT().HasPermission(args.ctx, claims.UserID, orgID, model.PermissionManageUsers).Return(false, nil)
+
+	t.do(args, CreateUserWant{err: errs.ErrAccessDenied})
+}
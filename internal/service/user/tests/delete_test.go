@@ -5,8 +5,13 @@ import (
 	"log/slog"
 	"testing"
 
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	passwordMocks "github.com/Paul1k96/microservices_course_auth/internal/password/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/principal"
 	"github.com/Paul1k96/microservices_course_auth/internal/repository/mocks"
 	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	svcMocks "github.com/Paul1k96/microservices_course_auth/internal/service/mocks"
 	"github.com/Paul1k96/microservices_course_auth/internal/service/user"
 	infraMocks "github.com/Paul1k96/microservices_course_platform_common/pkg/client/db/transaction"
 	"github.com/brianvoe/gofakeit/v7"
@@ -27,6 +32,9 @@ type DeleteUserSuite struct {
 	userRepo   *mocks.MockUsersRepository
 	userCache  *mocks.MockUsersCache
 	userEvents *mocks.MockUserEventsRepository
+	hasher     *passwordMocks.MockHasher
+	sessions   *svcMocks.MockSessionService
+	emailVerif *svcMocks.MockEmailVerificationService
 
 	service service.UserService
 }
@@ -38,14 +46,34 @@ func (t *DeleteUserSuite) SetupTest() {
 	t.userRepo = mocks.NewMockUsersRepository(t.ctrl)
 	t.userCache = mocks.NewMockUsersCache(t.ctrl)
 	t.userEvents = mocks.NewMockUserEventsRepository(t.ctrl)
-
-	t.service = user.NewService(slog.Default(), infraMocks.NewNopTxManager(), t.userRepo, t.userEvents, t.userCache)
+	t.hasher = passwordMocks.NewMockHasher(t.ctrl)
+	t.sessions = svcMocks.NewMockSessionService(t.ctrl)
+	t.emailVerif = svcMocks.NewMockEmailVerificationService(t.ctrl)
+
+	t.service = user.NewService(
+		slog.Default(),
+		infraMocks.NewNopTxManager(),
+		t.userRepo,
+		t.userEvents,
+		t.userCache,
+		t.hasher,
+		newPasswordPolicy(t.Assertions),
+		t.sessions,
+		t.emailVerif,
+		svcMocks.NewMockRoleService(t.ctrl),
+	)
 }
 
 func (t *DeleteUserSuite) TearDownTest() {
 	t.ctrl.Finish()
 }
 
+func (t *DeleteUserSuite) callerContext(orgID int64) context.Context {
+	claims := &model.UserClaims{ID: gofakeit.UUID(), UserID: gofakeit.Int64(), OrgID: orgID}
+
+	return principal.NewContext(context.Background(), gofakeit.LetterN(64), claims)
+}
+
 type DeleteUserArgs struct {
 	ctx context.Context
 	id  int64
@@ -67,13 +95,14 @@ func (t *DeleteUserSuite) do(args DeleteUserArgs, want DeleteUserWant) {
 
 func (t *DeleteUserSuite) TestDeleteUser_Ok() {
 	args := DeleteUserArgs{
-		ctx: context.Background(),
+		ctx: t.callerContext(model.DefaultOrgID),
 		id:  gofakeit.Int64(),
 	}
 
 	want := DeleteUserWant{}
 
-	t.userRepo.EXPECT().GetByID(args.ctx, args.id).Return(nil, nil)
+	t.userRepo.EXPECT().GetByIDInOrg(args.ctx, model.DefaultOrgID, args.id).Return(&model.User{ID: args.id, OrgID: model.DefaultOrgID}, nil)
+	t.sessions.EXPECT().RevokeUserTokens(args.ctx, args.id).Return(nil)
 
 	t.userRepo.EXPECT().Delete(args.ctx, args.id).Return(nil)
 	t.userCache.EXPECT().Delete(args.ctx, args.id).Return(nil)
@@ -83,9 +112,33 @@ func (t *DeleteUserSuite) TestDeleteUser_Ok() {
 	t.do(args, want)
 }
 
+func (t *DeleteUserSuite) TestDeleteUser_RecordsActor() {
+	id := gofakeit.Int64()
+	claims := &model.UserClaims{UserID: gofakeit.Int64(), OrgID: model.DefaultOrgID, Roles: []string{model.RoleAdmin}}
+	args := DeleteUserArgs{
+		ctx: principal.NewContext(context.Background(), gofakeit.LetterN(64), claims),
+		id:  id,
+	}
+
+	t.userRepo.EXPECT().GetByIDInOrg(args.ctx, model.DefaultOrgID, id).Return(&model.User{ID: id, OrgID: model.DefaultOrgID}, nil)
+	t.sessions.EXPECT().RevokeUserTokens(args.ctx, id).Return(nil)
+	t.userRepo.EXPECT().Delete(args.ctx, id).Return(nil)
+	t.userCache.EXPECT().Delete(args.ctx, id).Return(nil)
+	t.userEvents.EXPECT().Save(args.ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, event *model.UserEvent) error {
+			t.Require().Equal(claims.UserID, event.UserID)
+			t.Require().Equal(id, event.EntityID)
+			t.Require().Equal(model.UserEventTypeDeleteUser, event.Type)
+			return nil
+		},
+	)
+
+	t.do(args, DeleteUserWant{})
+}
+
 func (t *DeleteUserSuite) TestDeleteUser_RepoError() {
 	args := DeleteUserArgs{
-		ctx: context.Background(),
+		ctx: t.callerContext(model.DefaultOrgID),
 		id:  gofakeit.Int64(),
 	}
 
@@ -93,9 +146,58 @@ func (t *DeleteUserSuite) TestDeleteUser_RepoError() {
 		err: gofakeit.Error(),
 	}
 
-	t.userRepo.EXPECT().GetByID(args.ctx, args.id).Return(nil, nil)
+	t.userRepo.EXPECT().GetByIDInOrg(args.ctx, model.DefaultOrgID, args.id).Return(&model.User{ID: args.id, OrgID: model.DefaultOrgID}, nil)
+	t.sessions.EXPECT().RevokeUserTokens(args.ctx, args.id).Return(nil)
 
 	t.userRepo.EXPECT().Delete(args.ctx, args.id).Return(want.err)
 
 	t.do(args, want)
 }
+
+func (t *DeleteUserSuite) TestDeleteUser_RevokeTokensError() {
+	args := DeleteUserArgs{
+		ctx: t.callerContext(model.DefaultOrgID),
+		id:  gofakeit.Int64(),
+	}
+
+	want := DeleteUserWant{
+		err: gofakeit.Error(),
+	}
+
+	t.userRepo.EXPECT().GetByIDInOrg(args.ctx, model.DefaultOrgID, args.id).Return(&model.User{ID: args.id, OrgID: model.DefaultOrgID}, nil)
+	t.sessions.EXPECT().RevokeUserTokens(args.ctx, args.id).Return(want.err)
+
+	t.do(args, want)
+}
+
+func (t *DeleteUserSuite) TestDeleteUser_UserOfAnotherOrg() {
+	orgID := model.DefaultOrgID + 1
+	args := DeleteUserArgs{
+		ctx: t.callerContext(orgID),
+		id:  gofakeit.Int64(),
+	}
+
+	want := DeleteUserWant{
+		err: errs.ErrUserNotFound,
+	}
+
+	t.userRepo.EXPECT().GetByIDInOrg(args.ctx, orgID, args.id).Return(nil, errs.ErrUserNotFound)
+
+	t.do(args, want)
+}
+
+func (t *DeleteUserSuite) TestDeleteUser_MemberFromAnotherOrg() {
+	orgID := model.DefaultOrgID + 1
+	args := DeleteUserArgs{
+		ctx: t.callerContext(orgID),
+		id:  gofakeit.Int64(),
+	}
+
+	want := DeleteUserWant{
+		err: errs.ErrAccessDenied,
+	}
+
+	t.userRepo.EXPECT().GetByIDInOrg(args.ctx, orgID, args.id).Return(&model.User{ID: args.id, OrgID: model.DefaultOrgID}, nil)
+
+	t.do(args, want)
+}
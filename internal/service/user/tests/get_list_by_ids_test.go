@@ -6,8 +6,10 @@ import (
 	"testing"
 
 	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	passwordMocks "github.com/Paul1k96/microservices_course_auth/internal/password/mocks"
 	"github.com/Paul1k96/microservices_course_auth/internal/repository/mocks"
 	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	svcMocks "github.com/Paul1k96/microservices_course_auth/internal/service/mocks"
 	"github.com/Paul1k96/microservices_course_auth/internal/service/user"
 	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
 	infraMocks "github.com/Paul1k96/microservices_course_platform_common/pkg/client/db/transaction"
@@ -27,9 +29,12 @@ type GetListByIDsSuite struct {
 	*require.Assertions
 	ctrl *gomock.Controller
 
-	userRepo  *mocks.MockUsersRepository
-	userCache *mocks.MockUsersCache
-	eventRepo *mocks.MockUserEventsRepository
+	userRepo   *mocks.MockUsersRepository
+	userCache  *mocks.MockUsersCache
+	eventRepo  *mocks.MockUserEventsRepository
+	hasher     *passwordMocks.MockHasher
+	sessions   *svcMocks.MockSessionService
+	emailVerif *svcMocks.MockEmailVerificationService
 
 	service service.UserService
 }
@@ -41,17 +46,41 @@ func (t *GetListByIDsSuite) SetupTest() {
 	t.userRepo = mocks.NewMockUsersRepository(t.ctrl)
 	t.userCache = mocks.NewMockUsersCache(t.ctrl)
 	t.eventRepo = mocks.NewMockUserEventsRepository(t.ctrl)
-
-	t.service = user.NewService(slog.Default(), infraMocks.NewNopTxManager(), t.userRepo, t.eventRepo, t.userCache)
+	t.hasher = passwordMocks.NewMockHasher(t.ctrl)
+	t.sessions = svcMocks.NewMockSessionService(t.ctrl)
+	t.emailVerif = svcMocks.NewMockEmailVerificationService(t.ctrl)
+
+	t.service = user.NewService(
+		slog.Default(),
+		infraMocks.NewNopTxManager(),
+		t.userRepo,
+		t.eventRepo,
+		t.userCache,
+		t.hasher,
+		newPasswordPolicy(t.Assertions),
+		t.sessions,
+		t.emailVerif,
+		svcMocks.NewMockRoleService(t.ctrl),
+	)
 }
 
 func (t *GetListByIDsSuite) TearDownTest() {
 	t.ctrl.Finish()
 }
 
+func (t *GetListByIDsSuite) accessToken() string {
+	accessToken := gofakeit.LetterN(64)
+	claims := &model.UserClaims{ID: gofakeit.UUID(), UserID: gofakeit.Int64(), OrgID: model.DefaultOrgID}
+
+	t.sessions.EXPECT().VerifyAccessToken(gomock.Any(), accessToken).Return(claims, nil)
+
+	return accessToken
+}
+
 type GetListByIDsArgs struct {
-	ctx context.Context
-	ids []int64
+	ctx         context.Context
+	accessToken string
+	ids         []int64
 }
 
 type GetListByIDsWant struct {
@@ -60,7 +89,7 @@ type GetListByIDsWant struct {
 }
 
 func (t *GetListByIDsSuite) do(args GetListByIDsArgs, want GetListByIDsWant) {
-	usr, err := t.service.GetListByIDs(args.ctx, args.ids)
+	usr, err := t.service.GetListByIDs(args.ctx, args.accessToken, args.ids)
 
 	t.Require().ElementsMatch(want.user, usr)
 
@@ -75,8 +104,9 @@ func (t *GetListByIDsSuite) TestGetUser_OkFromCache() {
 	usrs := tm.NewUsers(3)
 
 	args := GetListByIDsArgs{
-		ctx: context.Background(),
-		ids: []int64{usrs[0].ID, usrs[1].ID, usrs[2].ID},
+		ctx:         context.Background(),
+		accessToken: t.accessToken(),
+		ids:         []int64{usrs[0].ID, usrs[1].ID, usrs[2].ID},
 	}
 	want := GetListByIDsWant{
 		user: usrs,
@@ -84,9 +114,9 @@ func (t *GetListByIDsSuite) TestGetUser_OkFromCache() {
 	}
 
 	_, errCtx := errgroup.WithContext(args.ctx)
-	t.userCache.EXPECT().Get(errCtx, usrs[0].ID).Return(usrs[0], nil)
-	t.userCache.EXPECT().Get(errCtx, usrs[1].ID).Return(usrs[1], nil)
-	t.userCache.EXPECT().Get(errCtx, usrs[2].ID).Return(usrs[2], nil)
+	t.userCache.EXPECT().Get(errCtx, model.DefaultOrgID, usrs[0].ID).Return(usrs[0], nil)
+	t.userCache.EXPECT().Get(errCtx, model.DefaultOrgID, usrs[1].ID).Return(usrs[1], nil)
+	t.userCache.EXPECT().Get(errCtx, model.DefaultOrgID, usrs[2].ID).Return(usrs[2], nil)
 
 	t.do(args, want)
 }
@@ -95,8 +125,9 @@ func (t *GetListByIDsSuite) TestGetUser_OkFromRepoAndSetCacheAll() {
 	usrs := tm.NewUsers(3)
 
 	args := GetListByIDsArgs{
-		ctx: context.Background(),
-		ids: []int64{usrs[0].ID, usrs[1].ID, usrs[2].ID},
+		ctx:         context.Background(),
+		accessToken: t.accessToken(),
+		ids:         []int64{usrs[0].ID, usrs[1].ID, usrs[2].ID},
 	}
 	want := GetListByIDsWant{
 		user: usrs,
@@ -105,17 +136,18 @@ func (t *GetListByIDsSuite) TestGetUser_OkFromRepoAndSetCacheAll() {
 
 	_, errCtx := errgroup.WithContext(args.ctx)
 
-	t.userCache.EXPECT().Get(errCtx, usrs[0].ID).Return(nil, gofakeit.Error())
-	t.userCache.EXPECT().Get(errCtx, usrs[1].ID).Return(nil, gofakeit.Error())
-	t.userCache.EXPECT().Get(errCtx, usrs[2].ID).Return(nil, gofakeit.Error())
+	t.userCache.EXPECT().Get(errCtx, model.DefaultOrgID, usrs[0].ID).Return(nil, gofakeit.Error())
+	t.userCache.EXPECT().Get(errCtx, model.DefaultOrgID, usrs[1].ID).Return(nil, gofakeit.Error())
+	t.userCache.EXPECT().Get(errCtx, model.DefaultOrgID, usrs[2].ID).Return(nil, gofakeit.Error())
 
-	t.userRepo.EXPECT().GetByIDs(
+	t.userRepo.EXPECT().GetByIDsInOrg(
 		args.ctx,
+		model.DefaultOrgID,
 		gomock.InAnyOrder([]int64{usrs[0].ID, usrs[1].ID, usrs[2].ID})).Return(usrs, nil)
 
-	t.userCache.EXPECT().Set(gomock.Any(), usrs[0]).Return(nil)
-	t.userCache.EXPECT().Set(gomock.Any(), usrs[1]).Return(nil)
-	t.userCache.EXPECT().Set(gomock.Any(), usrs[2]).Return(nil)
+	t.userCache.EXPECT().Set(gomock.Any(), model.DefaultOrgID, usrs[0]).Return(nil)
+	t.userCache.EXPECT().Set(gomock.Any(), model.DefaultOrgID, usrs[1]).Return(nil)
+	t.userCache.EXPECT().Set(gomock.Any(), model.DefaultOrgID, usrs[2]).Return(nil)
 
 	t.do(args, want)
 }
@@ -124,8 +156,9 @@ func (t *GetListByIDsSuite) TestGetUser_OkFromRepoAndSetCachePartially() {
 	usrs := tm.NewUsers(3)
 
 	args := GetListByIDsArgs{
-		ctx: context.Background(),
-		ids: []int64{usrs[0].ID, usrs[1].ID, usrs[2].ID},
+		ctx:         context.Background(),
+		accessToken: t.accessToken(),
+		ids:         []int64{usrs[0].ID, usrs[1].ID, usrs[2].ID},
 	}
 	want := GetListByIDsWant{
 		user: usrs,
@@ -134,17 +167,18 @@ func (t *GetListByIDsSuite) TestGetUser_OkFromRepoAndSetCachePartially() {
 
 	_, errCtx := errgroup.WithContext(args.ctx)
 
-	t.userCache.EXPECT().Get(errCtx, usrs[0].ID).Return(usrs[0], nil)
-	t.userCache.EXPECT().Get(errCtx, usrs[1].ID).Return(nil, gofakeit.Error())
-	t.userCache.EXPECT().Get(errCtx, usrs[2].ID).Return(nil, gofakeit.Error())
+	t.userCache.EXPECT().Get(errCtx, model.DefaultOrgID, usrs[0].ID).Return(usrs[0], nil)
+	t.userCache.EXPECT().Get(errCtx, model.DefaultOrgID, usrs[1].ID).Return(nil, gofakeit.Error())
+	t.userCache.EXPECT().Get(errCtx, model.DefaultOrgID, usrs[2].ID).Return(nil, gofakeit.Error())
 
 	repoUsrs := []*model.User{usrs[1], usrs[2]}
-	t.userRepo.EXPECT().GetByIDs(
+	t.userRepo.EXPECT().GetByIDsInOrg(
 		args.ctx,
+		model.DefaultOrgID,
 		gomock.InAnyOrder([]int64{usrs[1].ID, usrs[2].ID})).Return(repoUsrs, nil)
 
-	t.userCache.EXPECT().Set(gomock.Any(), usrs[1]).Return(nil)
-	t.userCache.EXPECT().Set(gomock.Any(), usrs[2]).Return(nil)
+	t.userCache.EXPECT().Set(gomock.Any(), model.DefaultOrgID, usrs[1]).Return(nil)
+	t.userCache.EXPECT().Set(gomock.Any(), model.DefaultOrgID, usrs[2]).Return(nil)
 
 	t.do(args, want)
 }
@@ -153,8 +187,9 @@ func (t *GetListByIDsSuite) TestGetUser_OkEmptyUsers() {
 	usrs := tm.NewUsers(3)
 
 	args := GetListByIDsArgs{
-		ctx: context.Background(),
-		ids: []int64{usrs[0].ID, usrs[1].ID, usrs[2].ID},
+		ctx:         context.Background(),
+		accessToken: t.accessToken(),
+		ids:         []int64{usrs[0].ID, usrs[1].ID, usrs[2].ID},
 	}
 	want := GetListByIDsWant{
 		user: []*model.User{},
@@ -163,12 +198,13 @@ func (t *GetListByIDsSuite) TestGetUser_OkEmptyUsers() {
 
 	_, errCtx := errgroup.WithContext(args.ctx)
 
-	t.userCache.EXPECT().Get(errCtx, usrs[0].ID).Return(nil, gofakeit.Error())
-	t.userCache.EXPECT().Get(errCtx, usrs[1].ID).Return(nil, gofakeit.Error())
-	t.userCache.EXPECT().Get(errCtx, usrs[2].ID).Return(nil, gofakeit.Error())
+	t.userCache.EXPECT().Get(errCtx, model.DefaultOrgID, usrs[0].ID).Return(nil, gofakeit.Error())
+	t.userCache.EXPECT().Get(errCtx, model.DefaultOrgID, usrs[1].ID).Return(nil, gofakeit.Error())
+	t.userCache.EXPECT().Get(errCtx, model.DefaultOrgID, usrs[2].ID).Return(nil, gofakeit.Error())
 
-	t.userRepo.EXPECT().GetByIDs(
+	t.userRepo.EXPECT().GetByIDsInOrg(
 		args.ctx,
+		model.DefaultOrgID,
 		gomock.InAnyOrder([]int64{usrs[0].ID, usrs[1].ID, usrs[2].ID})).Return([]*model.User{}, nil)
 
 	t.do(args, want)
@@ -0,0 +1,244 @@
+package tests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	passwordMocks "github.com/Paul1k96/microservices_course_auth/internal/password/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	svcMocks "github.com/Paul1k96/microservices_course_auth/internal/service/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service/user"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	infraMocks "github.com/Paul1k96/microservices_course_platform_common/pkg/client/db/transaction"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetUserSuite(t *testing.T) {
+	suite.Run(t, new(GetUserSuite))
+}
+
+type GetUserSuite struct {
+	suite.Suite
+	*require.Assertions
+	ctrl *gomock.Controller
+
+	userRepo   *mocks.MockUsersRepository
+	userCache  *mocks.MockUsersCache
+	eventRepo  *mocks.MockUserEventsRepository
+	hasher     *passwordMocks.MockHasher
+	sessions   *svcMocks.MockSessionService
+	emailVerif *svcMocks.MockEmailVerificationService
+
+	service service.UserService
+}
+
+func (t *GetUserSuite) SetupTest() {
+	t.Assertions = require.New(t.T())
+	t.ctrl = gomock.NewController(t.T())
+
+	t.userRepo = mocks.NewMockUsersRepository(t.ctrl)
+	t.userCache = mocks.NewMockUsersCache(t.ctrl)
+	t.eventRepo = mocks.NewMockUserEventsRepository(t.ctrl)
+	t.hasher = passwordMocks.NewMockHasher(t.ctrl)
+	t.sessions = svcMocks.NewMockSessionService(t.ctrl)
+	t.emailVerif = svcMocks.NewMockEmailVerificationService(t.ctrl)
+
+	t.service = user.NewService(
+		slog.Default(),
+		infraMocks.NewNopTxManager(),
+		t.userRepo,
+		t.eventRepo,
+		t.userCache,
+		t.hasher,
+		newPasswordPolicy(t.Assertions),
+		t.sessions,
+		t.emailVerif,
+		svcMocks.NewMockRoleService(t.ctrl),
+	)
+}
+
+func (t *GetUserSuite) TearDownTest() {
+	t.ctrl.Finish()
+}
+
+func (t *GetUserSuite) accessToken() string {
+	accessToken := gofakeit.LetterN(64)
+	claims := &model.UserClaims{ID: gofakeit.UUID(), UserID: gofakeit.Int64(), OrgID: model.DefaultOrgID}
+
+	t.sessions.EXPECT().VerifyAccessToken(gomock.Any(), accessToken).Return(claims, nil)
+
+	return accessToken
+}
+
+type GetUserArgs struct {
+	ctx         context.Context
+	accessToken string
+	id          int64
+}
+
+type GetUserWant struct {
+	user *model.User
+	err  error
+}
+
+func (t *GetUserSuite) do(args GetUserArgs, want GetUserWant) {
+	usr, err := t.service.Get(args.ctx, args.accessToken, args.id)
+
+	t.Require().Equal(want.user, usr)
+
+	if want.err == nil {
+		t.Require().NoError(err)
+	} else {
+		t.Require().ErrorContains(err, want.err.Error())
+	}
+}
+
+func (t *GetUserSuite) TestGetUser_OkFromCache() {
+	usr := tm.NewUser()
+
+	args := GetUserArgs{
+		ctx:         context.Background(),
+		accessToken: t.accessToken(),
+		id:          usr.ID,
+	}
+	want := GetUserWant{
+		user: usr,
+		err:  nil,
+	}
+
+	t.userCache.EXPECT().Get(args.ctx, model.DefaultOrgID, args.id).Return(usr, nil)
+
+	t.do(args, want)
+}
+
+func (t *GetUserSuite) TestGetUser_OkFromRepoAndSetCache() {
+	usr := tm.NewUser()
+
+	args := GetUserArgs{
+		ctx:         context.Background(),
+		accessToken: t.accessToken(),
+		id:          usr.ID,
+	}
+	want := GetUserWant{
+		user: usr,
+		err:  nil,
+	}
+
+	t.userCache.EXPECT().Get(args.ctx, model.DefaultOrgID, args.id).Return(nil, errs.ErrUserNotFound)
+	t.userRepo.EXPECT().GetByIDInOrg(args.ctx, model.DefaultOrgID, args.id).Return(usr, nil)
+	t.userCache.EXPECT().Set(args.ctx, model.DefaultOrgID, usr).Return(nil)
+
+	t.do(args, want)
+}
+
+func (t *GetUserSuite) TestGetUser_OkMemberOwnedByAnotherOrg() {
+	usr := tm.NewUser()
+	usr.OrgID = model.DefaultOrgID + 1
+
+	args := GetUserArgs{
+		ctx:         context.Background(),
+		accessToken: t.accessToken(),
+		id:          usr.ID,
+	}
+	want := GetUserWant{
+		user: usr,
+		err:  nil,
+	}
+
+	t.userCache.EXPECT().Get(args.ctx, model.DefaultOrgID, args.id).Return(nil, errs.ErrUserNotFound)
+	t.userRepo.EXPECT().GetByIDInOrg(args.ctx, model.DefaultOrgID, args.id).Return(usr, nil)
+	t.userCache.EXPECT().Set(args.ctx, model.DefaultOrgID, usr).Return(nil)
+
+	t.do(args, want)
+}
+
+func (t *GetUserSuite) TestGetUser_UserNotFound() {
+	args := GetUserArgs{
+		ctx:         context.Background(),
+		accessToken: t.accessToken(),
+		id:          0,
+	}
+	want := GetUserWant{
+		user: nil,
+		err:  errs.ErrUserNotFound,
+	}
+
+	t.userCache.EXPECT().Get(args.ctx, model.DefaultOrgID, args.id).Return(nil, errs.ErrUserNotFound)
+	t.userRepo.EXPECT().GetByIDInOrg(args.ctx, model.DefaultOrgID, args.id).Return(nil, errs.ErrUserNotFound)
+
+	t.do(args, want)
+}
+
+func (t *GetUserSuite) TestGetUser_FailedToGetAndSetFromCache() {
+	usr := tm.NewUser()
+
+	args := GetUserArgs{
+		ctx:         context.Background(),
+		accessToken: t.accessToken(),
+		id:          0,
+	}
+	want := GetUserWant{
+		user: usr,
+		err:  nil,
+	}
+
+	cacheErr := gofakeit.Error()
+	t.userCache.EXPECT().Get(args.ctx, model.DefaultOrgID, args.id).Return(nil, cacheErr)
+	t.userRepo.EXPECT().GetByIDInOrg(args.ctx, model.DefaultOrgID, args.id).Return(usr, nil)
+
+	t.userCache.EXPECT().Set(args.ctx, model.DefaultOrgID, usr).Return(cacheErr)
+
+	t.do(args, want)
+}
+
+func (t *GetUserSuite) TestGetUser_FailedToGetFromRepo() {
+	args := GetUserArgs{
+		ctx:         context.Background(),
+		accessToken: t.accessToken(),
+		id:          0,
+	}
+	want := GetUserWant{
+		user: nil,
+		err:  gofakeit.Error(),
+	}
+
+	t.userCache.EXPECT().Get(args.ctx, model.DefaultOrgID, args.id).Return(nil, errs.ErrUserNotFound)
+	t.userRepo.EXPECT().GetByIDInOrg(args.ctx, model.DefaultOrgID, args.id).Return(nil, want.err)
+
+	t.do(args, want)
+}
+
+func (t *GetUserSuite) TestGetUser_RepoNilErrorButEmptyUser() {
+	args := GetUserArgs{
+		ctx:         context.Background(),
+		accessToken: t.accessToken(),
+		id:          0,
+	}
+	want := GetUserWant{
+		user: nil,
+		err:  errs.ErrUserNotFound,
+	}
+
+	t.userCache.EXPECT().Get(args.ctx, model.DefaultOrgID, args.id).Return(nil, errs.ErrUserNotFound)
+	t.userRepo.EXPECT().GetByIDInOrg(args.ctx, model.DefaultOrgID, args.id).Return(nil, nil)
+
+	t.do(args, want)
+}
+
+func (t *GetUserSuite) TestGetUser_InvalidAccessToken() {
+	ctx := context.Background()
+	accessToken := gofakeit.LetterN(64)
+
+	t.sessions.EXPECT().VerifyAccessToken(ctx, accessToken).Return(nil, errs.ErrInvalidAccessToken)
+
+	usr, err := t.service.Get(ctx, accessToken, gofakeit.Int64())
+	t.Require().ErrorIs(err, errs.ErrInvalidAccessToken)
+	t.Require().Nil(usr)
+}
@@ -0,0 +1,109 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/service/user"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestPasswordPolicySuite(t *testing.T) {
+	suite.Run(t, new(PasswordPolicySuite))
+}
+
+type PasswordPolicySuite struct {
+	suite.Suite
+	*require.Assertions
+
+	config *passwordPolicyConfig
+	user   *model.User
+}
+
+func (t *PasswordPolicySuite) SetupTest() {
+	t.Assertions = require.New(t.T())
+
+	path := filepath.Join(t.T().TempDir(), "breached.txt")
+	t.Require().NoError(os.WriteFile(path, []byte("Password123\n\n  Qwerty123  \n"), 0o600))
+
+	t.config = &passwordPolicyConfig{breachedListPath: path}
+	t.user = &model.User{Name: "Alice Cooper", Email: "alcoop@example.com"}
+}
+
+func (t *PasswordPolicySuite) policy() *user.PasswordPolicy {
+	policy, err := user.NewPasswordPolicy(t.config)
+	t.Require().NoError(err)
+
+	return policy
+}
+
+func (t *PasswordPolicySuite) violations(err error) []string {
+	t.Require().ErrorIs(err, errs.ErrWeakPassword)
+
+	var validationErr *errs.ValidationError
+	t.Require().ErrorAs(err, &validationErr)
+
+	descriptions := make([]string, 0, len(validationErr.Violations))
+	for _, violation := range validationErr.Violations {
+		t.Require().Equal("password", violation.Field)
+		descriptions = append(descriptions, violation.Description)
+	}
+
+	return descriptions
+}
+
+func (t *PasswordPolicySuite) TestValidate_Ok() {
+	err := t.policy().Validate("password", tm.NewPassword(), t.user)
+	t.Require().NoError(err)
+}
+
+func (t *PasswordPolicySuite) TestValidate_AllViolationsReported() {
+	err := t.policy().Validate("password", "alice", t.user)
+
+	t.Require().Equal([]string{
+		"must be at least 8 characters long",
+		"must contain an uppercase letter",
+		"must contain a digit",
+		"must not contain the user name",
+	}, t.violations(err))
+}
+
+func (t *PasswordPolicySuite) TestValidate_ContainsEmail() {
+	err := t.policy().Validate("password", "xAlCoop2024", t.user)
+	t.Require().Equal([]string{"must not contain the email"}, t.violations(err))
+}
+
+func (t *PasswordPolicySuite) TestValidate_ShortNamePartsIgnored() {
+	err := t.policy().Validate("password", "Al1velyDay", &model.User{Name: "Al Li", Email: "al@example.com"})
+	t.Require().NoError(err)
+}
+
+func (t *PasswordPolicySuite) TestValidate_Breached() {
+	err := t.policy().Validate("password", "qwerty123", t.user)
+	t.Require().Equal([]string{"must contain an uppercase letter", "has appeared in a data breach"}, t.violations(err))
+
+	err = t.policy().Validate("password", "Password123", t.user)
+	t.Require().Equal([]string{"has appeared in a data breach"}, t.violations(err))
+}
+
+func (t *PasswordPolicySuite) TestValidate_SymbolRequired() {
+	t.config.requireSymbol = true
+
+	err := t.policy().Validate("password", "aA1bcdefgh", t.user)
+	t.Require().Equal([]string{"must contain a symbol"}, t.violations(err))
+
+	err = t.policy().Validate("password", "aA1bcd efgh", t.user)
+	t.Require().NoError(err)
+}
+
+func (t *PasswordPolicySuite) TestNewPasswordPolicy_BreachedListNotFound() {
+	t.config.breachedListPath = filepath.Join(t.T().TempDir(), "missing.txt")
+
+	_, err := user.NewPasswordPolicy(t.config)
+	t.Require().Error(err)
+}
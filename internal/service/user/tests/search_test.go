@@ -0,0 +1,201 @@
+package tests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	passwordMocks "github.com/Paul1k96/microservices_course_auth/internal/password/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	svcMocks "github.com/Paul1k96/microservices_course_auth/internal/service/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service/user"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	infraMocks "github.com/Paul1k96/microservices_course_platform_common/pkg/client/db/transaction"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSearchSuite(t *testing.T) {
+	suite.Run(t, new(SearchSuite))
+}
+
+type SearchSuite struct {
+	suite.Suite
+	*require.Assertions
+	ctrl *gomock.Controller
+
+	userRepo *mocks.MockUsersRepository
+	sessions *svcMocks.MockSessionService
+
+	service service.UserService
+}
+
+func (t *SearchSuite) SetupTest() {
+	t.Assertions = require.New(t.T())
+	t.ctrl = gomock.NewController(t.T())
+
+	t.userRepo = mocks.NewMockUsersRepository(t.ctrl)
+	t.sessions = svcMocks.NewMockSessionService(t.ctrl)
+
+	t.service = user.NewService(
+		slog.Default(),
+		infraMocks.NewNopTxManager(),
+		t.userRepo,
+		mocks.NewMockUserEventsRepository(t.ctrl),
+		mocks.NewMockUsersCache(t.ctrl),
+		passwordMocks.NewMockHasher(t.ctrl),
+		newPasswordPolicy(t.Assertions),
+		t.sessions,
+		svcMocks.NewMockEmailVerificationService(t.ctrl),
+		svcMocks.NewMockRoleService(t.ctrl),
+	)
+}
+
+func (t *SearchSuite) TearDownTest() {
+	t.ctrl.Finish()
+}
+
+func (t *SearchSuite) accessToken() string {
+	accessToken := gofakeit.LetterN(64)
+	claims := &model.UserClaims{ID: gofakeit.UUID(), UserID: gofakeit.Int64(), OrgID: model.DefaultOrgID}
+
+	t.sessions.EXPECT().VerifyAccessToken(gomock.Any(), accessToken).Return(claims, nil)
+
+	return accessToken
+}
+
+func (t *SearchSuite) TestSearch_OkDefaultPageSize() {
+	ctx := context.Background()
+	usrs := tm.NewUsers(3)
+
+	t.userRepo.EXPECT().Search(ctx, &model.UserFilter{
+		OrgID:       model.DefaultOrgID,
+		EmailDomain: "example.com",
+		Limit:       21,
+	}).Return(usrs, nil)
+
+	page, err := t.service.Search(ctx, t.accessToken(), &model.UserFilter{EmailDomain: "example.com"}, "")
+	t.NoError(err)
+	t.Equal(usrs, page.Users)
+	t.Empty(page.NextPageToken)
+}
+
+func (t *SearchSuite) TestSearch_OkPageSizeCapped() {
+	ctx := context.Background()
+
+	t.userRepo.EXPECT().Search(ctx, &model.UserFilter{
+		OrgID: model.DefaultOrgID,
+		Limit: 101,
+	}).Return([]*model.User{}, nil)
+
+	page, err := t.service.Search(ctx, t.accessToken(), &model.UserFilter{Limit: 500}, "")
+	t.NoError(err)
+	t.Empty(page.Users)
+	t.Empty(page.NextPageToken)
+}
+
+func (t *SearchSuite) TestSearch_OkNextPage() {
+	ctx := context.Background()
+	usrs := tm.NewUsers(3)
+	filter := &model.UserFilter{SortBy: model.UserSortByEmail, Descending: true, Limit: 2}
+
+	t.userRepo.EXPECT().Search(ctx, gomock.Cond(func(f *model.UserFilter) bool {
+		return f.After == nil && f.Limit == 3
+	})).Return(usrs, nil)
+
+	page, err := t.service.Search(ctx, t.accessToken(), filter, "")
+	t.NoError(err)
+	t.Equal(usrs[:2], page.Users)
+	t.NotEmpty(page.NextPageToken)
+
+	t.userRepo.EXPECT().Search(ctx, gomock.Cond(func(f *model.UserFilter) bool {
+		return f.After != nil &&
+			f.After.ID == usrs[1].ID &&
+			f.After.Email == usrs[1].Email &&
+			f.After.CreatedAt.Equal(usrs[1].CreatedAt) &&
+			f.SortBy == model.UserSortByEmail &&
+			f.Descending
+	})).Return(usrs[2:], nil)
+
+	page, err = t.service.Search(ctx, t.accessToken(), filter, page.NextPageToken)
+	t.NoError(err)
+	t.Equal(usrs[2:], page.Users)
+	t.Empty(page.NextPageToken)
+}
+
+func (t *SearchSuite) TestSearch_ErrPageTokenOfAnotherSorting() {
+	ctx := context.Background()
+	usrs := tm.NewUsers(2)
+
+	t.userRepo.EXPECT().Search(ctx, gomock.Any()).Return(usrs, nil)
+
+	page, err := t.service.Search(ctx, t.accessToken(), &model.UserFilter{Limit: 1}, "")
+	t.NoError(err)
+
+	_, err = t.service.Search(
+		ctx,
+		t.accessToken(),
+		&model.UserFilter{SortBy: model.UserSortByCreatedAt, Limit: 1},
+		page.NextPageToken,
+	)
+	t.ErrorIs(err, errs.ErrInvalidPageToken)
+}
+
+func (t *SearchSuite) TestSearch_ErrPageTokenOfAnotherFilter() {
+	ctx := context.Background()
+	usrs := tm.NewUsers(2)
+
+	t.userRepo.EXPECT().Search(ctx, gomock.Any()).Return(usrs, nil)
+
+	page, err := t.service.Search(ctx, t.accessToken(), &model.UserFilter{EmailDomain: "example.com", Limit: 1}, "")
+	t.NoError(err)
+
+	_, err = t.service.Search(
+		ctx,
+		t.accessToken(),
+		&model.UserFilter{EmailDomain: "example.org", Limit: 1},
+		page.NextPageToken,
+	)
+	t.ErrorIs(err, errs.ErrInvalidPageToken)
+}
+
+func (t *SearchSuite) TestSearch_OkNextPageOfAnotherSize() {
+	ctx := context.Background()
+	usrs := tm.NewUsers(2)
+
+	t.userRepo.EXPECT().Search(ctx, gomock.Any()).Return(usrs, nil)
+
+	page, err := t.service.Search(ctx, t.accessToken(), &model.UserFilter{EmailDomain: "example.com", Limit: 1}, "")
+	t.NoError(err)
+
+	t.userRepo.EXPECT().Search(ctx, gomock.Cond(func(f *model.UserFilter) bool {
+		return f.After != nil && f.After.ID == usrs[0].ID && f.Limit == 6
+	})).Return(usrs[1:], nil)
+
+	page, err = t.service.Search(
+		ctx,
+		t.accessToken(),
+		&model.UserFilter{EmailDomain: "EXAMPLE.com", Limit: 5},
+		page.NextPageToken,
+	)
+	t.NoError(err)
+	t.Equal(usrs[1:], page.Users)
+}
+
+func (t *SearchSuite) TestSearch_ErrMalformedPageToken() {
+	_, err := t.service.Search(context.Background(), t.accessToken(), &model.UserFilter{}, "not a token")
+	t.ErrorIs(err, errs.ErrInvalidPageToken)
+}
+
+func (t *SearchSuite) TestSearch_ErrInvalidAccessToken() {
+	accessToken := gofakeit.LetterN(64)
+	t.sessions.EXPECT().VerifyAccessToken(gomock.Any(), accessToken).Return(nil, errs.ErrInvalidAccessToken)
+
+	_, err := t.service.Search(context.Background(), accessToken, &model.UserFilter{}, "")
+	t.ErrorIs(err, errs.ErrInvalidAccessToken)
+}
@@ -7,8 +7,11 @@ import (
 
 	"github.com/Paul1k96/microservices_course_auth/internal/errs"
 	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	passwordMocks "github.com/Paul1k96/microservices_course_auth/internal/password/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/principal"
 	"github.com/Paul1k96/microservices_course_auth/internal/repository/mocks"
 	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	svcMocks "github.com/Paul1k96/microservices_course_auth/internal/service/mocks"
 	"github.com/Paul1k96/microservices_course_auth/internal/service/user"
 	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
 	infraMocks "github.com/Paul1k96/microservices_course_platform_common/pkg/client/db/transaction"
@@ -28,9 +31,12 @@ type UpdateUserSuite struct {
 	*require.Assertions
 	ctrl *gomock.Controller
 
-	userRepo  *mocks.MockUsersRepository
-	userCache *mocks.MockUsersCache
-	eventRepo *mocks.MockUserEventsRepository
+	userRepo   *mocks.MockUsersRepository
+	userCache  *mocks.MockUsersCache
+	eventRepo  *mocks.MockUserEventsRepository
+	hasher     *passwordMocks.MockHasher
+	sessions   *svcMocks.MockSessionService
+	emailVerif *svcMocks.MockEmailVerificationService
 
 	service service.UserService
 }
@@ -42,14 +48,34 @@ func (t *UpdateUserSuite) SetupTest() {
 	t.userRepo = mocks.NewMockUsersRepository(t.ctrl)
 	t.userCache = mocks.NewMockUsersCache(t.ctrl)
 	t.eventRepo = mocks.NewMockUserEventsRepository(t.ctrl)
-
-	t.service = user.NewService(slog.Default(), infraMocks.NewNopTxManager(), t.userRepo, t.eventRepo, t.userCache)
+	t.hasher = passwordMocks.NewMockHasher(t.ctrl)
+	t.sessions = svcMocks.NewMockSessionService(t.ctrl)
+	t.emailVerif = svcMocks.NewMockEmailVerificationService(t.ctrl)
+
+	t.service = user.NewService(
+		slog.Default(),
+		infraMocks.NewNopTxManager(),
+		t.userRepo,
+		t.eventRepo,
+		t.userCache,
+		t.hasher,
+		newPasswordPolicy(t.Assertions),
+		t.sessions,
+		t.emailVerif,
+		svcMocks.NewMockRoleService(t.ctrl),
+	)
 }
 
 func (t *UpdateUserSuite) TearDownTest() {
 	t.ctrl.Finish()
 }
 
+func (t *UpdateUserSuite) callerContext(orgID int64) context.Context {
+	claims := &model.UserClaims{ID: gofakeit.UUID(), UserID: gofakeit.Int64(), OrgID: orgID}
+
+	return principal.NewContext(context.Background(), gofakeit.LetterN(64), claims)
+}
+
 type UpdateUserArgs struct {
 	ctx  context.Context
 	user *model.User
@@ -76,7 +102,7 @@ func (t *UpdateUserSuite) TestUpdateUser_OkChangeName() {
 	changeUser.Name = gofakeit.Name()
 
 	args := UpdateUserArgs{
-		ctx:  context.Background(),
+		ctx:  t.callerContext(model.DefaultOrgID),
 		user: changeUser,
 	}
 
@@ -84,24 +110,52 @@ func (t *UpdateUserSuite) TestUpdateUser_OkChangeName() {
 		err: nil,
 	}
 
-	t.userRepo.EXPECT().GetByID(args.ctx, args.user.ID).Return(usr, nil)
+	t.userRepo.EXPECT().GetByIDInOrg(args.ctx, model.DefaultOrgID, args.user.ID).Return(usr, nil)
 
 	t.userRepo.EXPECT().Update(args.ctx, args.user).Return(want.err)
 
-	t.userCache.EXPECT().Set(args.ctx, args.user).Return(nil)
+	t.userCache.EXPECT().Delete(args.ctx, args.user.ID).Return(nil)
 
 	t.eventRepo.EXPECT().Save(args.ctx, gomock.Any()).Return(nil)
 
 	t.do(args, want)
 }
 
+func (t *UpdateUserSuite) TestUpdateUser_OkChangeEmail() {
+	usr := tm.NewUser()
+
+	changeUser := *usr
+	changeUser.Email = gofakeit.Email()
+
+	args := UpdateUserArgs{
+		ctx:  t.callerContext(model.DefaultOrgID),
+		user: &changeUser,
+	}
+
+	want := UpdateUserWant{
+		err: nil,
+	}
+
+	t.userRepo.EXPECT().GetByIDInOrg(args.ctx, model.DefaultOrgID, args.user.ID).Return(usr, nil)
+
+	t.userRepo.EXPECT().Update(args.ctx, args.user).Return(want.err)
+
+	t.userCache.EXPECT().Delete(args.ctx, args.user.ID).Return(nil)
+
+	t.eventRepo.EXPECT().Save(args.ctx, gomock.Any()).Return(nil)
+
+	t.emailVerif.EXPECT().Send(args.ctx, args.user).Return(nil)
+
+	t.do(args, want)
+}
+
 func (t *UpdateUserSuite) TestUpdateUser_NameContainsRestrictedSymbols() {
 	usr := tm.NewUser()
 
 	changeUser := usr
 	changeUser.Name = changeUser.Name + "!"
 	args := UpdateUserArgs{
-		ctx:  context.Background(),
+		ctx:  t.callerContext(model.DefaultOrgID),
 		user: changeUser,
 	}
 
@@ -118,7 +172,7 @@ func (t *UpdateUserSuite) TestUpdateUser_EmailInvalid() {
 	changeUser := usr
 	changeUser.Email = gofakeit.URL()
 	args := UpdateUserArgs{
-		ctx:  context.Background(),
+		ctx:  t.callerContext(model.DefaultOrgID),
 		user: changeUser,
 	}
 
@@ -133,9 +187,9 @@ func (t *UpdateUserSuite) TestUpdateUser_RoleInvalid() {
 	usr := tm.NewUser()
 
 	changeUser := usr
-	changeUser.Role = 100
+	changeUser.Roles = []string{"SUPERUSER"}
 	args := UpdateUserArgs{
-		ctx:  context.Background(),
+		ctx:  t.callerContext(model.DefaultOrgID),
 		user: changeUser,
 	}
 
@@ -151,7 +205,7 @@ func (t *UpdateUserSuite) TestUpdateUser_RepoError() {
 
 	changeUser := usr
 	args := UpdateUserArgs{
-		ctx:  context.Background(),
+		ctx:  t.callerContext(model.DefaultOrgID),
 		user: changeUser,
 	}
 
@@ -159,9 +213,79 @@ func (t *UpdateUserSuite) TestUpdateUser_RepoError() {
 		err: errs.ErrUserNotFound,
 	}
 
-	t.userRepo.EXPECT().GetByID(args.ctx, args.user.ID).Return(nil, nil)
+	t.userRepo.EXPECT().GetByIDInOrg(args.ctx, model.DefaultOrgID, args.user.ID).Return(usr, nil)
 
 	t.userRepo.EXPECT().Update(args.ctx, args.user).Return(want.err)
 
 	t.do(args, want)
 }
+
+func (t *UpdateUserSuite) TestUpdateUser_UserOfAnotherOrg() {
+	usr := tm.NewUser()
+	orgID := model.DefaultOrgID + 1
+
+	args := UpdateUserArgs{
+		ctx:  t.callerContext(orgID),
+		user: usr,
+	}
+
+	want := UpdateUserWant{
+		err: errs.ErrUserNotFound,
+	}
+
+	t.userRepo.EXPECT().GetByIDInOrg(args.ctx, orgID, args.user.ID).Return(nil, errs.ErrUserNotFound)
+
+	t.do(args, want)
+}
+
+func (t *UpdateUserSuite) TestUpdateUser_NoCaller() {
+	args := UpdateUserArgs{
+		ctx:  context.Background(),
+		user: tm.NewUser(),
+	}
+
+	want := UpdateUserWant{
+		err: errs.ErrInvalidAccessToken,
+	}
+
+	t.do(args, want)
+}
+
+func (t *UpdateUserSuite) TestUpdateUser_ImpersonatedChangeEmail() {
+	usr := tm.NewUser()
+
+	changeUser := *usr
+	changeUser.Email = gofakeit.Email()
+
+	claims := &model.UserClaims{UserID: usr.ID, OrgID: model.DefaultOrgID, ActorID: gofakeit.Int64()}
+	args := UpdateUserArgs{
+		ctx:  principal.NewContext(context.Background(), gofakeit.LetterN(64), claims),
+		user: &changeUser,
+	}
+
+	want := UpdateUserWant{
+		err: errs.ErrImpersonatedSession,
+	}
+
+	t.userRepo.EXPECT().GetByIDInOrg(args.ctx, model.DefaultOrgID, args.user.ID).Return(usr, nil)
+
+	t.do(args, want)
+}
+
+func (t *UpdateUserSuite) TestUpdateUser_MemberFromAnotherOrg() {
+	usr := tm.NewUser()
+	orgID := model.DefaultOrgID + 1
+
+	args := UpdateUserArgs{
+		ctx:  t.callerContext(orgID),
+		user: &model.User{ID: usr.ID, Name: gofakeit.FirstName()},
+	}
+
+	want := UpdateUserWant{
+		err: errs.ErrAccessDenied,
+	}
+
+	t.userRepo.EXPECT().GetByIDInOrg(args.ctx, orgID, args.user.ID).Return(usr, nil)
+
+	t.do(args, want)
+}
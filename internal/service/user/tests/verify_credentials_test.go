@@ -0,0 +1,172 @@
+package tests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	passwordMocks "github.com/Paul1k96/microservices_course_auth/internal/password/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/repository/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service"
+	svcMocks "github.com/Paul1k96/microservices_course_auth/internal/service/mocks"
+	"github.com/Paul1k96/microservices_course_auth/internal/service/user"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	infraMocks "github.com/Paul1k96/microservices_course_platform_common/pkg/client/db/transaction"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestVerifyCredentialsSuite(t *testing.T) {
+	suite.Run(t, new(VerifyCredentialsSuite))
+}
+
+type VerifyCredentialsSuite struct {
+	suite.Suite
+	*require.Assertions
+	ctrl *gomock.Controller
+
+	userRepo   *mocks.MockUsersRepository
+	userCache  *mocks.MockUsersCache
+	userEvents *mocks.MockUserEventsRepository
+	hasher     *passwordMocks.MockHasher
+	sessions   *svcMocks.MockSessionService
+	emailVerif *svcMocks.MockEmailVerificationService
+
+	service service.UserService
+}
+
+func (t *VerifyCredentialsSuite) SetupTest() {
+	t.Assertions = require.New(t.T())
+	t.ctrl = gomock.NewController(t.T())
+
+	t.userRepo = mocks.NewMockUsersRepository(t.ctrl)
+	t.userCache = mocks.NewMockUsersCache(t.ctrl)
+	t.userEvents = mocks.NewMockUserEventsRepository(t.ctrl)
+	t.hasher = passwordMocks.NewMockHasher(t.ctrl)
+	t.sessions = svcMocks.NewMockSessionService(t.ctrl)
+	t.emailVerif = svcMocks.NewMockEmailVerificationService(t.ctrl)
+
+	t.service = user.NewService(
+		slog.Default(),
+		infraMocks.NewNopTxManager(),
+		t.userRepo,
+		t.userEvents,
+		t.userCache,
+		t.hasher,
+		newPasswordPolicy(t.Assertions),
+		t.sessions,
+		t.emailVerif,
+		svcMocks.NewMockRoleService(t.ctrl),
+	)
+}
+
+func (t *VerifyCredentialsSuite) TearDownTest() {
+	t.ctrl.Finish()
+}
+
+type VerifyCredentialsArgs struct {
+	ctx      context.Context
+	email    string
+	password string
+}
+
+type VerifyCredentialsWant struct {
+	user *model.User
+	err  error
+}
+
+func (t *VerifyCredentialsSuite) do(args VerifyCredentialsArgs, want VerifyCredentialsWant) {
+	usr, err := t.service.VerifyCredentials(args.ctx, model.DefaultOrgID, args.email, args.password)
+
+	t.Require().Equal(want.user, usr)
+
+	if want.err == nil {
+		t.Require().NoError(err)
+	} else {
+		t.Require().ErrorContains(err, want.err.Error())
+	}
+}
+
+func (t *VerifyCredentialsSuite) TestVerifyCredentials_Ok() {
+	usr := tm.NewUser()
+
+	args := VerifyCredentialsArgs{
+		ctx:      context.Background(),
+		email:    usr.Email,
+		password: gofakeit.Password(true, true, true, true, false, 12),
+	}
+
+	want := VerifyCredentialsWant{
+		user: usr,
+	}
+
+	t.userRepo.EXPECT().GetByEmail(args.ctx, model.DefaultOrgID, args.email).Return(usr, nil)
+	t.hasher.EXPECT().Verify(usr.Password, args.password).Return(true, nil)
+	t.hasher.EXPECT().NeedsRehash(usr.Password).Return(false)
+
+	t.do(args, want)
+}
+
+func (t *VerifyCredentialsSuite) TestVerifyCredentials_OkRehash() {
+	usr := tm.NewUser()
+	newHash := gofakeit.Password(true, true, true, true, false, 60)
+
+	args := VerifyCredentialsArgs{
+		ctx:      context.Background(),
+		email:    usr.Email,
+		password: usr.Password,
+	}
+
+	want := VerifyCredentialsWant{
+		user: usr,
+	}
+
+	t.userRepo.EXPECT().GetByEmail(args.ctx, model.DefaultOrgID, args.email).Return(usr, nil)
+	t.hasher.EXPECT().Verify(usr.Password, args.password).Return(true, nil)
+	t.hasher.EXPECT().NeedsRehash(usr.Password).Return(true)
+	t.hasher.EXPECT().Hash(args.password).Return(newHash, nil)
+	t.userRepo.EXPECT().UpdatePassword(args.ctx, usr.ID, newHash).Return(nil)
+
+	t.do(args, want)
+
+	t.Require().Equal(newHash, usr.Password)
+}
+
+func (t *VerifyCredentialsSuite) TestVerifyCredentials_WrongPassword() {
+	usr := tm.NewUser()
+
+	args := VerifyCredentialsArgs{
+		ctx:      context.Background(),
+		email:    usr.Email,
+		password: gofakeit.Password(true, true, true, true, false, 12),
+	}
+
+	want := VerifyCredentialsWant{
+		err: errs.ErrInvalidCredentials,
+	}
+
+	t.userRepo.EXPECT().GetByEmail(args.ctx, model.DefaultOrgID, args.email).Return(usr, nil)
+	t.hasher.EXPECT().Verify(usr.Password, args.password).Return(false, nil)
+
+	t.do(args, want)
+}
+
+func (t *VerifyCredentialsSuite) TestVerifyCredentials_UserNotFound() {
+	args := VerifyCredentialsArgs{
+		ctx:      context.Background(),
+		email:    gofakeit.Email(),
+		password: gofakeit.Password(true, true, true, true, false, 12),
+	}
+
+	want := VerifyCredentialsWant{
+		err: errs.ErrInvalidCredentials,
+	}
+
+	t.userRepo.EXPECT().GetByEmail(args.ctx, model.DefaultOrgID, args.email).Return(nil, errs.ErrUserNotFound)
+
+	t.do(args, want)
+}
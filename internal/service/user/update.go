@@ -6,28 +6,54 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
 	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/principal"
 )
 
-// Update updates user.
+// Update updates user if their account is owned by the organization of the caller, accounts of members
+// added from other organizations belong to those organizations. Changing the email
+// resets its verification and sends a verification link to the new address, impersonation tokens
+// cannot change it.
 func (s *service) Update(ctx context.Context, user *model.User) error {
+	claims, ok := principal.FromContext(ctx)
+	if !ok {
+		return errs.ErrInvalidAccessToken
+	}
+
+	var (
+		emailChanged bool
+		orgID        int64
+	)
+
 	if txErr := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
 		if err := s.validateUpdateUser(user); err != nil {
 			return fmt.Errorf("failed to validate user: %w", err)
 		}
 
-		_, err := s.repo.GetByID(ctx, user.ID)
+		current, err := s.repo.GetByIDInOrg(ctx, claims.OrgID, user.ID)
 		if err != nil {
 			return fmt.Errorf("failed to get user by id: %w", err)
 		}
 
+		if current.OrgID != claims.OrgID {
+			return fmt.Errorf("update user of organization %d: %w", current.OrgID, errs.ErrAccessDenied)
+		}
+
+		emailChanged = user.Email != "" && user.Email != current.Email
+		orgID = current.OrgID
+
+		if emailChanged && claims.IsImpersonated() {
+			return fmt.Errorf("change email as admin %d: %w", claims.ActorID, errs.ErrImpersonatedSession)
+		}
+
 		updateTime := time.Now()
 		user.UpdatedAt = &updateTime
 		if err := s.repo.Update(ctx, user); err != nil {
 			return fmt.Errorf("failed to update user: %w", err)
 		}
 
-		err = s.events.Save(ctx, model.NewUpdateUserEvent(user.ID, user))
+		err = s.events.Save(ctx, model.NewUpdateUserEvent(principal.ActorID(ctx, user.ID), user).WithOrgID(orgID))
 		if err != nil {
 			s.logger.Error("failed to save user event:", slog.String("error", err.Error()))
 		}
@@ -37,9 +63,16 @@ func (s *service) Update(ctx context.Context, user *model.User) error {
 		return fmt.Errorf("transaction error: %w", txErr)
 	}
 
-	err := s.cache.Set(ctx, user)
+	err := s.cache.Delete(ctx, user.ID)
 	if err != nil {
-		s.logger.Error("failed to set user to cache:", slog.String("error", err.Error()))
+		s.logger.Error("failed to delete user from cache:", slog.String("error", err.Error()))
+	}
+
+	if emailChanged {
+		err = s.emailVerificationService.Send(ctx, user)
+		if err != nil {
+			s.logger.Error("failed to send email verification:", slog.String("error", err.Error()))
+		}
 	}
 
 	return nil
@@ -58,7 +91,7 @@ func (s *service) validateUpdateUser(user *model.User) error {
 		}
 	}
 
-	err := s.validateUserRole(user.Role)
+	err := s.validateUserRoles(user.Roles)
 	if err != nil {
 		return fmt.Errorf("role validation: %w", err)
 	}
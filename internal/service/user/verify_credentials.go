@@ -0,0 +1,56 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+)
+
+// VerifyCredentials returns user by email in the organization if password matches the stored hash.
+// Hashes made with outdated parameters and legacy plaintext passwords are
+// replaced with a fresh hash on successful verification.
+func (s *service) VerifyCredentials(ctx context.Context, orgID int64, email, password string) (*model.User, error) {
+	user, err := s.repo.GetByEmail(ctx, orgID, email)
+	if err != nil {
+		if errors.Is(err, errs.ErrUserNotFound) {
+			return nil, errs.ErrInvalidCredentials
+		}
+
+		return nil, fmt.Errorf("get user by email: %w", err)
+	}
+
+	ok, err := s.hasher.Verify(user.Password, password)
+	if err != nil {
+		return nil, fmt.Errorf("verify password: %w", err)
+	}
+
+	if !ok {
+		return nil, errs.ErrInvalidCredentials
+	}
+
+	if s.hasher.NeedsRehash(user.Password) {
+		s.rehashPassword(ctx, user, password)
+	}
+
+	return user, nil
+}
+
+func (s *service) rehashPassword(ctx context.Context, user *model.User, password string) {
+	passwordHash, err := s.hasher.Hash(password)
+	if err != nil {
+		s.logger.Error("failed to rehash password:", slog.String("error", err.Error()))
+		return
+	}
+
+	err = s.repo.UpdatePassword(ctx, user.ID, passwordHash)
+	if err != nil {
+		s.logger.Error("failed to update password hash:", slog.String("error", err.Error()))
+		return
+	}
+
+	user.Password = passwordHash
+}
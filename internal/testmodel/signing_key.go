@@ -0,0 +1,18 @@
+package testmodel
+
+import (
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+)
+
+// NewSigningKey creates a new EdDSA SigningKey instance valid for verification for an hour
+func NewSigningKey() *model.SigningKey {
+	key, err := token.GenerateKey(model.SigningAlgorithmEdDSA, time.Now().Add(time.Hour))
+	if err != nil {
+		panic(err)
+	}
+
+	return key
+}
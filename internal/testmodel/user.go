@@ -10,20 +10,33 @@ import (
 // NewUser creates a new User instance
 func NewUser() *model.User {
 	m := struct {
-		ID        int64
-		Name      string
-		Email     string `fake:"{email}"`
-		Password  string
-		Role      model.Role `fake:"{number:0,2}"`
-		CreatedAt time.Time
-		UpdatedAt *time.Time
+		ID              int64
+		OrgID           int64 `fake:"skip"`
+		Name            string
+		Email           string `fake:"{email}"`
+		Password        string
+		Roles           []string `fake:"skip"`
+		CreatedAt       time.Time
+		UpdatedAt       *time.Time
+		EmailVerifiedAt *time.Time
+		TOTPSecret      string     `fake:"skip"`
+		TOTPEnabledAt   *time.Time `fake:"skip"`
+		TOTPCounter     int64      `fake:"skip"`
 	}{}
 
 	_ = gofakeit.Struct(&m)
 	res := model.User(m)
+	res.OrgID = model.DefaultOrgID
+	res.Roles = []string{gofakeit.RandomString([]string{model.RoleUnknown, model.RoleUser, model.RoleAdmin})}
+	res.Password = NewPassword()
 	return &res
 }
 
+// NewPassword creates a password which has every character class required by password policy.
+func NewPassword() string {
+	return "aA1" + gofakeit.Password(true, true, true, true, false, 13)
+}
+
 // NewUsers creates a slice of User instances
 func NewUsers(quantity int) []*model.User {
 	res := make([]*model.User, 0, quantity)
@@ -0,0 +1,47 @@
+package token
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// APIKeyMarker starts every API key, so it is told apart from JWT access tokens
+// and found by secret scanners.
+const APIKeyMarker = "mca_"
+
+const apiKeyPrefixSize = 6
+
+// GenerateAPIKey returns a new API key and its public prefix. The key has the form
+// "mca_<prefix>_<secret>", only the prefix and the Hash of the key are meant to be stored.
+func GenerateAPIKey() (string, string, error) {
+	raw := make([]byte, apiKeyPrefixSize)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", fmt.Errorf("read random bytes: %w", err)
+	}
+
+	prefix := hex.EncodeToString(raw)
+
+	secret, err := GenerateOpaque()
+	if err != nil {
+		return "", "", fmt.Errorf("generate secret: %w", err)
+	}
+
+	return APIKeyMarker + prefix + "_" + secret, prefix, nil
+}
+
+// IsAPIKey reports whether tokenStr looks like an API key rather than a JWT.
+func IsAPIKey(tokenStr string) bool {
+	return strings.HasPrefix(tokenStr, APIKeyMarker)
+}
+
+// APIKeyPrefix returns the public prefix of the API key.
+func APIKeyPrefix(key string) (string, bool) {
+	rest, ok := strings.CutPrefix(key, APIKeyMarker)
+	if !ok || len(rest) <= apiKeyPrefixSize*2+1 || rest[apiKeyPrefixSize*2] != '_' {
+		return "", false
+	}
+
+	return rest[:apiKeyPrefixSize*2], true
+}
@@ -0,0 +1,86 @@
+package token
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// CodeChallengeMethodS256 is the only supported PKCE code challenge method.
+const CodeChallengeMethodS256 = "S256"
+
+// GenerateIDToken creates an OpenID Connect ID token of user for the OAuth client signed with asymmetric key.
+// Besides the registered claims it carries nonce and the user claims released by scopes.
+func GenerateIDToken(
+	user *model.User,
+	issuer, clientID, nonce string,
+	scopes []string,
+	key *model.SigningKey,
+	ttl time.Duration,
+) (string, error) {
+	method, err := signingMethod(key.Algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+
+	claims := UserInfoClaims(user, scopes)
+	claims["iss"] = issuer
+	claims["aud"] = clientID
+	claims["iat"] = now.Unix()
+	claims["exp"] = now.Add(ttl).Unix()
+	if nonce != "" {
+		claims["nonce"] = nonce
+	}
+
+	t := jwt.NewWithClaims(method, claims)
+	t.Header[keyIDHeader] = key.ID
+
+	signed, err := t.SignedString(key.PrivateKey)
+	if err != nil {
+		return "", fmt.Errorf("sign id token: %w", err)
+	}
+
+	return signed, nil
+}
+
+// UserInfoClaims returns the standard OpenID Connect claims of user released by scopes.
+// The profile scope releases name and updated_at, the email scope releases email and email_verified.
+func UserInfoClaims(user *model.User, scopes []string) jwt.MapClaims {
+	claims := jwt.MapClaims{
+		"sub": strconv.FormatInt(user.ID, 10),
+	}
+
+	if slices.Contains(scopes, model.ScopeProfile) {
+		claims["name"] = user.Name
+
+		updatedAt := user.CreatedAt
+		if user.UpdatedAt != nil {
+			updatedAt = *user.UpdatedAt
+		}
+
+		claims["updated_at"] = updatedAt.Unix()
+	}
+
+	if slices.Contains(scopes, model.ScopeEmail) {
+		claims["email"] = user.Email
+		claims["email_verified"] = user.EmailVerifiedAt != nil
+	}
+
+	return claims
+}
+
+// VerifyCodeChallenge reports whether the PKCE code verifier matches the S256 code challenge.
+func VerifyCodeChallenge(verifier, challenge string) bool {
+	sum := sha256.Sum256([]byte(verifier))
+
+	return subtle.ConstantTimeCompare([]byte(base64.RawURLEncoding.EncodeToString(sum[:])), []byte(challenge)) == 1
+}
@@ -0,0 +1,94 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/google/uuid"
+)
+
+const rsaKeyBits = 2048
+
+// JWK represents a public JSON Web Key as defined by RFC 7517.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
+
+// JWKS represents a JSON Web Key Set.
+type JWKS struct {
+	Keys []*JWK `json:"keys"`
+}
+
+// JWKSCacheTTL is how long clients may cache the published JWKS, a new key is published
+// at least that long before it signs tokens.
+const JWKSCacheTTL = 5 * time.Minute
+
+// GenerateKey creates a new signing key for algorithm valid for verification until expiresAt.
+// The key may sign tokens right away.
+func GenerateKey(algorithm string, expiresAt time.Time) (*model.SigningKey, error) {
+	var (
+		privateKey crypto.Signer
+		err        error
+	)
+
+	switch algorithm {
+	case model.SigningAlgorithmRS256:
+		privateKey, err = rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	case model.SigningAlgorithmEdDSA:
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm: %s", algorithm)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("generate %s key: %w", algorithm, err)
+	}
+
+	now := time.Now()
+
+	return &model.SigningKey{
+		ID:          uuid.NewString(),
+		Algorithm:   algorithm,
+		PrivateKey:  privateKey,
+		CreatedAt:   now,
+		ActivatesAt: now,
+		ExpiresAt:   expiresAt,
+	}, nil
+}
+
+// NewJWK returns public part of key as JWK.
+func NewJWK(key *model.SigningKey) (*JWK, error) {
+	jwk := &JWK{
+		Kid: key.ID,
+		Use: "sig",
+		Alg: key.Algorithm,
+	}
+
+	switch publicKey := key.PublicKey().(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(publicKey)
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+
+	return jwk, nil
+}
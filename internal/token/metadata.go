@@ -0,0 +1,30 @@
+package token
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// FromIncomingContext returns bearer token from the authorization metadata of incoming gRPC request.
+func FromIncomingContext(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 || !strings.HasPrefix(values[0], bearerPrefix) {
+		return "", false
+	}
+
+	tokenStr := strings.TrimSpace(strings.TrimPrefix(values[0], bearerPrefix))
+
+	return tokenStr, len(tokenStr) != 0
+}
@@ -0,0 +1,20 @@
+package token
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+)
+
+const opaqueTokenSize = 32
+
+// GenerateOpaque returns a random URL-safe token carrying no claims.
+// Only its Hash is meant to be stored.
+func GenerateOpaque() (string, error) {
+	raw := make([]byte, opaqueTokenSize)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("read random bytes: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
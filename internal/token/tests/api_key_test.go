@@ -0,0 +1,30 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateAPIKey(t *testing.T) {
+	key, prefix, err := token.GenerateAPIKey()
+	require.NoError(t, err)
+	require.True(t, token.IsAPIKey(key))
+
+	parsed, ok := token.APIKeyPrefix(key)
+	require.True(t, ok)
+	require.Equal(t, prefix, parsed)
+
+	other, otherPrefix, err := token.GenerateAPIKey()
+	require.NoError(t, err)
+	require.NotEqual(t, key, other)
+	require.NotEqual(t, prefix, otherPrefix)
+}
+
+func TestAPIKeyPrefix_Malformed(t *testing.T) {
+	for _, key := range []string{"", "mca_", "mca_0123456789ab", "mca_0123456789abXsecret", "eyJhbGciOi.x.y"} {
+		_, ok := token.APIKeyPrefix(key)
+		require.False(t, ok, key)
+	}
+}
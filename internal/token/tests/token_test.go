@@ -0,0 +1,161 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/errs"
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	tm "github.com/Paul1k96/microservices_course_auth/internal/testmodel"
+	"github.com/Paul1k96/microservices_course_auth/internal/token"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func keyFunc(keys ...*model.SigningKey) token.KeyFunc {
+	return func(keyID string) (*model.SigningKey, error) {
+		for _, key := range keys {
+			if key.ID == keyID {
+				return key, nil
+			}
+		}
+
+		return nil, errs.ErrSigningKeyNotFound
+	}
+}
+
+func TestGenerateWithKey(t *testing.T) {
+	usr := tm.NewUser()
+
+	for _, algorithm := range []string{model.SigningAlgorithmRS256, model.SigningAlgorithmEdDSA} {
+		t.Run(algorithm, func(t *testing.T) {
+			key, err := token.GenerateKey(algorithm, time.Now().Add(time.Hour))
+			require.NoError(t, err)
+
+			signed, _, err := token.GenerateWithKey(usr, token.TypeAccess, key, time.Minute, false)
+			require.NoError(t, err)
+
+			claims, err := token.VerifyWithKeys(signed, token.TypeAccess, keyFunc(key))
+			require.NoError(t, err)
+			require.Equal(t, usr.ID, claims.UserID)
+			require.Equal(t, usr.Roles, claims.Roles)
+
+			_, err = token.VerifyWithKeys(signed, token.TypeRefresh, keyFunc(key))
+			require.Error(t, err)
+
+			_, err = token.VerifyWithKeys(signed, token.TypeAccess, keyFunc(tm.NewSigningKey()))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestGenerateWithKey_MFA(t *testing.T) {
+	key := tm.NewSigningKey()
+
+	usr := tm.NewUser()
+	enabledAt := time.Now()
+	usr.TOTPEnabledAt = &enabledAt
+
+	for _, mfa := range []bool{false, true} {
+		signed, _, err := token.GenerateWithKey(usr, token.TypeAccess, key, time.Minute, mfa)
+		require.NoError(t, err)
+
+		claims, err := token.VerifyWithKeys(signed, token.TypeAccess, keyFunc(key))
+		require.NoError(t, err)
+		require.Equal(t, mfa, claims.MFA)
+	}
+}
+
+func TestGenerateWithKey_EmailVerified(t *testing.T) {
+	key := tm.NewSigningKey()
+
+	usr := tm.NewUser()
+	for _, verifiedAt := range []*time.Time{nil, &usr.CreatedAt} {
+		usr.EmailVerifiedAt = verifiedAt
+
+		signed, _, err := token.GenerateWithKey(usr, token.TypeAccess, key, time.Minute, false)
+		require.NoError(t, err)
+
+		claims, err := token.VerifyWithKeys(signed, token.TypeAccess, keyFunc(key))
+		require.NoError(t, err)
+		require.Equal(t, verifiedAt != nil, claims.EmailVerified)
+	}
+}
+
+func TestGenerateForClient(t *testing.T) {
+	key := tm.NewSigningKey()
+	scopes := []string{"/chat_v1.Chat/SendMessage", "/chat_v1.Chat/*"}
+
+	signed, _, err := token.GenerateForClient("billing", scopes, key, time.Minute)
+	require.NoError(t, err)
+
+	claims, err := token.VerifyWithKeys(signed, token.TypeAccess, keyFunc(key))
+	require.NoError(t, err)
+	require.Equal(t, "billing", claims.ClientID)
+	require.Equal(t, scopes, claims.Scopes)
+	require.Empty(t, claims.Roles)
+	require.Zero(t, claims.UserID)
+	require.True(t, claims.Allows("/chat_v1.Chat/Delete"))
+	require.False(t, claims.Allows("/user_v1.User/Get"))
+}
+
+func TestVerifyWithKeys_AlgorithmMismatch(t *testing.T) {
+	usr := tm.NewUser()
+	key := tm.NewSigningKey()
+
+	signed, _, err := token.GenerateWithKey(usr, token.TypeAccess, key, time.Minute, false)
+	require.NoError(t, err)
+
+	rsaKey, err := token.GenerateKey(model.SigningAlgorithmRS256, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	rsaKey.ID = key.ID
+
+	_, err = token.VerifyWithKeys(signed, token.TypeAccess, keyFunc(rsaKey))
+	require.Error(t, err)
+}
+
+func TestNewJWK(t *testing.T) {
+	edKey := tm.NewSigningKey()
+
+	jwk, err := token.NewJWK(edKey)
+	require.NoError(t, err)
+	require.Equal(t, "OKP", jwk.Kty)
+	require.Equal(t, "Ed25519", jwk.Crv)
+	require.Equal(t, edKey.ID, jwk.Kid)
+	require.Equal(t, base64.RawURLEncoding.EncodeToString(edKey.PublicKey().(ed25519.PublicKey)), jwk.X)
+
+	rsaKey, err := token.GenerateKey(model.SigningAlgorithmRS256, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	jwk, err = token.NewJWK(rsaKey)
+	require.NoError(t, err)
+	require.Equal(t, "RSA", jwk.Kty)
+	require.Equal(t, model.SigningAlgorithmRS256, jwk.Alg)
+	require.Equal(t, "AQAB", jwk.E)
+	require.Equal(t, base64.RawURLEncoding.EncodeToString(rsaKey.PublicKey().(*rsa.PublicKey).N.Bytes()), jwk.N)
+}
+
+func TestUserInfoClaims(t *testing.T) {
+	usr := tm.NewUser()
+
+	claims := token.UserInfoClaims(usr, []string{model.ScopeOpenID})
+	require.Equal(t, jwt.MapClaims{"sub": strconv.FormatInt(usr.ID, 10)}, claims)
+
+	claims = token.UserInfoClaims(usr, []string{model.ScopeOpenID, model.ScopeProfile, model.ScopeEmail})
+	require.Equal(t, usr.Name, claims["name"])
+	require.Equal(t, usr.Email, claims["email"])
+	require.Equal(t, usr.EmailVerifiedAt != nil, claims["email_verified"])
+}
+
+func TestVerifyCodeChallenge(t *testing.T) {
+	// RFC 7636 appendix B
+	verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	challenge := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+
+	require.True(t, token.VerifyCodeChallenge(verifier, challenge))
+	require.False(t, token.VerifyCodeChallenge(verifier+"x", challenge))
+}
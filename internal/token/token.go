@@ -0,0 +1,259 @@
+package token
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/model"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Token types.
+const (
+	TypeAccess  = "access"
+	TypeRefresh = "refresh"
+)
+
+const keyIDHeader = "kid"
+
+// Claims represents JWT claims issued by the auth service.
+type Claims struct {
+	jwt.RegisteredClaims
+	Type   string   `json:"typ"`
+	UserID int64    `json:"user_id"`
+	OrgID  int64    `json:"org_id,omitempty"`
+	Roles  []string `json:"roles,omitempty"`
+
+	EmailVerified bool `json:"email_verified"`
+	MFA           bool `json:"mfa"`
+
+	ClientID string `json:"client_id,omitempty"`
+	Scope    string `json:"scope,omitempty"`
+
+	ActorID int64 `json:"actor_id,omitempty"`
+}
+
+// KeyFunc returns verification key by its ID.
+type KeyFunc func(keyID string) (*model.SigningKey, error)
+
+// Generate creates a token of tokenType for user signed with HMAC secretKey.
+func Generate(user *model.User, tokenType string, secretKey []byte, ttl time.Duration) (string, error) {
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, newClaims(user, tokenType, ttl)).SignedString(secretKey)
+	if err != nil {
+		return "", fmt.Errorf("sign token: %w", err)
+	}
+
+	return signed, nil
+}
+
+// GenerateWithKey creates a token of tokenType for user signed with asymmetric key and returns it with its claims.
+// The key ID is put into the kid header so the token can be verified offline with JWKS. mfa tells whether
+// the session the token is issued for was opened with the second factor.
+func GenerateWithKey(
+	user *model.User,
+	tokenType string,
+	key *model.SigningKey,
+	ttl time.Duration,
+	mfa bool,
+) (string, *model.UserClaims, error) {
+	claims := newClaims(user, tokenType, ttl)
+	claims.MFA = mfa
+
+	return signWithKey(claims, key)
+}
+
+// GenerateForClient creates an access token of the OAuth client limited to scopes and signed with asymmetric key.
+// The token carries no user, it is told apart by the client_id claim.
+func GenerateForClient(
+	clientID string,
+	scopes []string,
+	key *model.SigningKey,
+	ttl time.Duration,
+) (string, *model.UserClaims, error) {
+	now := time.Now()
+	claims := Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   clientID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		Type:     TypeAccess,
+		ClientID: clientID,
+		Scope:    strings.Join(scopes, " "),
+	}
+
+	return signWithKey(claims, key)
+}
+
+// GenerateForUserClient creates an access token the user granted to the OAuth client, limited to scopes
+// and signed with asymmetric key. Like client tokens, it can call only endpoints matching its scopes,
+// and it does not carry the second factor.
+func GenerateForUserClient(
+	user *model.User,
+	clientID string,
+	scopes []string,
+	key *model.SigningKey,
+	ttl time.Duration,
+) (string, *model.UserClaims, error) {
+	claims := newClaims(user, TypeAccess, ttl)
+	claims.ClientID = clientID
+	claims.Scope = strings.Join(scopes, " ")
+
+	return signWithKey(claims, key)
+}
+
+// GenerateForImpersonation creates an access token of user for the admin actorID acting as them,
+// signed with asymmetric key. The token is told apart by the actor_id claim. It does not carry the second
+// factor, which only the admin passed.
+func GenerateForImpersonation(
+	user *model.User,
+	actorID int64,
+	key *model.SigningKey,
+	ttl time.Duration,
+) (string, *model.UserClaims, error) {
+	claims := newClaims(user, TypeAccess, ttl)
+	claims.ActorID = actorID
+
+	return signWithKey(claims, key)
+}
+
+// Verify parses token, checks its HMAC signature, expiration and type and returns its claims.
+func Verify(tokenStr string, tokenType string, secretKey []byte) (*model.UserClaims, error) {
+	var claims Claims
+
+	_, err := jwt.ParseWithClaims(
+		tokenStr,
+		&claims,
+		func(_ *jwt.Token) (interface{}, error) {
+			return secretKey, nil
+		},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("parse token: %w", err)
+	}
+
+	return toUserClaims(&claims, tokenType)
+}
+
+// VerifyWithKeys parses token, checks its signature with the key referenced by the kid header,
+// expiration and type and returns its claims.
+func VerifyWithKeys(tokenStr string, tokenType string, keyFunc KeyFunc) (*model.UserClaims, error) {
+	var claims Claims
+
+	_, err := jwt.ParseWithClaims(
+		tokenStr,
+		&claims,
+		func(t *jwt.Token) (interface{}, error) {
+			keyID, ok := t.Header[keyIDHeader].(string)
+			if !ok {
+				return nil, errors.New("token has no key id")
+			}
+
+			key, err := keyFunc(keyID)
+			if err != nil {
+				return nil, fmt.Errorf("get key %s: %w", keyID, err)
+			}
+
+			if t.Method.Alg() != key.Algorithm {
+				return nil, fmt.Errorf("unexpected signing method: %s", t.Method.Alg())
+			}
+
+			return key.PublicKey(), nil
+		},
+		jwt.WithValidMethods([]string{model.SigningAlgorithmRS256, model.SigningAlgorithmEdDSA}),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("parse token: %w", err)
+	}
+
+	return toUserClaims(&claims, tokenType)
+}
+
+// Hash returns a hex-encoded SHA-256 digest of tokenStr suitable for storing tokens at rest.
+func Hash(tokenStr string) string {
+	sum := sha256.Sum256([]byte(tokenStr))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func newClaims(user *model.User, tokenType string, ttl time.Duration) Claims {
+	now := time.Now()
+
+	return Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   strconv.FormatInt(user.ID, 10),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		Type:   tokenType,
+		UserID: user.ID,
+		OrgID:  user.OrgID,
+		Roles:  user.Roles,
+
+		EmailVerified: user.EmailVerifiedAt != nil,
+	}
+}
+
+func signWithKey(claims Claims, key *model.SigningKey) (string, *model.UserClaims, error) {
+	method, err := signingMethod(key.Algorithm)
+	if err != nil {
+		return "", nil, err
+	}
+
+	t := jwt.NewWithClaims(method, claims)
+	t.Header[keyIDHeader] = key.ID
+
+	signed, err := t.SignedString(key.PrivateKey)
+	if err != nil {
+		return "", nil, fmt.Errorf("sign token: %w", err)
+	}
+
+	userClaims, err := toUserClaims(&claims, claims.Type)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return signed, userClaims, nil
+}
+
+func toUserClaims(claims *Claims, tokenType string) (*model.UserClaims, error) {
+	if claims.Type != tokenType {
+		return nil, fmt.Errorf("unexpected token type: %s", claims.Type)
+	}
+
+	return &model.UserClaims{
+		ID:        claims.ID,
+		UserID:    claims.UserID,
+		OrgID:     claims.OrgID,
+		Roles:     claims.Roles,
+		ExpiresAt: claims.ExpiresAt.Time,
+
+		EmailVerified: claims.EmailVerified,
+		MFA:           claims.MFA,
+		ClientID:      claims.ClientID,
+		Scopes:        strings.Fields(claims.Scope),
+		ActorID:       claims.ActorID,
+	}, nil
+}
+
+func signingMethod(algorithm string) (jwt.SigningMethod, error) {
+	switch algorithm {
+	case model.SigningAlgorithmRS256:
+		return jwt.SigningMethodRS256, nil
+	case model.SigningAlgorithmEdDSA:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm: %s", algorithm)
+	}
+}
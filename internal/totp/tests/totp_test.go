@@ -0,0 +1,73 @@
+package tests
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/totp"
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret is the SHA1 seed "12345678901234567890" of RFC 6238 test vectors in base32.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+	}
+
+	for _, tt := range tests {
+		code, err := totp.Code(rfcSecret, totp.Counter(time.Unix(tt.unix, 0)))
+		require.NoError(t, err)
+		require.Equal(t, tt.want, code)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	current := totp.Counter(now)
+
+	code, err := totp.Code(rfcSecret, current-1)
+	require.NoError(t, err)
+
+	counter, ok := totp.Validate(rfcSecret, code, now, 0)
+	require.True(t, ok)
+	require.Equal(t, current-1, counter)
+
+	_, ok = totp.Validate(rfcSecret, code, now, counter)
+	require.False(t, ok, "replayed code must be refused")
+
+	code, err = totp.Code(rfcSecret, current-2)
+	require.NoError(t, err)
+
+	_, ok = totp.Validate(rfcSecret, code, now, 0)
+	require.False(t, ok, "code outside of the skew must be refused")
+
+	_, ok = totp.Validate(rfcSecret, "12345", now, 0)
+	require.False(t, ok)
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+
+	code, err := totp.Code(secret, totp.Counter(time.Now()))
+	require.NoError(t, err)
+	require.Len(t, code, totp.Digits)
+
+	uri, err := url.Parse(totp.URI("auth", "user@example.com", secret))
+	require.NoError(t, err)
+	require.Equal(t, "otpauth", uri.Scheme)
+	require.Equal(t, "totp", uri.Host)
+	require.Equal(t, "/auth:user@example.com", uri.Path)
+	require.Equal(t, secret, uri.Query().Get("secret"))
+	require.Equal(t, "auth", uri.Query().Get("issuer"))
+}
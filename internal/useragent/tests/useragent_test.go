@@ -0,0 +1,62 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/useragent"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestFromIncomingContext(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"user-agent", "grpc-go/1.67.1",
+		"grpcgateway-user-agent", "curl/8.5.0",
+	))
+	require.Equal(t, "curl/8.5.0", useragent.FromIncomingContext(ctx))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-agent", "grpc-go/1.67.1"))
+	require.Equal(t, "grpc-go/1.67.1", useragent.FromIncomingContext(ctx))
+
+	require.Empty(t, useragent.FromIncomingContext(context.Background()))
+}
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		userAgent string
+		want      string
+	}{
+		{
+			userAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 " +
+				"(KHTML, like Gecko) Chrome/129.0.0.0 Safari/537.36",
+			want: "Chrome on macOS",
+		},
+		{
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 " +
+				"(KHTML, like Gecko) Chrome/129.0.0.0 Safari/537.36 Edg/129.0.0.0",
+			want: "Edge on Windows",
+		},
+		{
+			userAgent: "Mozilla/5.0 (X11; Linux x86_64; rv:131.0) Gecko/20100101 Firefox/131.0",
+			want:      "Firefox on Linux",
+		},
+		{
+			userAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_6 like Mac OS X) AppleWebKit/605.1.15 " +
+				"(KHTML, like Gecko) Version/17.6 Mobile/15E148 Safari/604.1",
+			want: "Safari on iOS",
+		},
+		{
+			userAgent: "grpc-go/1.67.1",
+			want:      "gRPC client on Unknown",
+		},
+		{
+			userAgent: "",
+			want:      "Unknown on Unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.want, useragent.Describe(tt.userAgent), tt.userAgent)
+	}
+}
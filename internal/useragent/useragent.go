@@ -0,0 +1,78 @@
+package useragent
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// gatewayUserAgentHeader carries the User-Agent of HTTP requests proxied by the gRPC gateway.
+	gatewayUserAgentHeader = "grpcgateway-user-agent"
+	userAgentHeader        = "user-agent"
+)
+
+const unknown = "Unknown"
+
+// FromIncomingContext returns the user agent of the client which made incoming gRPC request.
+// Requests coming through the HTTP gateway report the user agent of the HTTP client.
+func FromIncomingContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	for _, header := range []string{gatewayUserAgentHeader, userAgentHeader} {
+		if values := md.Get(header); len(values) != 0 {
+			return values[0]
+		}
+	}
+
+	return ""
+}
+
+// Describe returns a short human readable device description like "Chrome on macOS" for userAgent.
+// It recognizes common browsers and platforms only, the rest is reported as unknown.
+func Describe(userAgent string) string {
+	return browser(userAgent) + " on " + platform(userAgent)
+}
+
+// browser is checked in order, since most browsers mention the engines they are compatible with.
+func browser(userAgent string) string {
+	switch {
+	case strings.Contains(userAgent, "Edg/"):
+		return "Edge"
+	case strings.Contains(userAgent, "OPR/"):
+		return "Opera"
+	case strings.Contains(userAgent, "Firefox/"):
+		return "Firefox"
+	case strings.Contains(userAgent, "Chrome/"):
+		return "Chrome"
+	case strings.Contains(userAgent, "Safari/"):
+		return "Safari"
+	case strings.HasPrefix(userAgent, "grpc-"):
+		return "gRPC client"
+	case strings.HasPrefix(userAgent, "curl/"):
+		return "curl"
+	default:
+		return unknown
+	}
+}
+
+func platform(userAgent string) string {
+	switch {
+	case strings.Contains(userAgent, "Android"):
+		return "Android"
+	case strings.Contains(userAgent, "iPhone"), strings.Contains(userAgent, "iPad"):
+		return "iOS"
+	case strings.Contains(userAgent, "Windows"):
+		return "Windows"
+	case strings.Contains(userAgent, "Mac OS X"):
+		return "macOS"
+	case strings.Contains(userAgent, "Linux"):
+		return "Linux"
+	default:
+		return unknown
+	}
+}
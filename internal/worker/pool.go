@@ -0,0 +1,89 @@
+package worker
+
+import (
+	"log/slog"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrQueueFull is returned when the task is submitted while every queued task is still waiting for a worker.
+	ErrQueueFull = errors.New("worker queue is full")
+	// ErrStopped is returned when the task is submitted to a stopped pool.
+	ErrStopped = errors.New("worker pool is stopped")
+)
+
+// Pool runs tasks in the background on a fixed number of workers. Tasks wait for a worker in a queue
+// of limited size, so a burst of requests cannot start an unbounded number of goroutines.
+type Pool struct {
+	logger *slog.Logger
+
+	mu      sync.RWMutex
+	stopped bool
+	tasks   chan func()
+	wg      sync.WaitGroup
+}
+
+// NewPool creates a pool of workers running tasks from a queue of queueSize and starts the workers.
+func NewPool(logger *slog.Logger, workers, queueSize int) *Pool {
+	p := &Pool{
+		logger: logger,
+		tasks:  make(chan func(), queueSize),
+	}
+
+	p.wg.Add(workers)
+	for range workers {
+		go p.work()
+	}
+
+	return p
+}
+
+// Submit queues task to run in the background without waiting for it.
+func (p *Pool) Submit(task func()) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.stopped {
+		return ErrStopped
+	}
+
+	select {
+	case p.tasks <- task:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// Stop stops accepting tasks and waits until the queued ones are done.
+func (p *Pool) Stop() {
+	p.mu.Lock()
+	if !p.stopped {
+		p.stopped = true
+		close(p.tasks)
+	}
+	p.mu.Unlock()
+
+	p.wg.Wait()
+}
+
+func (p *Pool) work() {
+	defer p.wg.Done()
+
+	for task := range p.tasks {
+		p.run(task)
+	}
+}
+
+// run runs task, a panicking task must not take the worker down with it.
+func (p *Pool) run(task func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			p.logger.Error("worker task panicked:", slog.Any("panic", r))
+		}
+	}()
+
+	task()
+}
@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Paul1k96/microservices_course_auth/internal/worker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPool_RunsTasks(t *testing.T) {
+	pool := worker.NewPool(slog.Default(), 2, 10)
+
+	var done atomic.Int32
+	for range 10 {
+		require.NoError(t, pool.Submit(func() { done.Add(1) }))
+	}
+
+	pool.Stop()
+	require.EqualValues(t, 10, done.Load())
+}
+
+func TestPool_QueueFull(t *testing.T) {
+	pool := worker.NewPool(slog.Default(), 1, 1)
+	defer pool.Stop()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+
+	require.NoError(t, pool.Submit(func() {
+		close(started)
+		<-release
+	}))
+	<-started
+
+	require.NoError(t, pool.Submit(func() {}))
+	require.ErrorIs(t, pool.Submit(func() {}), worker.ErrQueueFull)
+}
+
+func TestPool_StopDrainsQueue(t *testing.T) {
+	pool := worker.NewPool(slog.Default(), 1, 5)
+
+	var done atomic.Int32
+	for range 5 {
+		require.NoError(t, pool.Submit(func() {
+			time.Sleep(10 * time.Millisecond)
+			done.Add(1)
+		}))
+	}
+
+	pool.Stop()
+	require.EqualValues(t, 5, done.Load())
+	require.ErrorIs(t, pool.Submit(func() {}), worker.ErrStopped)
+}
+
+func TestPool_SurvivesPanickingTask(t *testing.T) {
+	pool := worker.NewPool(slog.Default(), 1, 2)
+
+	var done atomic.Bool
+	require.NoError(t, pool.Submit(func() { panic("task failed") }))
+	require.NoError(t, pool.Submit(func() { done.Store(true) }))
+
+	pool.Stop()
+	require.True(t, done.Load())
+}
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE signing_keys (
+    id text PRIMARY KEY,
+    algorithm text NOT NULL,
+    private_key text NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX signing_keys_expires_at_idx ON signing_keys(expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX signing_keys_expires_at_idx;
+
+DROP TABLE signing_keys;
+-- +goose StatementEnd
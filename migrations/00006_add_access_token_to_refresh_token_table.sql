@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE refresh_tokens ADD COLUMN access_token_id text;
+ALTER TABLE refresh_tokens ADD COLUMN access_token_expires_at TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE refresh_tokens DROP COLUMN access_token_expires_at;
+ALTER TABLE refresh_tokens DROP COLUMN access_token_id;
+-- +goose StatementEnd
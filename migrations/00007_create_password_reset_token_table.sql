@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE password_reset_tokens (
+    id UUID PRIMARY KEY,
+    user_id INT NOT NULL,
+    token_hash text NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX password_reset_tokens_token_hash_idx ON password_reset_tokens(token_hash);
+CREATE INDEX password_reset_tokens_user_id_idx ON password_reset_tokens(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX password_reset_tokens_user_id_idx;
+DROP INDEX password_reset_tokens_token_hash_idx;
+
+DROP TABLE password_reset_tokens;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN email_verified_at TIMESTAMP;
+
+CREATE TABLE email_verification_tokens (
+    id UUID PRIMARY KEY,
+    user_id INT NOT NULL,
+    email text NOT NULL,
+    token_hash text NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX email_verification_tokens_token_hash_idx ON email_verification_tokens(token_hash);
+CREATE INDEX email_verification_tokens_user_id_idx ON email_verification_tokens(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX email_verification_tokens_user_id_idx;
+DROP INDEX email_verification_tokens_token_hash_idx;
+
+DROP TABLE email_verification_tokens;
+
+ALTER TABLE users DROP COLUMN email_verified_at;
+-- +goose StatementEnd
@@ -0,0 +1,79 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE roles (
+    id SERIAL PRIMARY KEY,
+    name text NOT NULL UNIQUE,
+    description text NOT NULL DEFAULT '',
+    built_in BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE permissions (
+    id SERIAL PRIMARY KEY,
+    name text NOT NULL UNIQUE,
+    description text NOT NULL DEFAULT ''
+);
+
+CREATE TABLE role_permissions (
+    role_id INT NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+    permission_id INT NOT NULL REFERENCES permissions(id) ON DELETE CASCADE,
+    PRIMARY KEY (role_id, permission_id)
+);
+
+CREATE TABLE user_roles (
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role_id INT NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+    PRIMARY KEY (user_id, role_id)
+);
+
+CREATE INDEX user_roles_role_id_idx ON user_roles(role_id);
+
+INSERT INTO roles (name, description, built_in) VALUES
+    ('UNKNOWN', 'Users without a role', TRUE),
+    ('USER', 'Regular users', TRUE),
+    ('ADMIN', 'Administrators', TRUE);
+
+INSERT INTO permissions (name, description) VALUES
+    ('tokens.revoke', 'Revoke all tokens of any user'),
+    ('sessions.manage', 'List and revoke sessions of any user'),
+    ('users.unlock', 'Unlock accounts locked after failed logins'),
+    ('oauth_clients.manage', 'Create and revoke OAuth clients'),
+    ('chat.create', 'Create chats'),
+    ('chat.delete', 'Delete chats'),
+    ('chat.send_message', 'Send chat messages');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT roles.id, permissions.id FROM roles, permissions
+WHERE roles.name = 'ADMIN'
+   OR (roles.name = 'USER' AND permissions.name = 'chat.send_message');
+
+INSERT INTO user_roles (user_id, role_id)
+SELECT users.id, roles.id FROM users
+JOIN roles ON roles.name = COALESCE(users.role, 'UNKNOWN');
+
+ALTER TABLE users DROP COLUMN role;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN role text DEFAULT 'UNKNOWN';
+
+UPDATE users SET role = CASE
+    WHEN EXISTS (
+        SELECT 1 FROM user_roles JOIN roles ON roles.id = user_roles.role_id
+        WHERE user_roles.user_id = users.id AND roles.name = 'ADMIN'
+    ) THEN 'ADMIN'
+    WHEN EXISTS (
+        SELECT 1 FROM user_roles JOIN roles ON roles.id = user_roles.role_id
+        WHERE user_roles.user_id = users.id AND roles.name = 'USER'
+    ) THEN 'USER'
+    ELSE 'UNKNOWN'
+END;
+
+DROP INDEX user_roles_role_id_idx;
+
+DROP TABLE user_roles;
+DROP TABLE role_permissions;
+DROP TABLE permissions;
+DROP TABLE roles;
+-- +goose StatementEnd
@@ -0,0 +1,54 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE organizations (
+    id SERIAL PRIMARY KEY,
+    name text NOT NULL UNIQUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO organizations (id, name) VALUES (1, 'default');
+
+SELECT setval('organizations_id_seq', 1);
+
+ALTER TABLE users ADD COLUMN org_id INT NOT NULL DEFAULT 1 REFERENCES organizations(id);
+
+ALTER TABLE users DROP CONSTRAINT email_unique;
+
+ALTER TABLE users ADD CONSTRAINT users_org_id_email_unique UNIQUE (org_id, email);
+
+CREATE TABLE org_members (
+    org_id INT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role_id INT REFERENCES roles(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (org_id, user_id)
+);
+
+CREATE INDEX org_members_user_id_idx ON org_members(user_id);
+
+INSERT INTO org_members (org_id, user_id)
+SELECT org_id, id FROM users;
+
+ALTER TABLE user_events ADD COLUMN org_id INT;
+
+CREATE INDEX user_events_org_id_idx ON user_events(org_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX user_events_org_id_idx;
+
+ALTER TABLE user_events DROP COLUMN org_id;
+
+DROP INDEX org_members_user_id_idx;
+
+DROP TABLE org_members;
+
+ALTER TABLE users DROP CONSTRAINT users_org_id_email_unique;
+
+ALTER TABLE users ADD CONSTRAINT email_unique UNIQUE (email);
+
+ALTER TABLE users DROP COLUMN org_id;
+
+DROP TABLE organizations;
+-- +goose StatementEnd
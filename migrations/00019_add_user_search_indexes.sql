@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX users_created_at_id_idx ON users(created_at, id);
+
+CREATE INDEX users_last_changed_at_id_idx ON users((COALESCE(updated_at, created_at)), id);
+
+CREATE INDEX users_updated_at_idx ON users(updated_at);
+
+CREATE INDEX users_email_id_idx ON users(email, id);
+
+CREATE INDEX users_email_domain_idx ON users((lower(split_part(email, '@', 2))));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX users_email_domain_idx;
+
+DROP INDEX users_email_id_idx;
+
+DROP INDEX users_updated_at_idx;
+
+DROP INDEX users_last_changed_at_id_idx;
+
+DROP INDEX users_created_at_id_idx;
+-- +goose StatementEnd
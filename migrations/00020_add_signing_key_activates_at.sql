@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE signing_keys ADD COLUMN activates_at TIMESTAMP;
+
+-- Existing keys are already published and signing.
+UPDATE signing_keys SET activates_at = created_at;
+
+ALTER TABLE signing_keys ALTER COLUMN activates_at SET NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE signing_keys DROP COLUMN activates_at;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_roles ADD COLUMN org_id INT REFERENCES organizations(id) ON DELETE CASCADE;
+
+UPDATE user_roles SET org_id = users.org_id FROM users WHERE users.id = user_roles.user_id;
+
+ALTER TABLE user_roles ALTER COLUMN org_id SET NOT NULL;
+
+ALTER TABLE user_roles DROP CONSTRAINT user_roles_pkey;
+
+ALTER TABLE user_roles ADD PRIMARY KEY (user_id, org_id, role_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_roles DROP CONSTRAINT user_roles_pkey;
+
+DELETE FROM user_roles USING users WHERE users.id = user_roles.user_id AND users.org_id <> user_roles.org_id;
+
+ALTER TABLE user_roles DROP COLUMN org_id;
+
+ALTER TABLE user_roles ADD PRIMARY KEY (user_id, role_id);
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE roles ADD COLUMN org_id INT REFERENCES organizations(id) ON DELETE CASCADE;
+
+UPDATE roles SET org_id = 1 WHERE NOT built_in;
+
+ALTER TABLE roles ADD CONSTRAINT roles_org_id_check CHECK (built_in = (org_id IS NULL));
+
+ALTER TABLE roles DROP CONSTRAINT roles_name_key;
+
+ALTER TABLE roles ADD CONSTRAINT roles_org_id_name_unique UNIQUE (org_id, name);
+
+CREATE UNIQUE INDEX roles_built_in_name_idx ON roles(name) WHERE org_id IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX roles_built_in_name_idx;
+
+ALTER TABLE roles DROP CONSTRAINT roles_org_id_name_unique;
+
+DELETE FROM roles USING roles AS kept WHERE kept.name = roles.name AND kept.id < roles.id;
+
+ALTER TABLE roles ADD CONSTRAINT roles_name_key UNIQUE (name);
+
+ALTER TABLE roles DROP CONSTRAINT roles_org_id_check;
+
+ALTER TABLE roles DROP COLUMN org_id;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Chat permissions are created and granted to built-in roles on startup from ACCESS_ROLE_PERMISSIONS.
+DELETE FROM role_permissions USING roles, permissions
+WHERE roles.id = role_permissions.role_id
+  AND permissions.id = role_permissions.permission_id
+  AND roles.built_in
+  AND permissions.name IN ('chat.create', 'chat.delete', 'chat.send_message');
+
+DELETE FROM permissions
+WHERE name IN ('chat.create', 'chat.delete', 'chat.send_message')
+  AND NOT EXISTS (SELECT 1 FROM role_permissions WHERE role_permissions.permission_id = permissions.id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+INSERT INTO permissions (name, description) VALUES
+    ('chat.create', 'Create chats'),
+    ('chat.delete', 'Delete chats'),
+    ('chat.send_message', 'Send chat messages')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT roles.id, permissions.id FROM roles, permissions
+WHERE permissions.name IN ('chat.create', 'chat.delete', 'chat.send_message')
+  AND roles.built_in
+  AND (roles.name = 'ADMIN' OR (roles.name = 'USER' AND permissions.name = 'chat.send_message'))
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
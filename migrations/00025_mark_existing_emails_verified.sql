@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Accounts created before verification was introduced keep working where a verified email is required.
+UPDATE users SET email_verified_at = CURRENT_TIMESTAMP
+WHERE email_verified_at IS NULL
+  AND created_at < (
+      SELECT tstamp FROM goose_db_version
+      WHERE version_id = 8 AND is_applied
+      ORDER BY id DESC
+      LIMIT 1
+  );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Verified emails cannot be told apart from the ones marked verified by this migration, they are kept.
+SELECT 'down SQL query';
+-- +goose StatementEnd